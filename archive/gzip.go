@@ -0,0 +1,86 @@
+// Package archive implements oxylabs.ArchiveHook, retaining the raw response
+// of every successful call of a client, e.g. for compliance.
+package archive
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// GzipDir archives the raw responses gzip compressed into Dir, in a
+// yyyy/mm/dd directory per day, along with the record as a json sidecar.
+type GzipDir struct {
+	Dir string
+}
+
+// NewGzipDir returns the archive of the raw responses into dir.
+func NewGzipDir(dir string) *GzipDir {
+	return &GzipDir{Dir: dir}
+}
+
+func (a *GzipDir) Archive(_ context.Context, record oxylabs.ArchiveRecord, body []byte) error {
+	dir := filepath.Join(a.Dir, record.Timestamp.Format("2006/01/02"))
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("error creating archive dir: %v", err)
+	}
+
+	name := Name(record)
+	if err := writeGzip(filepath.Join(dir, name+".json.gz"), body); err != nil {
+		return err
+	}
+
+	meta, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error marshalling archive record: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, name+".meta.json"), meta, 0o644); err != nil {
+		return fmt.Errorf("error writing archive record: %v", err)
+	}
+
+	return nil
+}
+
+// Name returns the base name of the archive of the record: its timestamp
+// followed by the job ID, or the payload hash for sync calls.
+func Name(record oxylabs.ArchiveRecord) string {
+	id := record.JobID
+	if id == "" {
+		id = record.PayloadHash
+	}
+	if len(id) > 16 {
+		id = id[:16]
+	}
+
+	name := record.Timestamp.UTC().Format("20060102T150405.000000000Z")
+	if id != "" {
+		name += "-" + id
+	}
+
+	return strings.ReplaceAll(name, ".", "")
+}
+
+// writeGzip writes the gzip compressed body to path.
+func writeGzip(path string, body []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating archive file: %v", err)
+	}
+	defer f.Close()
+
+	w := gzip.NewWriter(f)
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("error writing archive file: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error writing archive file: %v", err)
+	}
+
+	return f.Close()
+}
@@ -0,0 +1,166 @@
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// S3 archives the raw responses gzip compressed as objects of Bucket, with
+// keys Prefix/yyyy/mm/dd/<name>.json.gz and the record as object metadata.
+// Reqs are signed with AWS Signature Version 4 with the static credentials.
+// Endpoint is the S3 endpoint, https://s3.<Region>.amazonaws.com if empty,
+// objects being addressed path-style, so S3 compatible stores work too.
+type S3 struct {
+	Bucket          string
+	Prefix          string
+	Region          string
+	Endpoint        string
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	HttpClient      *http.Client
+}
+
+func (a *S3) Archive(ctx context.Context, record oxylabs.ArchiveRecord, body []byte) error {
+	var compressed bytes.Buffer
+	w := gzip.NewWriter(&compressed)
+	if _, err := w.Write(body); err != nil {
+		return fmt.Errorf("error compressing resp: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("error compressing resp: %v", err)
+	}
+
+	meta, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error marshalling archive record: %v", err)
+	}
+
+	key := path.Join(a.Prefix, record.Timestamp.Format("2006/01/02"), Name(record)+".json.gz")
+	req, err := http.NewRequestWithContext(ctx, "PUT", a.objectUrl(key), bytes.NewReader(compressed.Bytes()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	req.Header.Set("x-amz-meta-record", url.QueryEscape(string(meta)))
+	a.sign(req, compressed.Bytes(), time.Now().UTC())
+
+	client := a.HttpClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error performing req: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(resp.Body)
+		return &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: respBody}
+	}
+
+	return nil
+}
+
+// objectUrl returns the path-style url of the object with the key.
+func (a *S3) objectUrl(key string) string {
+	endpoint := a.Endpoint
+	if endpoint == "" {
+		endpoint = fmt.Sprintf("https://s3.%s.amazonaws.com", a.Region)
+	}
+
+	escaped := make([]string, 0, strings.Count(key, "/")+1)
+	for _, segment := range strings.Split(key, "/") {
+		escaped = append(escaped, url.PathEscape(segment))
+	}
+
+	return fmt.Sprintf("%s/%s/%s", strings.TrimSuffix(endpoint, "/"), a.Bucket, strings.Join(escaped, "/"))
+}
+
+// sign signs the req with AWS Signature Version 4.
+func (a *S3) sign(req *http.Request, body []byte, now time.Time) {
+	date := now.Format("20060102")
+	timestamp := now.Format("20060102T150405Z")
+	payloadHash := sha256Hex(body)
+
+	req.Header.Set("x-amz-date", timestamp)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+	if a.SessionToken != "" {
+		req.Header.Set("x-amz-security-token", a.SessionToken)
+	}
+
+	// Canonical headers, host included.
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := fmt.Sprintf("%s/%s/s3/aws4_request", date, a.Region)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		timestamp,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSha256([]byte("AWS4"+a.SecretAccessKey), date)
+	key = hmacSha256(key, a.Region)
+	key = hmacSha256(key, "s3")
+	key = hmacSha256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSha256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.AccessKeyID, scope, signedHeaders, signature,
+	))
+}
+
+// sha256Hex returns the hex encoded SHA-256 hash of data.
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// hmacSha256 returns the HMAC-SHA256 of data with the key.
+func hmacSha256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
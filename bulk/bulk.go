@@ -0,0 +1,220 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+)
+
+// DefaultConcurrency is the number of items executed simultaneously if not set.
+var DefaultConcurrency = 10
+
+// Opts contains the options available for bulk execution.
+// Sampling, if set, executes only the sample of the inputs of the run, keyed
+// by KeyOf, or by the KeyOf func of the opts if set.
+// Politeness, if set, spaces out the inputs of the same target domain, as
+// returned by DomainOf, or by the DomainOf func of the opts if set.
+type Opts struct {
+	Concurrency int
+	Sampling    *Sampling
+	Politeness  *Politeness
+	DomainOf    func(input interface{}) string
+	KeyOf       func(input interface{}) string
+}
+
+// Success is the output of a successfully executed item.
+type Success[In, Out any] struct {
+	Index    int
+	Input    In
+	Output   Out
+	Duration time.Duration
+}
+
+// ItemError is the error of a failed item.
+type ItemError[In any] struct {
+	Index    int
+	Input    In
+	Err      error
+	Duration time.Duration
+}
+
+func (e *ItemError[In]) Error() string {
+	return fmt.Sprintf("item %d failed: %v", e.Index, e.Err)
+}
+
+func (e *ItemError[In]) Unwrap() error {
+	return e.Err
+}
+
+// Stats contains aggregate stats of a bulk execution.
+// Skipped is the number of inputs left out of the sample of the run.
+type Stats struct {
+	Total       int
+	Skipped     int
+	Succeeded   int
+	Failed      int
+	Duration    time.Duration
+	MinDuration time.Duration
+	MaxDuration time.Duration
+	AvgDuration time.Duration
+}
+
+// BulkResult is the report of a bulk execution.
+// Successes and Errors are ordered by item index.
+type BulkResult[In, Out any] struct {
+	Successes []Success[In, Out]
+	Errors    []*ItemError[In]
+	Stats     Stats
+}
+
+// Execute runs fn for every input concurrently and reports every success and
+// failure, instead of failing the whole batch on the first error.
+// Items not yet started when ctx is done fail with the ctx error.
+func Execute[In, Out any](
+	ctx context.Context,
+	inputs []In,
+	fn func(context.Context, In) (Out, error),
+	opts ...*Opts,
+) *BulkResult[In, Out] {
+	// Prepare options.
+	opt := &Opts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	if opt.Concurrency <= 0 {
+		opt.Concurrency = DefaultConcurrency
+	}
+	domainOf := opt.DomainOf
+	if domainOf == nil {
+		domainOf = DomainOf
+	}
+	keyOf := opt.KeyOf
+	if keyOf == nil {
+		keyOf = KeyOf
+	}
+
+	// Pick the inputs of the sample of the run.
+	var (
+		indexes []int
+		skipped int
+	)
+	for i := range inputs {
+		if opt.Sampling.Includes(keyOf(inputs[i])) {
+			indexes = append(indexes, i)
+		} else {
+			skipped++
+		}
+	}
+
+	var (
+		mu     sync.Mutex
+		result = &BulkResult[In, Out]{}
+	)
+
+	start := time.Now()
+
+	g := &errgroup.Group{}
+	schedule(ctx, indexes, func(i int) string {
+		return domainOf(inputs[i])
+	}, opt.Politeness, opt.Concurrency, func(i int, release func(), err error) {
+		if err != nil {
+			var zero Out
+			mu.Lock()
+			defer mu.Unlock()
+			result.record(i, inputs[i], zero, err, 0)
+			return
+		}
+
+		g.Go(func() error {
+			defer release()
+
+			itemStart := time.Now()
+			output, err := fn(ctx, inputs[i])
+			duration := time.Since(itemStart)
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.record(i, inputs[i], output, err, duration)
+
+			// Never fail the group, so that every item is executed.
+			return nil
+		})
+	})
+	_ = g.Wait()
+
+	result.sort()
+	result.Stats = result.stats(time.Since(start))
+	result.Stats.Skipped = skipped
+
+	return result
+}
+
+// record adds the outcome of the item to the successes or errors of the result.
+func (r *BulkResult[In, Out]) record(i int, input In, output Out, err error, duration time.Duration) {
+	if err != nil {
+		r.Errors = append(r.Errors, &ItemError[In]{
+			Index:    i,
+			Input:    input,
+			Err:      err,
+			Duration: duration,
+		})
+		return
+	}
+
+	r.Successes = append(r.Successes, Success[In, Out]{
+		Index:    i,
+		Input:    input,
+		Output:   output,
+		Duration: duration,
+	})
+}
+
+// sort orders successes and errors by item index.
+func (r *BulkResult[In, Out]) sort() {
+	sort.Slice(r.Successes, func(i, j int) bool {
+		return r.Successes[i].Index < r.Successes[j].Index
+	})
+	sort.Slice(r.Errors, func(i, j int) bool {
+		return r.Errors[i].Index < r.Errors[j].Index
+	})
+}
+
+// stats aggregates the item durations of the result.
+func (r *BulkResult[In, Out]) stats(duration time.Duration) Stats {
+	stats := Stats{
+		Total:     len(r.Successes) + len(r.Errors),
+		Succeeded: len(r.Successes),
+		Failed:    len(r.Errors),
+		Duration:  duration,
+	}
+
+	durations := make([]time.Duration, 0, stats.Total)
+	for _, s := range r.Successes {
+		durations = append(durations, s.Duration)
+	}
+	for _, e := range r.Errors {
+		durations = append(durations, e.Duration)
+	}
+
+	var total time.Duration
+	for i, d := range durations {
+		if i == 0 || d < stats.MinDuration {
+			stats.MinDuration = d
+		}
+		if d > stats.MaxDuration {
+			stats.MaxDuration = d
+		}
+		total += d
+	}
+	if len(durations) > 0 {
+		stats.AvgDuration = total / time.Duration(len(durations))
+	}
+
+	return stats
+}
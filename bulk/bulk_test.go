@@ -0,0 +1,49 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecute_PartialFailure(t *testing.T) {
+	errOdd := errors.New("odd")
+
+	result := Execute(
+		context.Background(),
+		[]int{1, 2, 3, 4, 5},
+		func(_ context.Context, in int) (int, error) {
+			if in%2 != 0 {
+				return 0, errOdd
+			}
+			return in * 10, nil
+		},
+		&Opts{Concurrency: 2},
+	)
+
+	assert.Equal(t, 5, result.Stats.Total)
+	assert.Equal(t, 2, result.Stats.Succeeded)
+	assert.Equal(t, 3, result.Stats.Failed)
+
+	assert.Equal(t, []int{1, 3}, []int{result.Successes[0].Index, result.Successes[1].Index})
+	assert.Equal(t, 40, result.Successes[1].Output)
+
+	for _, e := range result.Errors {
+		assert.ErrorIs(t, e, errOdd)
+	}
+	assert.Equal(t, 4, result.Errors[2].Index)
+}
+
+func TestExecute_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := Execute(ctx, []string{"a", "b"}, func(context.Context, string) (string, error) {
+		return "ok", nil
+	})
+
+	assert.Equal(t, 2, result.Stats.Failed)
+	assert.ErrorIs(t, result.Errors[0], context.Canceled)
+}
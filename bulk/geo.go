@@ -0,0 +1,62 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// CompareAcrossGeos runs scrapeFn for every geo location concurrently, e.g. the
+// same query scraped with the geo_location of each geo, and returns the outputs
+// keyed by geo. Repeated geos are scraped once. The outputs of the geos that
+// succeeded are returned along with the joined errors of the geos that failed.
+//
+//	results, err := bulk.CompareAcrossGeos(ctx,
+//		func(ctx context.Context, geo string) (*serp.Resp, error) {
+//			return c.ScrapeGoogleSearchCtx(ctx, query, &serp.GoogleSearchOpts{GeoLocation: geo})
+//		},
+//		[]string{"Germany", "France", "Spain"},
+//	)
+func CompareAcrossGeos[Out any](
+	ctx context.Context,
+	scrapeFn func(ctx context.Context, geo string) (Out, error),
+	geos []string,
+	opts ...*Opts,
+) (map[string]Out, error) {
+	// Scrape each geo once.
+	seen := make(map[string]bool, len(geos))
+	unique := make([]string, 0, len(geos))
+	for _, geo := range geos {
+		if !seen[geo] {
+			seen[geo] = true
+			unique = append(unique, geo)
+		}
+	}
+
+	result := Execute(ctx, unique, scrapeFn, opts...)
+
+	outputs := make(map[string]Out, len(result.Successes))
+	for _, s := range result.Successes {
+		outputs[s.Input] = s.Output
+	}
+	errs := make([]error, 0, len(result.Errors))
+	for _, e := range result.Errors {
+		errs = append(errs, &GeoError{Geo: e.Input, Err: e.Err})
+	}
+
+	return outputs, errors.Join(errs...)
+}
+
+// GeoError is the error of a geo of CompareAcrossGeos.
+type GeoError struct {
+	Geo string
+	Err error
+}
+
+func (e *GeoError) Error() string {
+	return fmt.Sprintf("geo %s failed: %v", e.Geo, e.Err)
+}
+
+func (e *GeoError) Unwrap() error {
+	return e.Err
+}
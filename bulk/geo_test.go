@@ -0,0 +1,38 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompareAcrossGeos(t *testing.T) {
+	errBlocked := errors.New("blocked")
+	calls := make(chan string, 4)
+
+	results, err := CompareAcrossGeos(
+		context.Background(),
+		func(_ context.Context, geo string) (string, error) {
+			calls <- geo
+			if geo == "Spain" {
+				return "", errBlocked
+			}
+			return "price in " + geo, nil
+		},
+		[]string{"Germany", "France", "Spain", "Germany"},
+	)
+	close(calls)
+
+	assert.Len(t, calls, 3, "repeated geos are scraped once")
+	assert.Equal(t, map[string]string{
+		"Germany": "price in Germany",
+		"France":  "price in France",
+	}, results)
+
+	var geoErr *GeoError
+	assert.True(t, errors.As(err, &geoErr))
+	assert.Equal(t, "Spain", geoErr.Geo)
+	assert.ErrorIs(t, err, errBlocked)
+}
@@ -0,0 +1,175 @@
+package bulk
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Politeness caps the items executed per target domain, e.g. when scraping
+// many urls of the same retailer. Delay is the min time between the starts of
+// the items of a domain, Concurrency the max items of a domain executed
+// simultaneously, unlimited if 0. Items waiting for their domain don't take
+// any of the concurrency slots of the execution.
+type Politeness struct {
+	Delay       time.Duration
+	Concurrency int
+}
+
+// DomainOf returns the target domain of the input, without the www. prefix:
+// the host of a url string, or of the url of an *oxylabs.Request.
+// Inputs without a domain, e.g. query based requests, return "".
+func DomainOf(input interface{}) string {
+	var rawUrl string
+	switch input := input.(type) {
+	case string:
+		rawUrl = input
+	case *oxylabs.Request:
+		if input != nil {
+			rawUrl = input.Url
+		}
+	}
+
+	u, err := url.Parse(rawUrl)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// schedule calls start for every item of indexes once it may start: once one
+// of the concurrency slots is free and, with politeness, once the politeness of
+// its domain allows it. The items wait for their domain in a queue per domain,
+// in order of the indexes, without holding a slot, so that the items of a slow
+// domain don't starve the others. start is called with the func releasing the
+// slot of the item, or with the ctx error for the items not started once ctx is
+// done. start may be called concurrently, schedule returns once it was called
+// for every item.
+func schedule(
+	ctx context.Context,
+	indexes []int,
+	domainOf func(i int) string,
+	politeness *Politeness,
+	concurrency int,
+	start func(i int, release func(), err error),
+) {
+	if politeness != nil && politeness.Delay <= 0 && politeness.Concurrency <= 0 {
+		politeness = nil
+	}
+
+	// Queue the items per domain, in order of first appearance.
+	var domains []string
+	queues := make(map[string][]int)
+	for _, i := range indexes {
+		domain := ""
+		if politeness != nil {
+			domain = domainOf(i)
+		}
+		if _, ok := queues[domain]; !ok {
+			domains = append(domains, domain)
+		}
+		queues[domain] = append(queues[domain], i)
+	}
+
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for _, domain := range domains {
+		wg.Add(1)
+		go func(gate *domainGate, queue []int) {
+			defer wg.Done()
+			for n, i := range queue {
+				release, err := gate.acquire(ctx, slots)
+				if err != nil {
+					for _, i := range queue[n:] {
+						start(i, nil, err)
+					}
+					return
+				}
+				start(i, release, nil)
+			}
+		}(newDomainGate(politeness, domain), queues[domain])
+	}
+	wg.Wait()
+}
+
+// domainGate spaces out the items of a domain. A nil gate lets every item
+// start as soon as a slot is free. It is used by the queue of its domain only.
+type domainGate struct {
+	sem   chan struct{}
+	delay time.Duration
+	next  time.Time
+}
+
+// newDomainGate returns the gate of the domain per the politeness,
+// nil if the politeness is unset or the item has no domain.
+func newDomainGate(politeness *Politeness, domain string) *domainGate {
+	if politeness == nil || domain == "" {
+		return nil
+	}
+
+	gate := &domainGate{delay: politeness.Delay}
+	if politeness.Concurrency > 0 {
+		gate.sem = make(chan struct{}, politeness.Concurrency)
+	}
+
+	return gate
+}
+
+// acquire waits for the turn of the next item of the domain, then for a free
+// slot, returning the func releasing both, or the ctx error if ctx is done first.
+func (g *domainGate) acquire(ctx context.Context, slots chan struct{}) (func(), error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Cap the concurrency of the domain.
+	releaseDomain := func() {}
+	if g != nil && g.sem != nil {
+		select {
+		case g.sem <- struct{}{}:
+			releaseDomain = func() { <-g.sem }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	// Space out the starts.
+	if g != nil {
+		if wait := time.Until(g.next); wait > 0 {
+			timer := time.NewTimer(wait)
+			defer timer.Stop()
+			select {
+			case <-timer.C:
+			case <-ctx.Done():
+				releaseDomain()
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	// Wait for a free slot.
+	select {
+	case slots <- struct{}{}:
+	case <-ctx.Done():
+		releaseDomain()
+		return nil, ctx.Err()
+	}
+	if err := ctx.Err(); err != nil {
+		<-slots
+		releaseDomain()
+		return nil, err
+	}
+	if g != nil {
+		g.next = time.Now().Add(g.delay)
+	}
+
+	return func() {
+		<-slots
+		releaseDomain()
+	}, nil
+}
@@ -0,0 +1,70 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestDomainOf(t *testing.T) {
+	assert.Equal(t, "shop.com", DomainOf("https://www.Shop.com/p/1"))
+	assert.Equal(t, "shop.com", DomainOf(&oxylabs.Request{Url: "https://shop.com/p/1"}))
+	assert.Equal(t, "", DomainOf(&oxylabs.Request{Query: "adidas"}))
+	assert.Equal(t, "", DomainOf(42))
+}
+
+func TestExecutePoliteness(t *testing.T) {
+	urls := []string{
+		"https://a.com/1", "https://a.com/2", "https://a.com/3",
+		"https://b.com/1", "https://b.com/2", "https://b.com/3",
+	}
+
+	var mu sync.Mutex
+	starts := make(map[string][]time.Time)
+	result := Execute(context.Background(), urls, func(_ context.Context, url string) (string, error) {
+		mu.Lock()
+		starts[DomainOf(url)] = append(starts[DomainOf(url)], time.Now())
+		mu.Unlock()
+		return url, nil
+	}, &Opts{Concurrency: 6, Politeness: &Politeness{Delay: 20 * time.Millisecond, Concurrency: 1}})
+
+	assert.Len(t, result.Successes, 6)
+	for domain, times := range starts {
+		assert.Len(t, times, 3, domain)
+		for i := 1; i < len(times); i++ {
+			assert.GreaterOrEqual(t, times[i].Sub(times[i-1]), 15*time.Millisecond, domain)
+		}
+	}
+	assert.Less(t, result.Stats.Duration, 200*time.Millisecond, "domains are spaced out independently")
+}
+
+func TestPolitenessDoesNotStarveOtherDomains(t *testing.T) {
+	urls := []string{
+		"https://a.com/1", "https://a.com/2", "https://a.com/3", "https://a.com/4",
+		"https://b.com/1",
+	}
+	opts := &Opts{Concurrency: 2, Politeness: &Politeness{Delay: 50 * time.Millisecond, Concurrency: 1}}
+
+	executors := map[string]func(context.Context, []string, func(context.Context, string) (time.Time, error), ...*Opts) *BulkResult[string, time.Time]{
+		"Execute":       Execute[string, time.Time],
+		"ExecuteScoped": ExecuteScoped[string, time.Time],
+	}
+	for name, execute := range executors {
+		t.Run(name, func(t *testing.T) {
+			start := time.Now()
+			result := execute(context.Background(), urls, func(_ context.Context, url string) (time.Time, error) {
+				return time.Now(), nil
+			}, opts)
+
+			assert.Len(t, result.Successes, 5)
+			assert.Less(t, result.Successes[4].Output.Sub(start), 40*time.Millisecond,
+				"the item of b.com does not wait for the items of a.com")
+			assert.GreaterOrEqual(t, result.Successes[3].Output.Sub(start), 140*time.Millisecond)
+		})
+	}
+}
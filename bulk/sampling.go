@@ -0,0 +1,71 @@
+package bulk
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Sampling executes only a fraction of the items per run, so that very large
+// sets of items can be scraped within a fixed credit budget. The sample
+// rotates deterministically with Run, every item being part of the sample of
+// one of every 1/Rate consecutive runs. Rate is the fraction of the items
+// sampled per run, in (0, 1], every item being sampled if it is 0 or above 1.
+type Sampling struct {
+	Rate float64
+	Run  int
+}
+
+// Includes reports whether the item with the key, e.g. a product ID, is
+// part of the sample of the run. The position of an item in the rotation
+// depends on its key only, so that adding items does not shift the others.
+func (s *Sampling) Includes(key string) bool {
+	if !s.enabled() {
+		return true
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+
+	return s.includes(uint64(h.Sum32()), 1<<32)
+}
+
+// KeyOf returns the sampling key of the input: a string itself, the url, or
+// else the source and query, of an *oxylabs.Request, and the formatted value
+// of other inputs, e.g. an int.
+func KeyOf(input interface{}) string {
+	switch input := input.(type) {
+	case string:
+		return input
+	case *oxylabs.Request:
+		if input == nil {
+			return ""
+		}
+		if input.Url != "" {
+			return input.Url
+		}
+		return string(input.Source) + ":" + input.Query
+	}
+
+	return fmt.Sprint(input)
+}
+
+// enabled reports whether the sampling leaves out items.
+func (s *Sampling) enabled() bool {
+	return s != nil && s.Rate > 0 && s.Rate < 1
+}
+
+// includes reports whether the item at the position of the n positions is part
+// of the sample of the run, the window of the run starting where the window of
+// the previous run ended.
+func (s *Sampling) includes(position uint64, n uint64) bool {
+	width := uint64(math.Round(s.Rate * float64(n)))
+	if width == 0 {
+		width = 1
+	}
+	start := uint64(s.Run) * width % n
+
+	return (position+n-start)%n < width
+}
@@ -0,0 +1,99 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestSamplingRotation(t *testing.T) {
+	counts := make(map[string]int)
+	for run := 0; run < 4; run++ {
+		s := &Sampling{Rate: 0.25, Run: run}
+		for i := 0; i < 1000; i++ {
+			if key := fmt.Sprintf("item-%d", i); s.Includes(key) {
+				counts[key]++
+			}
+		}
+	}
+
+	assert.Len(t, counts, 1000)
+	for key, count := range counts {
+		assert.Equal(t, 1, count, key)
+	}
+}
+
+func TestExecuteSampling(t *testing.T) {
+	inputs := make([]int, 100)
+	for i := range inputs {
+		inputs[i] = i
+	}
+
+	executed := make(map[int]int)
+	for run := 0; run < 10; run++ {
+		result := Execute(context.Background(), inputs, func(_ context.Context, in int) (int, error) {
+			return in, nil
+		}, &Opts{Concurrency: 1, Sampling: &Sampling{Rate: 0.1, Run: run}})
+
+		assert.Equal(t, 100, result.Stats.Total+result.Stats.Skipped)
+		for _, s := range result.Successes {
+			executed[s.Input]++
+		}
+	}
+
+	assert.Len(t, executed, 100)
+	for input, count := range executed {
+		assert.Equal(t, 1, count, input)
+	}
+}
+
+func TestExecuteSamplingByKey(t *testing.T) {
+	sampled := func(inputs []string, opts *Opts) map[string]bool {
+		result := Execute(context.Background(), inputs, func(_ context.Context, in string) (string, error) {
+			return in, nil
+		}, opts)
+
+		sample := make(map[string]bool)
+		for _, s := range result.Successes {
+			sample[s.Input] = true
+		}
+		return sample
+	}
+
+	inputs := make([]string, 50)
+	for i := range inputs {
+		inputs[i] = fmt.Sprintf("product-%d", i)
+	}
+	sampling := &Sampling{Rate: 0.2, Run: 3}
+	before := sampled(inputs, &Opts{Sampling: sampling})
+
+	// Inserting an item doesn't shift the sample of the others.
+	after := sampled(append([]string{"product-new"}, inputs...), &Opts{Sampling: sampling})
+	delete(after, "product-new")
+	assert.Equal(t, before, after)
+
+	for input := range before {
+		assert.True(t, sampling.Includes(input))
+	}
+
+	// The key of the items is customizable.
+	custom := sampled(inputs, &Opts{Sampling: sampling, KeyOf: func(input interface{}) string {
+		return strings.TrimPrefix(input.(string), "product-")
+	}})
+	for input := range custom {
+		assert.True(t, sampling.Includes(strings.TrimPrefix(input, "product-")))
+	}
+}
+
+func TestKeyOf(t *testing.T) {
+	assert.Equal(t, "product-1", KeyOf("product-1"))
+	assert.Equal(t, "42", KeyOf(42))
+	assert.Equal(t, "https://example.com/p/1", KeyOf(&oxylabs.Request{Source: oxylabs.Universal, Url: "https://example.com/p/1"}))
+	assert.Equal(t, "amazon_product:B0", KeyOf(&oxylabs.Request{Source: oxylabs.AmazonProduct, Query: "B0"}))
+	assert.Equal(t, "", KeyOf((*oxylabs.Request)(nil)))
+}
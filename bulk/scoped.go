@@ -0,0 +1,125 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// outcome is the outcome of a single item of a scoped execution.
+type outcome[In, Out any] struct {
+	index    int
+	input    In
+	output   Out
+	err      error
+	duration time.Duration
+}
+
+// ExecuteScoped runs fn for every input concurrently like Execute, with structured
+// concurrency: every item runs with a child ctx of ctx, canceled as soon as the
+// item returns, and ExecuteScoped returns only once every goroutine it started
+// has exited. Cancelling ctx thus tears down every in-flight item, whose fn must
+// honour its ctx, and fails the items not yet started with the ctx error.
+// No goroutine outlives the call, even if ctx is never canceled.
+func ExecuteScoped[In, Out any](
+	ctx context.Context,
+	inputs []In,
+	fn func(context.Context, In) (Out, error),
+	opts ...*Opts,
+) *BulkResult[In, Out] {
+	// Prepare options.
+	opt := &Opts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	concurrency := opt.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+	domainOf := opt.DomainOf
+	if domainOf == nil {
+		domainOf = DomainOf
+	}
+	keyOf := opt.KeyOf
+	if keyOf == nil {
+		keyOf = KeyOf
+	}
+
+	// Pick the inputs of the sample of the run.
+	var (
+		indexes []int
+		skipped int
+	)
+	for i := range inputs {
+		if opt.Sampling.Includes(keyOf(inputs[i])) {
+			indexes = append(indexes, i)
+		} else {
+			skipped++
+		}
+	}
+
+	// The scope of the items: torn down once every item is done.
+	scope, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		mu     sync.Mutex
+		wg     sync.WaitGroup
+		result = &BulkResult[In, Out]{}
+	)
+	start := time.Now()
+
+	// Run every item once it may start, failing the items not yet started
+	// once ctx is done, and wait for every item to exit.
+	schedule(scope, indexes, func(i int) string {
+		return domainOf(inputs[i])
+	}, opt.Politeness, concurrency, func(i int, release func(), err error) {
+		if err != nil {
+			var zero Out
+			mu.Lock()
+			defer mu.Unlock()
+			result.record(i, inputs[i], zero, err, 0)
+			return
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer release()
+
+			o := runScoped(scope, i, inputs[i], fn)
+
+			mu.Lock()
+			defer mu.Unlock()
+			result.record(o.index, o.input, o.output, o.err, o.duration)
+		}()
+	})
+	wg.Wait()
+
+	result.sort()
+	result.Stats = result.stats(time.Since(start))
+	result.Stats.Skipped = skipped
+
+	return result
+}
+
+// runScoped runs fn for the item with a child ctx of the scope,
+// canceled once fn returns.
+func runScoped[In, Out any](
+	scope context.Context,
+	i int,
+	input In,
+	fn func(context.Context, In) (Out, error),
+) outcome[In, Out] {
+	ctx, cancel := context.WithCancel(scope)
+	defer cancel()
+
+	o := outcome[In, Out]{index: i, input: input}
+	itemStart := time.Now()
+	o.output, o.err = fn(ctx, input)
+	o.duration = time.Since(itemStart)
+
+	return o
+}
@@ -0,0 +1,118 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExecuteScoped(t *testing.T) {
+	errOdd := errors.New("odd")
+
+	result := ExecuteScoped(
+		context.Background(),
+		[]int{1, 2, 3, 4, 5},
+		func(_ context.Context, in int) (int, error) {
+			if in%2 != 0 {
+				return 0, errOdd
+			}
+			return in * 10, nil
+		},
+		&Opts{Concurrency: 2},
+	)
+
+	assert.Equal(t, 5, result.Stats.Total)
+	assert.Equal(t, 2, result.Stats.Succeeded)
+	assert.Equal(t, []int{1, 3}, []int{result.Successes[0].Index, result.Successes[1].Index})
+	assert.Equal(t, 40, result.Successes[1].Output)
+	assert.Equal(t, 4, result.Errors[2].Index)
+}
+
+func TestExecuteScoped_CancelTearsDownChildren(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var started, exited atomic.Int32
+	go func() {
+		// Cancel once the first wave of items is in flight.
+		for started.Load() < 3 {
+			time.Sleep(time.Millisecond)
+		}
+		cancel()
+	}()
+
+	inputs := make([]int, 10)
+	result := ExecuteScoped(ctx, inputs, func(ctx context.Context, _ int) (int, error) {
+		started.Add(1)
+		defer exited.Add(1)
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}, &Opts{Concurrency: 3})
+
+	assert.Equal(t, int32(3), started.Load(), "items not yet started are not run")
+	assert.Equal(t, started.Load(), exited.Load(), "every in-flight item exited before returning")
+	assert.Equal(t, 10, result.Stats.Failed)
+	for _, e := range result.Errors {
+		assert.ErrorIs(t, e, context.Canceled)
+	}
+	assertNoLeak(t, before)
+}
+
+func TestExecuteScoped_ChildCtxCanceledOnReturn(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	// Goroutines bound to the ctx of an item are torn down once the item returns.
+	var leaked atomic.Int32
+	result := ExecuteScoped(context.Background(), []int{1, 2, 3}, func(ctx context.Context, in int) (int, error) {
+		leaked.Add(1)
+		go func() {
+			<-ctx.Done()
+			leaked.Add(-1)
+		}()
+		return in, nil
+	})
+
+	assert.Equal(t, 3, result.Stats.Succeeded)
+	assert.Eventually(t, func() bool { return leaked.Load() == 0 }, time.Second, time.Millisecond)
+	assertNoLeak(t, before)
+}
+
+func TestExecuteScoped_CanceledContext(t *testing.T) {
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result := ExecuteScoped(ctx, []string{"a", "b"}, func(context.Context, string) (string, error) {
+		return "ok", nil
+	})
+
+	assert.Equal(t, 2, result.Stats.Failed)
+	assert.ErrorIs(t, result.Errors[0], context.Canceled)
+	assertNoLeak(t, before)
+}
+
+func TestExecuteScoped_Empty(t *testing.T) {
+	result := ExecuteScoped(context.Background(), nil, func(context.Context, int) (int, error) {
+		return 0, nil
+	})
+
+	assert.Equal(t, 0, result.Stats.Total)
+}
+
+// assertNoLeak asserts no goroutine started since before outlives the test.
+// It polls by hand, as assert.Eventually runs the condition in a goroutine.
+func assertNoLeak(t *testing.T, before int) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		if runtime.NumGoroutine() <= before {
+			return
+		}
+	}
+	t.Errorf("goroutines leaked: %d running, %d before", runtime.NumGoroutine(), before)
+}
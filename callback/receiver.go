@@ -0,0 +1,217 @@
+package callback
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// DefaultTTL is the time an unclaimed callback, or the id of a forgotten job,
+// is kept by a Receiver.
+const DefaultTTL = 10 * time.Minute
+
+// DefaultMaxUnclaimed is the number of unclaimed callbacks kept by a Receiver.
+const DefaultMaxUnclaimed = 10000
+
+// DefaultFallback is the time to wait for a job callback
+// before falling back to polling the job status.
+var DefaultFallback = 30 * time.Second
+
+// Job is the job info posted by Oxylabs to the callback_url once a job completes.
+type Job struct {
+	ID     string `json:"id"`
+	Status string `json:"status"`
+	Source string `json:"source"`
+}
+
+// ReceiverOpts configures a Receiver.
+// TTL is the time callbacks of jobs nobody awaits are kept, and callbacks of
+// forgotten jobs are dropped, DefaultTTL if unset.
+// MaxUnclaimed is the number of callbacks of jobs nobody awaits kept at once,
+// DefaultMaxUnclaimed if unset; further callbacks are dropped until some are
+// claimed or expire.
+// Verify is called with every callback req, which is rejected if it returns
+// false, e.g. SharedSecret or AllowIPs.
+// Now is the clock of the receiver, time.Now if unset.
+type ReceiverOpts struct {
+	TTL          time.Duration
+	MaxUnclaimed int
+	Verify       func(req *http.Request) bool
+	Now          func() time.Time
+}
+
+// Receiver is an http.Handler receiving Oxylabs job callbacks.
+// Mount it on a publicly reachable endpoint and use Url as the callback_url.
+type Receiver struct {
+	Url string
+
+	opts ReceiverOpts
+
+	mu        sync.Mutex
+	waiters   map[string]chan Job
+	arrived   map[string]arrival
+	forgotten map[string]time.Time
+}
+
+// arrival is a callback of a job nobody awaits yet.
+type arrival struct {
+	job Job
+	at  time.Time
+}
+
+// NewReceiver returns a Receiver reachable by Oxylabs at url,
+// configured with the last non-nil opts.
+func NewReceiver(url string, opts ...*ReceiverOpts) *Receiver {
+	r := &Receiver{
+		Url:       url,
+		waiters:   make(map[string]chan Job),
+		arrived:   make(map[string]arrival),
+		forgotten: make(map[string]time.Time),
+	}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		r.opts = *opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	if r.opts.TTL <= 0 {
+		r.opts.TTL = DefaultTTL
+	}
+	if r.opts.MaxUnclaimed <= 0 {
+		r.opts.MaxUnclaimed = DefaultMaxUnclaimed
+	}
+	if r.opts.Now == nil {
+		r.opts.Now = time.Now
+	}
+
+	return r
+}
+
+// SharedSecret returns a Verify func accepting the reqs whose query parameter
+// param is secret, to be set in the query of the callback url.
+func SharedSecret(param, secret string) func(req *http.Request) bool {
+	return func(req *http.Request) bool {
+		value := req.URL.Query().Get(param)
+		return subtle.ConstantTimeCompare([]byte(value), []byte(secret)) == 1
+	}
+}
+
+// AllowIPs returns a Verify func accepting the reqs from the given IPs or CIDR
+// ranges. Malformed entries are ignored.
+func AllowIPs(ips ...string) func(req *http.Request) bool {
+	var nets []*net.IPNet
+	for _, ip := range ips {
+		if _, ipNet, err := net.ParseCIDR(ip); err == nil {
+			nets = append(nets, ipNet)
+		} else if parsed := net.ParseIP(ip); parsed != nil {
+			nets = append(nets, &net.IPNet{IP: parsed, Mask: net.CIDRMask(len(parsed)*8, len(parsed)*8)})
+		}
+	}
+
+	return func(req *http.Request) bool {
+		host, _, err := net.SplitHostPort(req.RemoteAddr)
+		if err != nil {
+			host = req.RemoteAddr
+		}
+		ip := net.ParseIP(host)
+		for _, ipNet := range nets {
+			if ip != nil && ipNet.Contains(ip) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// ServeHTTP decodes the posted job and delivers it to the job's waiter.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if r.opts.Verify != nil && !r.opts.Verify(req) {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+
+	job := Job{}
+	if err := json.NewDecoder(req.Body).Decode(&job); err != nil || job.ID == "" {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	r.deliver(job)
+	w.WriteHeader(http.StatusOK)
+}
+
+// Await returns a channel receiving the job once its callback arrives.
+// If the callback already arrived, the job is delivered immediately.
+func (r *Receiver) Await(jobID string) <-chan Job {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.expire()
+	delete(r.forgotten, jobID)
+
+	jobChan := make(chan Job, 1)
+	if arrival, ok := r.arrived[jobID]; ok {
+		delete(r.arrived, jobID)
+		jobChan <- arrival.job
+		return jobChan
+	}
+
+	r.waiters[jobID] = jobChan
+	return jobChan
+}
+
+// Forget drops any pending waiter or undelivered callback for the job.
+// Callbacks of the job arriving within the TTL of the receiver are dropped.
+func (r *Receiver) Forget(jobID string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.waiters, jobID)
+	delete(r.arrived, jobID)
+	r.forgotten[jobID] = r.opts.Now()
+}
+
+// deliver sends the job to its waiter, or keeps it until the job is awaited.
+// Callbacks of forgotten jobs, and callbacks beyond the unclaimed limit, are dropped.
+func (r *Receiver) deliver(job Job) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if jobChan, ok := r.waiters[job.ID]; ok {
+		delete(r.waiters, job.ID)
+		jobChan <- job
+		return
+	}
+
+	r.expire()
+	if _, ok := r.forgotten[job.ID]; ok {
+		return
+	}
+	if _, ok := r.arrived[job.ID]; !ok && len(r.arrived) >= r.opts.MaxUnclaimed {
+		return
+	}
+
+	r.arrived[job.ID] = arrival{job: job, at: r.opts.Now()}
+}
+
+// expire drops the unclaimed callbacks and forgotten jobs older than the TTL.
+func (r *Receiver) expire() {
+	deadline := r.opts.Now().Add(-r.opts.TTL)
+	for jobID, arrival := range r.arrived {
+		if arrival.at.Before(deadline) {
+			delete(r.arrived, jobID)
+		}
+	}
+	for jobID, at := range r.forgotten {
+		if at.Before(deadline) {
+			delete(r.forgotten, jobID)
+		}
+	}
+}
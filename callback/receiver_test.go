@@ -0,0 +1,115 @@
+package callback
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReceiver_DeliversToWaiter(t *testing.T) {
+	r := NewReceiver("https://example.com/callback")
+	jobChan := r.Await("123")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id":"123","status":"done"}`)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, Job{ID: "123", Status: "done"}, <-jobChan)
+}
+
+func TestReceiver_CallbackBeforeAwait(t *testing.T) {
+	r := NewReceiver("https://example.com/callback")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"id":"123","status":"faulted"}`)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, Job{ID: "123", Status: "faulted"}, <-r.Await("123"))
+}
+
+func TestReceiver_InvalidRequests(t *testing.T) {
+	r := NewReceiver("https://example.com/callback")
+
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+
+	rec = httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"status":"done"}`)))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+// post posts the job callback body to the receiver and returns the status code.
+func post(r *Receiver, target, body string) int {
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, target, strings.NewReader(body)))
+	return rec.Code
+}
+
+func TestReceiver_UnclaimedCallbacks(t *testing.T) {
+	now := time.Now()
+	r := NewReceiver("https://example.com/callback", &ReceiverOpts{
+		TTL:          time.Minute,
+		MaxUnclaimed: 2,
+		Now:          func() time.Time { return now },
+	})
+
+	for _, id := range []string{"1", "2", "3"} {
+		assert.Equal(t, http.StatusOK, post(r, "/", `{"id":"`+id+`","status":"done"}`))
+	}
+	assert.Len(t, r.arrived, 2, "callbacks beyond the limit are dropped")
+
+	now = now.Add(2 * time.Minute)
+	post(r, "/", `{"id":"4","status":"done"}`)
+	assert.Len(t, r.arrived, 1, "expired callbacks are dropped")
+
+	select {
+	case <-r.Await("1"):
+		assert.Fail(t, "expired callback delivered")
+	default:
+	}
+	assert.Equal(t, Job{ID: "4", Status: "done"}, <-r.Await("4"))
+}
+
+func TestReceiver_ForgottenJobs(t *testing.T) {
+	now := time.Now()
+	r := NewReceiver("https://example.com/callback", &ReceiverOpts{TTL: time.Minute, Now: func() time.Time { return now }})
+
+	r.Await("123")
+	r.Forget("123")
+	post(r, "/", `{"id":"123","status":"done"}`)
+	assert.Empty(t, r.arrived, "late callbacks of forgotten jobs are dropped")
+	assert.Empty(t, r.waiters)
+
+	now = now.Add(2 * time.Minute)
+	post(r, "/", `{"id":"456","status":"done"}`)
+	assert.Empty(t, r.forgotten, "forgotten jobs expire")
+}
+
+func TestReceiver_Verify(t *testing.T) {
+	r := NewReceiver("https://example.com/callback?token=s3cret", &ReceiverOpts{Verify: SharedSecret("token", "s3cret")})
+	assert.Equal(t, http.StatusForbidden, post(r, "/callback", `{"id":"1"}`))
+	assert.Equal(t, http.StatusForbidden, post(r, "/callback?token=wrong", `{"id":"1"}`))
+	assert.Equal(t, http.StatusOK, post(r, "/callback?token=s3cret", `{"id":"1"}`))
+	assert.Len(t, r.arrived, 1)
+
+	allow := AllowIPs("192.0.2.10", "198.51.100.0/24", "not an ip")
+	tests := []struct {
+		remoteAddr string
+		want       bool
+	}{
+		{remoteAddr: "192.0.2.10:1234", want: true},
+		{remoteAddr: "198.51.100.7:1234", want: true},
+		{remoteAddr: "192.0.2.11:1234"},
+		{remoteAddr: "garbage"},
+	}
+	for _, tt := range tests {
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.RemoteAddr = tt.remoteAddr
+		assert.Equal(t, tt.want, allow(req), tt.remoteAddr)
+	}
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// maxStringLen is the max length of the strings asserted by the generated test,
+// longer strings being raw content rather than fields of the models.
+const maxStringLen = 200
+
+// assertions returns the assertions of the generated test on the resp decoded
+// from a fixture: the value of every scalar field set, and the length and first
+// element of every slice, so that fields the models stop decoding fail the test.
+func assertions(resp interface{}) []string {
+	var lines []string
+	walk(reflect.ValueOf(resp), "resp", &lines)
+
+	return lines
+}
+
+// walk appends the assertions on the value v of the expression path to lines.
+// The assertions guarding the access to nested values return on failure,
+// so that the test reports the drift instead of panicking.
+func walk(v reflect.Value, path string, lines *[]string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return
+		}
+		if path != "resp" {
+			*lines = append(*lines, fmt.Sprintf("if !assert.NotNil(t, %s) {\nreturn\n}", path))
+		}
+		if isScalar(v.Elem().Kind()) {
+			path = "*" + path
+		}
+		walk(v.Elem(), path, lines)
+	case reflect.Interface:
+		if !v.IsNil() && isScalar(v.Elem().Kind()) {
+			walk(v.Elem(), path, lines)
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if field := v.Type().Field(i); field.IsExported() {
+				walk(v.Field(i), path+"."+field.Name, lines)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Len() == 0 || v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		*lines = append(*lines, fmt.Sprintf("if !assert.Len(t, %s, %d) {\nreturn\n}", path, v.Len()))
+		walk(v.Index(0), path+"[0]", lines)
+	case reflect.Map:
+		if v.Len() == 0 || v.Type().Key().Kind() != reflect.String {
+			return
+		}
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return keys[i].String() < keys[j].String() })
+		for _, key := range keys {
+			walk(v.MapIndex(key), fmt.Sprintf("%s[%q]", path, key.String()), lines)
+		}
+	case reflect.String:
+		if s := v.String(); s != "" && len(s) <= maxStringLen {
+			*lines = append(*lines, fmt.Sprintf("assert.EqualValues(t, %q, %s)", s, path))
+		}
+	case reflect.Bool:
+		if v.Bool() {
+			*lines = append(*lines, fmt.Sprintf("assert.EqualValues(t, true, %s)", path))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if v.Int() != 0 {
+			*lines = append(*lines, fmt.Sprintf("assert.EqualValues(t, %d, %s)", v.Int(), path))
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if v.Uint() != 0 {
+			*lines = append(*lines, fmt.Sprintf("assert.EqualValues(t, %d, %s)", v.Uint(), path))
+		}
+	case reflect.Float32, reflect.Float64:
+		if v.Float() != 0 {
+			*lines = append(*lines, fmt.Sprintf("assert.EqualValues(t, %s, %s)", strconv.FormatFloat(v.Float(), 'g', -1, 64), path))
+		}
+	}
+}
+
+// isScalar returns whether values of the kind are asserted by value.
+func isScalar(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Ptr, reflect.Interface, reflect.Struct, reflect.Slice, reflect.Array, reflect.Map:
+		return false
+	}
+
+	return kind != reflect.Invalid
+}
@@ -0,0 +1,296 @@
+// Command fixturegen fetches one live response per source of a package, with
+// the credentials of the OXYLABS_USERNAME and OXYLABS_PASSWORD environment
+// variables, and writes the sanitized responses as fixtures to testdata/fixtures
+// along with a fixtures_test.go decoding them into the typed models. The test
+// asserts the value of every field decoded at generation, so that drift of the
+// models against the API is caught by go test.
+//
+// It is run by go generate in the serp and ecommerce packages:
+//
+//	OXYLABS_USERNAME=user OXYLABS_PASSWORD=pass go generate ./serp ./ecommerce
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/ecommerce"
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/serp"
+)
+
+// fixture is the req of the fixture of a source.
+type fixture struct {
+	Source oxylabs.Source
+	New    func() (*oxylabs.Request, error)
+}
+
+// fixtures are the fixtures per package.
+var fixtures = map[string][]fixture{
+	"serp": {
+		{oxylabs.GoogleSearch, func() (*oxylabs.Request, error) {
+			return serp.NewGoogleSearchRequest("adidas", &serp.GoogleSearchOpts{Parse: oxylabs.Bool(true)})
+		}},
+		{oxylabs.GoogleAds, func() (*oxylabs.Request, error) {
+			return serp.NewGoogleAdsRequest("adidas", &serp.GoogleAdsOpts{Parse: oxylabs.Bool(true)})
+		}},
+		{oxylabs.BingSearch, func() (*oxylabs.Request, error) {
+			return serp.NewBingSearchRequest("adidas", &serp.BingSearchOpts{Parse: oxylabs.Bool(true)})
+		}},
+	},
+	"ecommerce": {
+		{oxylabs.AmazonSearch, func() (*oxylabs.Request, error) {
+			return ecommerce.NewAmazonSearchRequest("nirvana tshirt", &ecommerce.AmazonSearchOpts{Parse: oxylabs.Bool(true)})
+		}},
+		{oxylabs.AmazonProduct, func() (*oxylabs.Request, error) {
+			return ecommerce.NewAmazonProductRequest("B07FZ8S74R", &ecommerce.AmazonProductOpts{Parse: oxylabs.Bool(true)})
+		}},
+		{oxylabs.GoogleShoppingSearch, func() (*oxylabs.Request, error) {
+			return ecommerce.NewGoogleShoppingSearchRequest("adidas", &ecommerce.GoogleShoppingSearchOpts{Parse: oxylabs.Bool(true)})
+		}},
+	},
+}
+
+// sanitizedKeys are the keys whose values are replaced in the fixtures,
+// as they identify the account or the job.
+var sanitizedKeys = map[string]interface{}{
+	"id":           "00000000000000000000",
+	"job_id":       "00000000000000000000",
+	"client_id":    0,
+	"username":     "user",
+	"session_id":   "",
+	"callback_url": "",
+	"created_at":   "2024-01-01 00:00:00",
+	"updated_at":   "2024-01-01 00:00:00",
+}
+
+// decoders decode the fixture resps of a package into its typed resp, as the
+// generated test does.
+var decoders = map[string]func(*http.Response) (interface{}, error){
+	"serp": func(httpResp *http.Response) (interface{}, error) {
+		return serp.GetResp(httpResp, true, false)
+	},
+	"ecommerce": func(httpResp *http.Response) (interface{}, error) {
+		return ecommerce.GetResp(httpResp, true, false)
+	},
+}
+
+// testFixture is a fixture of the generated test, with the assertions on its resp.
+type testFixture struct {
+	Source     string
+	Assertions []string
+}
+
+var testTemplate = template.Must(template.New("test").Parse(`// Code generated by fixturegen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixtures(t *testing.T) {
+	tests := []struct {
+		source string
+		check  func(t *testing.T, resp *Resp)
+	}{
+{{- range .Fixtures}}
+		{
+			source: "{{.Source}}",
+			check: func(t *testing.T, resp *Resp) {
+{{- range .Assertions}}
+				{{.}}
+{{- end}}
+			},
+		},
+{{- end}}
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.source, func(t *testing.T) {
+			f, err := os.Open("testdata/fixtures/" + tt.source + ".json")
+			if !assert.NoError(t, err) {
+				return
+			}
+			defer f.Close()
+
+			resp, err := GetResp(&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(f)}, true, false)
+			if !assert.NoError(t, err) {
+				return
+			}
+			tt.check(t, resp)
+		})
+	}
+}
+`))
+
+func main() {
+	pkg := flag.String("package", "", "package of the fixtures, serp or ecommerce")
+	dir := flag.String("dir", ".", "directory of the package")
+	timeout := flag.Duration("timeout", 2*time.Minute, "timeout of each req")
+	flag.Parse()
+
+	if err := run(*pkg, *dir, *timeout); err != nil {
+		log.Fatalf("fixturegen: %v", err)
+	}
+}
+
+// run writes the fixtures and decoding test of the package to dir.
+func run(pkg string, dir string, timeout time.Duration) error {
+	pkgFixtures, ok := fixtures[pkg]
+	if !ok {
+		return fmt.Errorf("invalid package: %s", pkg)
+	}
+
+	username, password := os.Getenv("OXYLABS_USERNAME"), os.Getenv("OXYLABS_PASSWORD")
+	if username == "" || password == "" {
+		return fmt.Errorf("OXYLABS_USERNAME and OXYLABS_PASSWORD must be set")
+	}
+	c := internal.NewClient(internal.SyncBaseUrl, username, password)
+
+	fixturesDir := filepath.Join(dir, "testdata", "fixtures")
+	if err := os.MkdirAll(fixturesDir, 0o755); err != nil {
+		return fmt.Errorf("error creating fixtures dir: %v", err)
+	}
+
+	var testFixtures []testFixture
+	for _, f := range pkgFixtures {
+		content, err := fetch(c, f, timeout)
+		if err != nil {
+			return fmt.Errorf("error fetching %s: %v", f.Source, err)
+		}
+
+		path := filepath.Join(fixturesDir, string(f.Source)+".json")
+		if err := os.WriteFile(path, content, 0o644); err != nil {
+			return fmt.Errorf("error writing fixture: %v", err)
+		}
+		log.Printf("fixturegen: wrote %s", path)
+
+		resp, err := decoders[pkg](&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(content))})
+		if err != nil {
+			return fmt.Errorf("error decoding %s: %v", f.Source, err)
+		}
+		testFixtures = append(testFixtures, testFixture{Source: string(f.Source), Assertions: assertions(resp)})
+	}
+	sort.Slice(testFixtures, func(i, j int) bool { return testFixtures[i].Source < testFixtures[j].Source })
+
+	test, err := generateTest(pkg, testFixtures)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, "fixtures_test.go"), test, 0o644)
+}
+
+// generateTest returns the formatted decoding test of the fixtures of the package.
+func generateTest(pkg string, testFixtures []testFixture) ([]byte, error) {
+	var test bytes.Buffer
+	if err := testTemplate.Execute(&test, struct {
+		Package  string
+		Fixtures []testFixture
+	}{pkg, testFixtures}); err != nil {
+		return nil, fmt.Errorf("error executing test template: %v", err)
+	}
+
+	formatted, err := format.Source(test.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error formatting test: %v", err)
+	}
+
+	return formatted, nil
+}
+
+// fetch returns the sanitized live response of the fixture.
+func fetch(c *internal.Client, f fixture, timeout time.Duration) ([]byte, error) {
+	req, err := f.New()
+	if err != nil {
+		return nil, err
+	}
+	payload, err := req.Payload()
+	if err != nil {
+		return nil, err
+	}
+	jsonPayload, err := oxylabs.MarshalPayload(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	httpResp, err := c.Req(ctx, jsonPayload, "POST")
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	respBody, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, &oxylabs.StatusError{StatusCode: httpResp.StatusCode, Status: httpResp.Status, Body: respBody}
+	}
+
+	return sanitize(respBody)
+}
+
+// sanitize replaces the values of the sanitized keys of the json content
+// and indents it.
+func sanitize(content []byte) ([]byte, error) {
+	var v interface{}
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+	if err := decoder.Decode(&v); err != nil {
+		return nil, fmt.Errorf("error unmarshalling resp body: %v", err)
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(sanitizeValue(v)); err != nil {
+		return nil, fmt.Errorf("error marshalling fixture: %v", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// sanitizeValue replaces the values of the sanitized keys of v.
+func sanitizeValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if replacement, ok := sanitizedKeys[strings.ToLower(key)]; ok && value != nil {
+				v[key] = replacement
+				continue
+			}
+			v[key] = sanitizeValue(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = sanitizeValue(value)
+		}
+	}
+
+	return v
+}
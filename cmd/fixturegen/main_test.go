@@ -0,0 +1,82 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitize(t *testing.T) {
+	content := []byte(`{"job":{"id":"7123","client_id":42,"query":"adidas"},"results":[{"job_id":"7123","page":1,"created_at":"2024-05-01 10:00:00"}]}`)
+
+	sanitized, err := sanitize(content)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"job": {"id": "00000000000000000000", "client_id": 0, "query": "adidas"},
+		"results": [{"job_id": "00000000000000000000", "page": 1, "created_at": "2024-01-01 00:00:00"}]
+	}`, string(sanitized))
+}
+
+func TestAssertions(t *testing.T) {
+	type link struct {
+		Title string
+		Url   *string
+	}
+	url := "https://adidas.com"
+	resp := &struct {
+		Query   string
+		Page    int
+		Price   float64
+		Parsed  bool
+		Empty   string
+		Raw     []byte
+		Links   []link
+		Extra   map[string]interface{}
+		private string
+	}{
+		Query:   "adidas",
+		Page:    2,
+		Price:   4.5,
+		Parsed:  true,
+		Raw:     []byte("{}"),
+		Links:   []link{{Title: "Adidas", Url: &url}, {Title: "Shoes"}},
+		Extra:   map[string]interface{}{"b": 3.0, "a": "x", "nested": map[string]interface{}{"c": 1}},
+		private: "hidden",
+	}
+
+	assert.Equal(t, []string{
+		`assert.EqualValues(t, "adidas", resp.Query)`,
+		`assert.EqualValues(t, 2, resp.Page)`,
+		`assert.EqualValues(t, 4.5, resp.Price)`,
+		`assert.EqualValues(t, true, resp.Parsed)`,
+		"if !assert.Len(t, resp.Links, 2) {\nreturn\n}",
+		`assert.EqualValues(t, "Adidas", resp.Links[0].Title)`,
+		"if !assert.NotNil(t, resp.Links[0].Url) {\nreturn\n}",
+		`assert.EqualValues(t, "https://adidas.com", *resp.Links[0].Url)`,
+		`assert.EqualValues(t, "x", resp.Extra["a"])`,
+		`assert.EqualValues(t, 3, resp.Extra["b"])`,
+	}, assertions(resp))
+}
+
+func TestGenerateTest(t *testing.T) {
+	content := []byte(`{"results":[{"content":{"results":{"organic":[{"pos":1,"url":"https://adidas.com","title":"Adidas"}]}},"page":1}],"job":{"query":"adidas","source":"google_search"}}`)
+	resp, err := decoders["serp"](&http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(content))})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	test, err := generateTest("serp", []testFixture{{Source: "google_search", Assertions: assertions(resp)}})
+	assert.NoError(t, err)
+	for _, line := range []string{
+		`source: "google_search",`,
+		`assert.EqualValues(t, "adidas", resp.Job.Query)`,
+		`assert.EqualValues(t, "google_search", resp.Job.Source)`,
+		`assert.EqualValues(t, "Adidas", resp.Results[0].ContentParsed.Results.Organic[0].Title)`,
+		`assert.EqualValues(t, 1, resp.Results[0].ContentParsed.Results.Organic[0].Pos)`,
+	} {
+		assert.Contains(t, string(test), line)
+	}
+}
@@ -0,0 +1,179 @@
+package diff
+
+import (
+	"reflect"
+
+	"github.com/revvim/oxylabs-sdk-go/ecommerce"
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/serp"
+)
+
+// Kind is the kind of change of an item between two result sets.
+type Kind string
+
+const (
+	Added   Kind = "added"
+	Removed Kind = "removed"
+	Changed Kind = "changed"
+)
+
+// Change is the change of a single item, identified by Key.
+// Old is the zero value for added items and New for removed ones.
+// PriceDelta and RankDelta are New minus Old for changed items.
+type Change[T any] struct {
+	Key        string
+	Kind       Kind
+	Old        T
+	New        T
+	PriceDelta float64
+	RankDelta  int
+}
+
+// Report is the list of changes between two result sets.
+type Report[T any] struct {
+	Added   []Change[T]
+	Removed []Change[T]
+	Changed []Change[T]
+}
+
+// Empty returns whether the result sets are identical.
+func (r *Report[T]) Empty() bool {
+	return len(r.Added) == 0 && len(r.Removed) == 0 && len(r.Changed) == 0
+}
+
+// Opts contains the options of the comparison.
+// Price and Rank, if set, extract the price and rank of an item,
+// Equal reports whether two items with the same key are unchanged,
+// reflect.DeepEqual if not set.
+type Opts[T any] struct {
+	Price func(T) float64
+	Rank  func(T) int
+	Equal func(a, b T) bool
+}
+
+// Compare compares the old and new items identified by key.
+// Items with an empty key are ignored, the first item wins for duplicate keys.
+func Compare[T any](oldItems, newItems []T, key func(T) string, opts ...*Opts[T]) *Report[T] {
+	// Prepare options.
+	opt := &Opts[T]{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	equal := opt.Equal
+	if equal == nil {
+		equal = func(a, b T) bool { return reflect.DeepEqual(a, b) }
+	}
+
+	oldKeys, oldByKey := index(oldItems, key)
+	newKeys, newByKey := index(newItems, key)
+	report := &Report[T]{}
+
+	for _, k := range oldKeys {
+		if _, ok := newByKey[k]; !ok {
+			report.Removed = append(report.Removed, Change[T]{Key: k, Kind: Removed, Old: oldByKey[k]})
+		}
+	}
+
+	for _, k := range newKeys {
+		item := newByKey[k]
+		old, ok := oldByKey[k]
+		if !ok {
+			report.Added = append(report.Added, Change[T]{Key: k, Kind: Added, New: item})
+			continue
+		}
+
+		change := Change[T]{Key: k, Kind: Changed, Old: old, New: item}
+		if opt.Price != nil {
+			change.PriceDelta = opt.Price(item) - opt.Price(old)
+		}
+		if opt.Rank != nil {
+			change.RankDelta = opt.Rank(item) - opt.Rank(old)
+		}
+
+		if change.PriceDelta != 0 || change.RankDelta != 0 || !equal(old, item) {
+			report.Changed = append(report.Changed, change)
+		}
+	}
+
+	return report
+}
+
+// index returns the keys of the items in order and the first item of every
+// key, skipping items with an empty key.
+func index[T any](items []T, key func(T) string) ([]string, map[string]T) {
+	keys := make([]string, 0, len(items))
+	byKey := make(map[string]T, len(items))
+	for _, item := range items {
+		k := key(item)
+		if _, ok := byKey[k]; !ok && k != "" {
+			keys = append(keys, k)
+			byKey[k] = item
+		}
+	}
+
+	return keys, byKey
+}
+
+// SerpOrganic compares the organic results of two serp resps of the same source and query,
+// identified by url, reporting rank changes over all pages of the resps.
+func SerpOrganic(oldResp, newResp *serp.Resp) *Report[serp.Organic] {
+	return Compare(
+		organic(oldResp),
+		organic(newResp),
+		func(o serp.Organic) string { return o.Url },
+		&Opts[serp.Organic]{
+			Rank:  func(o serp.Organic) int { return o.PosOverall },
+			Equal: func(a, b serp.Organic) bool { return a.Title == b.Title && a.Desc == b.Desc },
+		},
+	)
+}
+
+// Products compares the products of two ecommerce resps of the same source and query,
+// identified by their first identifier or title, reporting price changes.
+func Products(oldResp, newResp *ecommerce.Resp) (*Report[ecommerce.ProductResult], error) {
+	oldProducts, err := oldResp.Products()
+	if err != nil {
+		return nil, err
+	}
+
+	newProducts, err := newResp.Products()
+	if err != nil {
+		return nil, err
+	}
+
+	return Compare(
+		oldProducts,
+		newProducts,
+		ecommerce.ProductResult.Key,
+		&Opts[ecommerce.ProductResult]{
+			Price: func(p ecommerce.ProductResult) float64 { return p.Price.Amount },
+		},
+	), nil
+}
+
+// organic returns the organic results of every page of the resp. Results
+// without a position over all pages get one from their page and position,
+// with the limit of the job, or the default limit, as results per page.
+func organic(resp *serp.Resp) []serp.Organic {
+	perPage := resp.Job.Limit
+	if perPage <= 0 {
+		perPage = internal.DefaultLimit_SERP
+	}
+
+	var items []serp.Organic
+	for _, result := range resp.Results {
+		page := result.Page
+		if page <= 0 {
+			page = result.ContentParsed.Page
+		}
+		for _, item := range result.ContentParsed.Results.Organic {
+			if item.PosOverall == 0 {
+				item.PosOverall = max(page-1, 0)*perPage + item.Pos
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
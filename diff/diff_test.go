@@ -0,0 +1,81 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/serp"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	Url   string
+	Rank  int
+	Price float64
+}
+
+func TestCompare(t *testing.T) {
+	oldItems := []item{{"a", 1, 10}, {"b", 2, 20}, {"c", 3, 30}}
+	newItems := []item{{"b", 1, 25}, {"c", 3, 30}, {"d", 2, 5}}
+
+	report := Compare(oldItems, newItems, func(i item) string { return i.Url }, &Opts[item]{
+		Price: func(i item) float64 { return i.Price },
+		Rank:  func(i item) int { return i.Rank },
+	})
+
+	assert.Equal(t, []Change[item]{{Key: "a", Kind: Removed, Old: oldItems[0]}}, report.Removed)
+	assert.Equal(t, []Change[item]{{Key: "d", Kind: Added, New: newItems[2]}}, report.Added)
+	assert.Equal(t, []Change[item]{{
+		Key:        "b",
+		Kind:       Changed,
+		Old:        oldItems[1],
+		New:        newItems[0],
+		PriceDelta: 5,
+		RankDelta:  -1,
+	}}, report.Changed)
+	assert.False(t, report.Empty())
+
+	assert.True(t, Compare(oldItems, oldItems, func(i item) string { return i.Url }).Empty())
+}
+
+func TestCompareDuplicateKeys(t *testing.T) {
+	oldItems := []item{{"a", 1, 10}, {"a", 1, 10}, {"", 2, 20}}
+	newItems := []item{{"b", 1, 10}, {"b", 2, 15}, {"", 3, 30}}
+
+	report := Compare(oldItems, newItems, func(i item) string { return i.Url })
+
+	assert.Equal(t, []Change[item]{{Key: "a", Kind: Removed, Old: oldItems[0]}}, report.Removed, "identical duplicates are removed once")
+	assert.Equal(t, []Change[item]{{Key: "b", Kind: Added, New: newItems[0]}}, report.Added, "the first item wins")
+	assert.Empty(t, report.Changed)
+}
+
+// serpPages returns a serp resp with a page per list of organic results.
+func serpPages(limit int, pages ...[]serp.Organic) *serp.Resp {
+	resp := &serp.Resp{Job: serp.Job{Limit: limit}}
+	for i, organic := range pages {
+		result := serp.Results{Page: i + 1}
+		result.ContentParsed.Results.Organic = organic
+		resp.Results = append(resp.Results, result)
+	}
+
+	return resp
+}
+
+func TestSerpOrganicPages(t *testing.T) {
+	oldResp := serpPages(2,
+		[]serp.Organic{{Pos: 1, Url: "a"}, {Pos: 2, Url: "b"}},
+		[]serp.Organic{{Pos: 1, Url: "c"}, {Pos: 2, Url: "d"}},
+	)
+	newResp := serpPages(2,
+		[]serp.Organic{{Pos: 1, Url: "c"}, {Pos: 2, Url: "b"}},
+		[]serp.Organic{{Pos: 1, Url: "a"}, {Pos: 2, Url: "d", PosOverall: 4}},
+	)
+
+	report := SerpOrganic(oldResp, newResp)
+	deltas := map[string]int{}
+	for _, change := range report.Changed {
+		deltas[change.Key] = change.RankDelta
+	}
+	assert.Equal(t, map[string]int{"a": 2, "c": -2}, deltas, "#1 of page 1 and #1 of page 2 are different ranks")
+	assert.Empty(t, report.Added)
+	assert.Empty(t, report.Removed)
+}
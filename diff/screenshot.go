@@ -0,0 +1,126 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"math"
+	"math/bits"
+)
+
+var pngSignature = []byte("\x89PNG\r\n\x1a\n")
+
+// screenshotGrid is the size of the grid of the luminance of the screenshots compared.
+const screenshotGrid = 64
+
+// cellSamples is the number of pixels sampled per cell side of the grid.
+const cellSamples = 4
+
+// ScreenshotDiff is the perceptual difference between two screenshots.
+// Score ranges from 0 for identical to 1 for opposite screenshots, as the mean
+// difference of their luminance on a grid, which ignores compression noise and
+// small rendering differences. HashDistance is the number of differing bits,
+// out of 64, of their difference hashes. SizeChanged reports whether the
+// dimensions of the screenshots differ, e.g. for a longer page.
+type ScreenshotDiff struct {
+	Score        float64
+	HashDistance int
+	SizeChanged  bool
+}
+
+// Changed returns whether the screenshots differ by more than the threshold score.
+func (d ScreenshotDiff) Changed(threshold float64) bool {
+	return d.Score > threshold
+}
+
+// DecodeScreenshot returns the png of the content of a result of a scrape with
+// png rendering, given either base64 encoded or as is.
+func DecodeScreenshot(content string) ([]byte, error) {
+	if bytes.HasPrefix([]byte(content), pngSignature) {
+		return []byte(content), nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(content)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding screenshot: %v", err)
+	}
+	if !bytes.HasPrefix(decoded, pngSignature) {
+		return nil, fmt.Errorf("error decoding screenshot: content is not a png")
+	}
+
+	return decoded, nil
+}
+
+// CompareScreenshots compares two png screenshots, e.g. of the same product
+// page scraped at different times, and returns their perceptual difference.
+func CompareScreenshots(a, b []byte) (ScreenshotDiff, error) {
+	imgA, err := png.Decode(bytes.NewReader(a))
+	if err != nil {
+		return ScreenshotDiff{}, fmt.Errorf("error decoding first screenshot: %v", err)
+	}
+	imgB, err := png.Decode(bytes.NewReader(b))
+	if err != nil {
+		return ScreenshotDiff{}, fmt.Errorf("error decoding second screenshot: %v", err)
+	}
+
+	gridA := luminanceGrid(imgA, screenshotGrid, screenshotGrid)
+	gridB := luminanceGrid(imgB, screenshotGrid, screenshotGrid)
+	var total float64
+	for i := range gridA {
+		total += math.Abs(gridA[i] - gridB[i])
+	}
+
+	return ScreenshotDiff{
+		Score:        total / float64(len(gridA)),
+		HashDistance: bits.OnesCount64(differenceHash(imgA) ^ differenceHash(imgB)),
+		SizeChanged:  imgA.Bounds().Size() != imgB.Bounds().Size(),
+	}, nil
+}
+
+// differenceHash returns the 64 bit difference hash of the image: whether each
+// cell of a 9x8 luminance grid is brighter than its right neighbour.
+func differenceHash(img image.Image) uint64 {
+	grid := luminanceGrid(img, 9, 8)
+
+	var hash uint64
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			hash <<= 1
+			if grid[y*9+x] > grid[y*9+x+1] {
+				hash |= 1
+			}
+		}
+	}
+
+	return hash
+}
+
+// luminanceGrid returns the mean luminance, from 0 to 1, of every cell of a
+// width by height grid laid over the image, row by row.
+func luminanceGrid(img image.Image, width, height int) []float64 {
+	bounds := img.Bounds()
+	grid := make([]float64, width*height)
+	if bounds.Empty() {
+		return grid
+	}
+
+	for cy := 0; cy < height; cy++ {
+		for cx := 0; cx < width; cx++ {
+			var sum float64
+			for sy := 0; sy < cellSamples; sy++ {
+				for sx := 0; sx < cellSamples; sx++ {
+					// Sample the pixels evenly spread over the cell.
+					x := bounds.Min.X + ((cx*cellSamples+sx)*2+1)*bounds.Dx()/(width*cellSamples*2)
+					y := bounds.Min.Y + ((cy*cellSamples+sy)*2+1)*bounds.Dy()/(height*cellSamples*2)
+					r, g, b, _ := img.At(x, y).RGBA()
+					sum += (0.299*float64(r) + 0.587*float64(g) + 0.114*float64(b)) / 0xffff
+				}
+			}
+			grid[cy*width+cx] = sum / (cellSamples * cellSamples)
+		}
+	}
+
+	return grid
+}
@@ -0,0 +1,74 @@
+package diff
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// screenshot returns a white png of the size with a black box, if any.
+func screenshot(t *testing.T, width, height int, box image.Rectangle) []byte {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			if image.Pt(x, y).In(box) {
+				img.SetGray(x, y, color.Gray{})
+			} else {
+				img.SetGray(x, y, color.Gray{Y: 255})
+			}
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	assert.NoError(t, png.Encode(buf, img))
+	return buf.Bytes()
+}
+
+func TestCompareScreenshots(t *testing.T) {
+	page := screenshot(t, 400, 300, image.Rect(50, 50, 150, 100))
+
+	same, err := CompareScreenshots(page, page)
+	assert.NoError(t, err)
+	assert.Equal(t, ScreenshotDiff{}, same)
+
+	moved, err := CompareScreenshots(page, screenshot(t, 400, 300, image.Rect(250, 150, 350, 200)))
+	assert.NoError(t, err)
+	assert.True(t, moved.Changed(0.01))
+	assert.Greater(t, moved.HashDistance, 0)
+	assert.False(t, moved.SizeChanged)
+
+	inverted, err := CompareScreenshots(
+		screenshot(t, 400, 300, image.Rectangle{}),
+		screenshot(t, 400, 300, image.Rect(0, 0, 400, 300)),
+	)
+	assert.NoError(t, err)
+	assert.InDelta(t, 1, inverted.Score, 0.001)
+
+	resized, err := CompareScreenshots(page, screenshot(t, 800, 600, image.Rect(100, 100, 300, 200)))
+	assert.NoError(t, err)
+	assert.True(t, resized.SizeChanged)
+	assert.False(t, resized.Changed(0.01), "scaled screenshots look the same")
+
+	_, err = CompareScreenshots(page, []byte("not a png"))
+	assert.ErrorContains(t, err, "error decoding second screenshot")
+}
+
+func TestDecodeScreenshot(t *testing.T) {
+	page := screenshot(t, 10, 10, image.Rectangle{})
+
+	decoded, err := DecodeScreenshot(base64.StdEncoding.EncodeToString(page))
+	assert.NoError(t, err)
+	assert.Equal(t, page, decoded)
+
+	decoded, err = DecodeScreenshot(string(page))
+	assert.NoError(t, err)
+	assert.Equal(t, page, decoded)
+
+	_, err = DecodeScreenshot("<html></html>")
+	assert.Error(t, err)
+}
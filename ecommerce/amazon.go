@@ -2,7 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -14,49 +13,50 @@ import (
 type AmazonUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonUrlOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonUrl parameters.
 func (opt *AmazonUrlOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonUrl, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source.
-func (c *EcommerceClient) ScrapeAmazonUrl(
+// NewAmazonUrlRequest prepares and validates the request of ScrapeAmazonUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonUrlRequest(
 	url string,
 	opts ...*AmazonUrlOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonUrlCtx(ctx, url, opts...)
-}
-
-// ScrapeAmazonUrlCtx scrapes amazon via Oxylabs E-Commerce API with amazon as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonUrlCtx(
-	ctx context.Context,
-	url string,
-	opts ...*AmazonUrlOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Check validity of url.
 	err := internal.ValidateUrl(url, "amazon")
 	if err != nil {
@@ -80,40 +80,52 @@ func (c *EcommerceClient) ScrapeAmazonUrlCtx(
 
 	//Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonUrl,
-		"url":             url,
 		"user_agent_type": opt.UserAgent,
 		"render":          opt.Render,
 		"callback_url":    opt.CallbackUrl,
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonUrl,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonUrl scrapes amazon via Oxylabs E-Commerce API with amazon as source.
+func (c *EcommerceClient) ScrapeAmazonUrl(
+	url string,
+	opts ...*AmazonUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	return c.ScrapeAmazonUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeAmazonUrlCtx scrapes amazon via Oxylabs E-Commerce API with amazon as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*AmazonUrlOpts,
+) (*Resp, error) {
+	req, err := NewAmazonUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonSearchOpts contains all the query parameters available for amazon_search.
@@ -124,54 +136,55 @@ type AmazonSearchOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	Context           []func(oxylabs.ContextOption)
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonSearchOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonSearch parameters.
 func (opt *AmazonSearchOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonSearch, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
-func (c *EcommerceClient) ScrapeAmazonSearch(
+// NewAmazonSearchRequest prepares and validates the request of ScrapeAmazonSearch
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonSearchRequest(
 	query string,
 	opts ...*AmazonSearchOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonSearchCtx(ctx, query, opts...)
-}
-
-// ScrapeAmazonSearchCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonSearchCtx(
-	ctx context.Context,
-	query string,
-	opts ...*AmazonSearchOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -184,6 +197,11 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.AmazonSearch, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultUserAgent(&opt.UserAgent)
@@ -198,9 +216,7 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSearch,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"geo_location":    opt.GeoLocation,
@@ -220,32 +236,46 @@ func (c *EcommerceClient) ScrapeAmazonSearchCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonSearch,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonSearch scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
+func (c *EcommerceClient) ScrapeAmazonSearch(
+	query string,
+	opts ...*AmazonSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeAmazonSearchCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeAmazonSearchCtx scrapes amazon via Oxylabs E-Commerce API with amazon_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSearchOpts,
+) (*Resp, error) {
+	req, err := NewAmazonSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonProductOpts contains all the query parameters available for amazon_product.
@@ -254,50 +284,51 @@ type AmazonProductOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	Context           []func(oxylabs.ContextOption)
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonProductOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonProduct parameters.
 func (opt *AmazonProductOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonProduct, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
-func (c *EcommerceClient) ScrapeAmazonProduct(
+// NewAmazonProductRequest prepares and validates the request of ScrapeAmazonProduct
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonProductRequest(
 	query string,
 	opts ...*AmazonProductOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonProductCtx(ctx, query, opts...)
-}
-
-// ScrapeAmazonProductCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonProductCtx(
-	ctx context.Context,
-	query string,
-	opts ...*AmazonProductOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonProductOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -310,6 +341,11 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.AmazonProduct, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultUserAgent(&opt.UserAgent)
@@ -322,9 +358,7 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonProduct,
 		"domain":          opt.Domain,
-		"query":           query,
 		"geo_location":    opt.GeoLocation,
 		"user_agent_type": opt.UserAgent,
 		"render":          opt.Render,
@@ -338,32 +372,46 @@ func (c *EcommerceClient) ScrapeAmazonProductCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonProduct,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonProduct scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
+func (c *EcommerceClient) ScrapeAmazonProduct(
+	query string,
+	opts ...*AmazonProductOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	return c.ScrapeAmazonProductCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonProductCtx scrapes amazon via Oxylabs E-Commerce API with amazon_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonProductCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonProductOpts,
+) (*Resp, error) {
+	req, err := NewAmazonProductRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonPricingOpts contains all the query parameters available for amazon_pricing.
@@ -374,53 +422,54 @@ type AmazonPricingOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonPricingOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonPricing parameters.
 func (opt *AmazonPricingOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonPricing, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
-}
-
-// ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
-func (c *EcommerceClient) ScrapeAmazonPricing(
-	query string,
-	opts ...*AmazonPricingOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonPricingCtx(ctx, query, opts...)
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonPricingCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonPricingCtx(
-	ctx context.Context,
+// NewAmazonPricingRequest prepares and validates the request of ScrapeAmazonPricing
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonPricingRequest(
 	query string,
 	opts ...*AmazonPricingOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonPricingOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -441,9 +490,7 @@ func (c *EcommerceClient) ScrapeAmazonPricingCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonPricing,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"geo_location":    opt.GeoLocation,
@@ -453,32 +500,46 @@ func (c *EcommerceClient) ScrapeAmazonPricingCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonPricing,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonPricing scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
+func (c *EcommerceClient) ScrapeAmazonPricing(
+	query string,
+	opts ...*AmazonPricingOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	return c.ScrapeAmazonPricingCtx(ctx, query, opts...)
+}
+
+// ScrapeAmazonPricingCtx scrapes amazon via Oxylabs E-Commerce API with amazon_pricing as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonPricingCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonPricingOpts,
+) (*Resp, error) {
+	req, err := NewAmazonPricingRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonReviewsOpts contains all the query parameters available for amazon_reviews.
@@ -489,53 +550,54 @@ type AmazonReviewsOpts struct {
 	StartPage         int
 	Pages             int
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonReviewsOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonReviews parameters.
 func (opt *AmazonReviewsOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonReviews, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
-func (c *EcommerceClient) ScrapeAmazonReviews(
+// NewAmazonReviewsRequest prepares and validates the request of ScrapeAmazonReviews
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonReviewsRequest(
 	query string,
 	opts ...*AmazonReviewsOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonReviewsCtx(ctx, query, opts...)
-}
-
-// ScrapeAmazonReviewsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
-	ctx context.Context,
-	query string,
-	opts ...*AmazonReviewsOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonReviewsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -556,9 +618,7 @@ func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonReviews,
 		"domain":          opt.Domain,
-		"query":           query,
 		"geo_location":    opt.GeoLocation,
 		"user_agent_type": opt.UserAgent,
 		"start_page":      opt.StartPage,
@@ -568,32 +628,46 @@ func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonReviews,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonReviews scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
+func (c *EcommerceClient) ScrapeAmazonReviews(
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeAmazonReviewsCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeAmazonReviewsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_reviews as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonReviewsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonReviewsOpts,
+) (*Resp, error) {
+	req, err := NewAmazonReviewsRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonQuestionsOpts contains all the query parameters available for amazon_questions.
@@ -602,49 +676,50 @@ type AmazonQuestionsOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonQuestionsOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonQuestions parameters.
 func (opt *AmazonQuestionsOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonQuestions, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
-func (c *EcommerceClient) ScrapeAmazonQuestions(
+// NewAmazonQuestionsRequest prepares and validates the request of ScrapeAmazonQuestions
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonQuestionsRequest(
 	query string,
 	opts ...*AmazonQuestionsOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonQuestionsCtx(ctx, query, opts...)
-}
-
-// ScrapeAmazonQuestionsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
-	ctx context.Context,
-	query string,
-	opts ...*AmazonQuestionsOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonQuestionsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -663,9 +738,7 @@ func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonQuestions,
 		"domain":          opt.Domain,
-		"query":           query,
 		"geo_location":    opt.GeoLocation,
 		"user_agent_type": opt.UserAgent,
 		"render":          opt.Render,
@@ -673,32 +746,46 @@ func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonQuestions,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonQuestions scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
+func (c *EcommerceClient) ScrapeAmazonQuestions(
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeAmazonQuestionsCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeAmazonQuestionsCtx scrapes amazon via Oxylabs E-Commerce API with amazon_questions as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonQuestionsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonQuestionsOpts,
+) (*Resp, error) {
+	req, err := NewAmazonQuestionsRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonBestsellersOpts contains all the query parameters available for amazon_bestsellers.
@@ -709,53 +796,54 @@ type AmazonBestsellersOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonBestsellersOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonBestsellers parameters.
 func (opt *AmazonBestsellersOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonBestsellers, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonBestsellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
-func (c *EcommerceClient) ScrapeAmazonBestsellers(
+// NewAmazonBestsellersRequest prepares and validates the request of ScrapeAmazonBestsellers
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonBestsellersRequest(
 	query string,
 	opts ...*AmazonBestsellersOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonBestsellersCtx(ctx, query, opts...)
-}
-
-// ScrapeAmazonBestsellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
-	ctx context.Context,
-	query string,
-	opts ...*AmazonBestsellersOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonBestsellersOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -776,9 +864,7 @@ func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonBestsellers,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"geo_location":    opt.GeoLocation,
@@ -788,32 +874,46 @@ func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonBestsellers,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonBestsellers scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
+func (c *EcommerceClient) ScrapeAmazonBestsellers(
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeAmazonBestsellersCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeAmazonBestsellersCtx scrapes amazon via Oxylabs E-Commerce API with amazon_bestsellers as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonBestsellersCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonBestsellersOpts,
+) (*Resp, error) {
+	req, err := NewAmazonBestsellersRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // AmazonSellersOpts contains all the query parameters available for amazon_seller.
@@ -822,49 +922,50 @@ type AmazonSellersOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *AmazonSellersOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeAmazonSeller parameters.
 func (opt *AmazonSellersOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.AmazonSellers, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
-}
-
-// ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
-func (c *EcommerceClient) ScrapeAmazonSellers(
-	query string,
-	opts ...*AmazonSellersOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeAmazonSellersCtx(ctx, query, opts...)
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeAmazonSellerCtx scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeAmazonSellersCtx(
-	ctx context.Context,
+// NewAmazonSellersRequest prepares and validates the request of ScrapeAmazonSellers
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewAmazonSellersRequest(
 	query string,
 	opts ...*AmazonSellersOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &AmazonSellersOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -883,9 +984,7 @@ func (c *EcommerceClient) ScrapeAmazonSellersCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.AmazonSellers,
 		"domain":          opt.Domain,
-		"query":           query,
 		"geo_location":    opt.GeoLocation,
 		"user_agent_type": opt.UserAgent,
 		"render":          opt.Render,
@@ -893,30 +992,44 @@ func (c *EcommerceClient) ScrapeAmazonSellersCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parse_instructions"] = opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.AmazonSellers,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeAmazonSellers scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
+func (c *EcommerceClient) ScrapeAmazonSellers(
+	query string,
+	opts ...*AmazonSellersOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeAmazonSellersCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeAmazonSellerCtx scrapes amazon via Oxylabs E-Commerce API with amazon_seller as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeAmazonSellersCtx(
+	ctx context.Context,
+	query string,
+	opts ...*AmazonSellersOpts,
+) (*Resp, error) {
+	req, err := NewAmazonSellersRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
@@ -0,0 +1,168 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// AmazonProduct is a parsed amazon_product page.
+// Price is the price of the buybox offer, PriceInitial its price before discount.
+type AmazonProduct struct {
+	Asin         string `json:"asin"`
+	Title        string `json:"title"`
+	Url          string `json:"url"`
+	Manufacturer string `json:"manufacturer"`
+	Description  string `json:"description"`
+
+	Price         float64        `json:"price"`
+	PriceUpper    float64        `json:"price_upper"`
+	PriceInitial  float64        `json:"price_initial"`
+	PriceShipping float64        `json:"price_shipping"`
+	Currency      string         `json:"currency"`
+	Coupon        string         `json:"coupon"`
+	Buybox        []AmazonBuybox `json:"buybox,omitempty"`
+
+	Rating                 float64                        `json:"rating"`
+	ReviewsCount           int                            `json:"reviews_count"`
+	RatingStarDistribution []AmazonRatingStarDistribution `json:"rating_star_distribution,omitempty"`
+
+	Variations []AmazonProductVariation `json:"variations,omitempty"`
+	Images     []string                 `json:"images,omitempty"`
+
+	Stock           string                  `json:"stock"`
+	IsPrimeEligible bool                    `json:"is_prime_eligible"`
+	Delivery        []AmazonProductDelivery `json:"delivery,omitempty"`
+}
+
+// AmazonBuybox is an offer of the buybox of an amazon product.
+type AmazonBuybox struct {
+	Name         string  `json:"name"`
+	Price        float64 `json:"price"`
+	Stock        string  `json:"stock"`
+	Condition    string  `json:"condition"`
+	DeliveryType string  `json:"delivery_type"`
+	SoldBy       string  `json:"sold_by"`
+}
+
+// AmazonProductVariation is a variation of an amazon product, e.g. a size or color.
+// Dimensions are the values of the variation by dimension name.
+type AmazonProductVariation struct {
+	Asin       string            `json:"asin"`
+	Selected   bool              `json:"selected"`
+	Dimensions map[string]string `json:"dimensions,omitempty"`
+}
+
+// AmazonProducts decodes the parsed content of every amazon_product page of the resp.
+func (r *Resp) AmazonProducts() ([]AmazonProduct, error) {
+	products := make([]AmazonProduct, 0, len(r.Results))
+	for _, result := range r.Results {
+		if len(result.ContentRaw) == 0 || result.ContentRaw[0] != '{' {
+			return nil, fmt.Errorf("page %d has no parsed content", result.Page)
+		}
+
+		var raw map[string]interface{}
+		if err := json.Unmarshal(result.ContentRaw, &raw); err != nil {
+			return nil, fmt.Errorf("error decoding amazon product: %v", err)
+		}
+		products = append(products, amazonProduct(raw))
+	}
+
+	return products, nil
+}
+
+// amazonProduct returns the amazon product of the parsed content.
+// Fields whose layout varies between parser versions are decoded leniently.
+func amazonProduct(raw map[string]interface{}) AmazonProduct {
+	product := AmazonProduct{
+		Asin:          firstString(raw, "asin", "asin_in_url"),
+		Title:         firstString(raw, "title", "product_name"),
+		Url:           firstString(raw, "url"),
+		Manufacturer:  firstString(raw, "manufacturer", "brand"),
+		Description:   firstString(raw, "description"),
+		Price:         number(raw["price"], "price", "amount"),
+		PriceUpper:    number(raw["price_upper"]),
+		PriceInitial:  number(raw["price_initial"]),
+		PriceShipping: number(raw["price_shipping"]),
+		Currency:      firstString(raw, "currency"),
+		Coupon:        firstString(raw, "coupon"),
+		Rating:        number(raw["rating"]),
+		ReviewsCount:  int(number(raw["reviews_count"])),
+		Stock:         firstString(raw, "stock"),
+	}
+	product.IsPrimeEligible, _ = raw["is_prime_eligible"].(bool)
+	if product.Price == 0 {
+		product.Price = number(raw["price_buybox"])
+	}
+
+	for _, entry := range objects(raw["buybox"]) {
+		product.Buybox = append(product.Buybox, AmazonBuybox{
+			Name:         firstString(entry, "name"),
+			Price:        number(entry["price"]),
+			Stock:        firstString(entry, "stock"),
+			Condition:    firstString(entry, "condition"),
+			DeliveryType: firstString(entry, "delivery_type"),
+			SoldBy:       firstString(entry, "sold_by", "seller"),
+		})
+	}
+
+	for _, entry := range objects(raw["rating_star_distribution"]) {
+		product.RatingStarDistribution = append(product.RatingStarDistribution, AmazonRatingStarDistribution{
+			Rating:     number(entry["rating"]),
+			Percentage: int(number(entry["percentage"])),
+		})
+	}
+
+	for _, entry := range objects(raw["variation"]) {
+		variation := AmazonProductVariation{Asin: firstString(entry, "asin")}
+		variation.Selected, _ = entry["selected"].(bool)
+		if dimensions, ok := entry["dimensions"].(map[string]interface{}); ok {
+			variation.Dimensions = make(map[string]string, len(dimensions))
+			for name := range dimensions {
+				variation.Dimensions[name] = firstString(dimensions, name)
+			}
+		}
+		product.Variations = append(product.Variations, variation)
+	}
+
+	// Images are listed as urls, or as objects with their url.
+	images, _ := raw["images"].([]interface{})
+	for _, image := range images {
+		switch image := image.(type) {
+		case string:
+			product.Images = append(product.Images, image)
+		case map[string]interface{}:
+			if url := firstString(image, "url", "link"); url != "" {
+				product.Images = append(product.Images, url)
+			}
+		}
+	}
+
+	for _, entry := range objects(raw["delivery"]) {
+		delivery := AmazonProductDelivery{Type: firstString(entry, "type")}
+		if date, ok := entry["date"].(map[string]interface{}); ok {
+			delivery.Date.By = firstString(date, "by")
+			delivery.Date.From = firstString(date, "from")
+		}
+		product.Delivery = append(product.Delivery, delivery)
+	}
+
+	return product
+}
+
+// objects returns the objects of the list v, or v itself if it is an object.
+func objects(v interface{}) []map[string]interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		return []map[string]interface{}{v}
+	case []interface{}:
+		list := make([]map[string]interface{}, 0, len(v))
+		for _, entry := range v {
+			if object, ok := entry.(map[string]interface{}); ok {
+				list = append(list, object)
+			}
+		}
+		return list
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package ecommerce
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmazonProducts(t *testing.T) {
+	body := []byte(`{"results": [{"page": 1, "content": {
+		"asin": "B07FZ8S74R", "title": "Echo Dot", "url": "https://www.amazon.com/dp/B07FZ8S74R",
+		"manufacturer": "Amazon", "price": 49.99, "price_initial": 59.99, "price_shipping": 4.99, "currency": "USD",
+		"buybox": [{"name": "Amazon.com", "price": 49.99, "stock": "In Stock", "delivery_type": "FREE_DELIVERY"}],
+		"rating": 4.7, "reviews_count": 1024,
+		"rating_star_distribution": [{"rating": 5, "percentage": 80}],
+		"variation": [{"asin": "B07FZ8S74R", "selected": true, "dimensions": {"Color": "Charcoal"}}],
+		"images": ["https://m.media-amazon.com/1.jpg", {"url": "https://m.media-amazon.com/2.jpg"}],
+		"stock": "In Stock", "is_prime_eligible": true,
+		"delivery": [{"type": "FREE delivery", "date": {"by": "Monday, July 1"}}]
+	}}], "job": {"id": "1", "source": "amazon_product", "query": "B07FZ8S74R"}}`)
+
+	resp, err := GetResp(&http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, true, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 59.99, resp.Results[0].ContentParsed.PriceInitial)
+
+	products, err := resp.AmazonProducts()
+	assert.NoError(t, err)
+	delivery := AmazonProductDelivery{Type: "FREE delivery"}
+	delivery.Date.By = "Monday, July 1"
+	assert.Equal(t, []AmazonProduct{{
+		Asin:                   "B07FZ8S74R",
+		Title:                  "Echo Dot",
+		Url:                    "https://www.amazon.com/dp/B07FZ8S74R",
+		Manufacturer:           "Amazon",
+		Price:                  49.99,
+		PriceInitial:           59.99,
+		PriceShipping:          4.99,
+		Currency:               "USD",
+		Buybox:                 []AmazonBuybox{{Name: "Amazon.com", Price: 49.99, Stock: "In Stock", DeliveryType: "FREE_DELIVERY"}},
+		Rating:                 4.7,
+		ReviewsCount:           1024,
+		RatingStarDistribution: []AmazonRatingStarDistribution{{Rating: 5, Percentage: 80}},
+		Variations:             []AmazonProductVariation{{Asin: "B07FZ8S74R", Selected: true, Dimensions: map[string]string{"Color": "Charcoal"}}},
+		Images:                 []string{"https://m.media-amazon.com/1.jpg", "https://m.media-amazon.com/2.jpg"},
+		Stock:                  "In Stock",
+		IsPrimeEligible:        true,
+		Delivery:               []AmazonProductDelivery{delivery},
+	}}, products)
+
+	resp = &Resp{Results: []Results{{Page: 1, Content: "<html>"}}}
+	_, err = resp.AmazonProducts()
+	assert.Error(t, err)
+}
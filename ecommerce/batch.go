@@ -0,0 +1,145 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// JobResult is the outcome of a single job of a batch submission.
+// Err is set if the job faulted or could not be retrieved, in which case Resp is nil.
+type JobResult struct {
+	JobID string
+	Resp  *Resp
+	Err   error
+}
+
+// Batch is a set of jobs submitted together with the async polling runtime.
+type Batch struct {
+	JobIDs  []string
+	results chan JobResult
+}
+
+// Results returns a channel delivering each job's outcome as soon as it completes,
+// regardless of submission order. The channel is closed once every job has completed.
+func (b *Batch) Results() <-chan JobResult {
+	return b.results
+}
+
+// batchOpts contains the options needed to retrieve the results of a batch.
+type batchOpts struct {
+	parse            bool
+	customParserFlag bool
+	pollInterval     time.Duration
+}
+
+// newBatch starts polling the given jobs and returns the batch delivering their results.
+func newBatch(
+	ctx context.Context,
+	c *internal.Client,
+	jobIDs []string,
+	opt *batchOpts,
+) *Batch {
+	opts := make(map[string]*batchOpts, len(jobIDs))
+	for _, jobID := range jobIDs {
+		opts[jobID] = opt
+	}
+
+	return newMixedBatch(ctx, c, jobIDs, opts, opt.pollInterval)
+}
+
+// newMixedBatch starts polling the given jobs and returns the batch delivering
+// their results, each job's results retrieved with its own options.
+func newMixedBatch(
+	ctx context.Context,
+	c *internal.Client,
+	jobIDs []string,
+	opts map[string]*batchOpts,
+	pollInterval time.Duration,
+) *Batch {
+	b := &Batch{
+		JobIDs:  jobIDs,
+		results: make(chan JobResult, len(jobIDs)),
+	}
+
+	jobRespChan := make(chan internal.JobHttpResp)
+	go c.PollJobs(ctx, jobIDs, pollInterval, jobRespChan)
+
+	go func() {
+		defer close(b.results)
+
+		for jobResp := range jobRespChan {
+			if jobResp.Err != nil {
+				b.results <- JobResult{JobID: jobResp.JobID, Err: jobResp.Err}
+				continue
+			}
+
+			opt := opts[jobResp.JobID]
+			resp, err := GetResp(jobResp.HttpResp, opt.parse, opt.customParserFlag)
+			b.results <- JobResult{JobID: jobResp.JobID, Resp: resp, Err: err}
+		}
+	}()
+
+	return b
+}
+
+// ScrapeGoogleShoppingSearchBatch submits one google_shopping_search job per query
+// in a single batch req via Oxylabs E-Commerce API and polls them concurrently.
+// The provided context bounds the polling of every job in the batch.
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchBatch(
+	ctx context.Context,
+	queries []string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*Batch, error) {
+	jobIDs, bOpt, err := c.submitGoogleShoppingSearchBatch(ctx, queries, internal.WithClientDefaults(c.C, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return newBatch(ctx, c.C, jobIDs, bOpt), nil
+}
+
+// submitGoogleShoppingSearchBatch submits the google_shopping_search batch
+// and returns the job IDs along with the options needed to retrieve their results.
+func (c *EcommerceClientAsync) submitGoogleShoppingSearchBatch(
+	ctx context.Context,
+	queries []string,
+	opt *GoogleShoppingSearchOpts,
+) ([]string, *batchOpts, error) {
+	if len(queries) == 0 {
+		return nil, nil, fmt.Errorf("at least one query must be provided")
+	}
+
+	req, err := NewGoogleShoppingSearchRequest("", opt)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Prepare payload with one job per query.
+	payload, err := req.Payload()
+	if err != nil {
+		return nil, nil, err
+	}
+	payload["query"] = queries
+
+	// Marshal.
+	jsonPayload, err := oxylabs.MarshalPayload(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+
+	// Get job IDs.
+	jobIDs, err := c.C.GetJobIDs(ctx, jsonPayload)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return jobIDs, &batchOpts{
+		parse:            req.Parse(),
+		customParserFlag: req.CustomParser(),
+		pollInterval:     req.PollInterval,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package ecommerce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// batchGroup is a set of reqs sharing every parameter but the query or url,
+// submitted in a single batch req.
+type batchGroup struct {
+	payload map[string]interface{}
+	key     string
+	values  []string
+	indexes []int
+	opt     *batchOpts
+}
+
+// SubmitBatch submits the reqs, possibly of different sources, via Oxylabs
+// E-Commerce API and polls their jobs concurrently. Reqs sharing every parameter
+// but the query or url are submitted in a single batch req. Every req is
+// validated before anything is sent, the errors of the invalid reqs being
+// returned together. The job IDs of the batch are in the order of the reqs.
+// If a group fails to be submitted after others were, the error is an
+// *oxylabs.PartialSubmitError with the job IDs already submitted.
+// The provided context bounds the submission and polling of every job.
+func (c *EcommerceClientAsync) SubmitBatch(
+	ctx context.Context,
+	reqs []*oxylabs.Request,
+) (*Batch, error) {
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("at least one request must be provided")
+	}
+
+	groups, err := groupBatch(reqs)
+	if err != nil {
+		return nil, err
+	}
+
+	// Submit each group, mapping the job IDs back to the reqs.
+	jobIDs := make([]string, len(reqs))
+	opts := make(map[string]*batchOpts, len(reqs))
+	pollInterval := groups[0].opt.pollInterval
+	for i, group := range groups {
+		group.payload[group.key] = group.values
+		jsonPayload, err := oxylabs.MarshalPayload(group.payload)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling payload: %v", err)
+		}
+
+		groupJobIDs, err := c.C.GetJobIDs(ctx, jsonPayload)
+		if err == nil && len(groupJobIDs) != len(group.indexes) {
+			err = fmt.Errorf("error submitting batch: %d jobs created for %d requests", len(groupJobIDs), len(group.indexes))
+		}
+		if err != nil {
+			// Report the jobs of the groups already submitted.
+			if i > 0 {
+				return nil, &oxylabs.PartialSubmitError{JobIDs: jobIDs, Err: err}
+			}
+			return nil, err
+		}
+
+		for i, jobID := range groupJobIDs {
+			jobIDs[group.indexes[i]] = jobID
+			opts[jobID] = group.opt
+		}
+		if group.opt.pollInterval != 0 && (pollInterval == 0 || group.opt.pollInterval < pollInterval) {
+			pollInterval = group.opt.pollInterval
+		}
+	}
+
+	return newMixedBatch(ctx, c.C, jobIDs, opts, pollInterval), nil
+}
+
+// groupBatch validates the reqs and groups those sharing every parameter
+// but the query or url, in order of first appearance.
+func groupBatch(reqs []*oxylabs.Request) ([]*batchGroup, error) {
+	var groups []*batchGroup
+	byParams := make(map[string]*batchGroup)
+
+	var errs []error
+	for i, req := range reqs {
+		if req == nil {
+			errs = append(errs, fmt.Errorf("request %d: request cannot be nil", i))
+			continue
+		}
+
+		payload, err := req.Payload()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("request %d: %w", i, err))
+			continue
+		}
+
+		// Split the query or url from the shared parameters.
+		key, value := "query", req.Query
+		if req.Url != "" {
+			key, value = "url", req.Url
+		}
+		if value == "" {
+			errs = append(errs, fmt.Errorf("request %d: query or url must be provided", i))
+			continue
+		}
+		delete(payload, "query")
+		delete(payload, "url")
+
+		params, err := oxylabs.MarshalPayload(payload)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("request %d: error marshalling payload: %v", i, err))
+			continue
+		}
+
+		groupKey := key + ":" + string(params)
+		group, ok := byParams[groupKey]
+		if !ok {
+			group = &batchGroup{
+				payload: payload,
+				key:     key,
+				opt: &batchOpts{
+					parse:            req.Parse(),
+					customParserFlag: req.CustomParser(),
+					pollInterval:     req.PollInterval,
+				},
+			}
+			byParams[groupKey] = group
+			groups = append(groups, group)
+		}
+		group.values = append(group.values, value)
+		group.indexes = append(group.indexes, i)
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,95 @@
+package ecommerce
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupBatch(t *testing.T) {
+	shoes, _ := NewGoogleShoppingSearchRequest("shoes", &GoogleShoppingSearchOpts{Parse: oxylabs.Bool(true)})
+	socks, _ := NewGoogleShoppingSearchRequest("socks", &GoogleShoppingSearchOpts{Parse: oxylabs.Bool(true)})
+	product, _ := NewAmazonProductRequest("B07FZ8S74R", &AmazonProductOpts{Parse: oxylabs.Bool(true)})
+	url, _ := NewAmazonUrlRequest("https://www.amazon.com/dp/B07FZ8S74R")
+
+	groups, err := groupBatch([]*oxylabs.Request{shoes, product, socks, url})
+	assert.NoError(t, err)
+	assert.Len(t, groups, 3)
+	assert.Equal(t, []string{"shoes", "socks"}, groups[0].values)
+	assert.Equal(t, []int{0, 2}, groups[0].indexes)
+	assert.Equal(t, "query", groups[0].key)
+	assert.Equal(t, []int{1}, groups[1].indexes)
+	assert.Equal(t, "url", groups[2].key)
+	assert.True(t, groups[1].opt.parse)
+}
+
+func TestGroupBatchValidation(t *testing.T) {
+	product, _ := NewAmazonProductRequest("B07FZ8S74R")
+	invalid := &oxylabs.Request{Source: oxylabs.AmazonProduct, Query: "B07FZ8S74R", Params: map[string]interface{}{"limit": 10}}
+
+	_, err := groupBatch([]*oxylabs.Request{product, nil, invalid, {Source: oxylabs.AmazonProduct}})
+	assert.ErrorContains(t, err, "request 1: request cannot be nil")
+	assert.ErrorContains(t, err, "request 2: limit parameter is not supported by the amazon_product source")
+	assert.ErrorContains(t, err, "request 3: query or url must be provided")
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+}
+
+// failingSubmits is a transport failing the submissions after the first ones.
+type failingSubmits struct {
+	next      http.RoundTripper
+	mu        sync.Mutex
+	succeeded int
+}
+
+func (f *failingSubmits) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodPost {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+		if f.succeeded == 0 {
+			return &http.Response{
+				StatusCode: http.StatusInternalServerError,
+				Status:     "500 Internal Server Error",
+				Body:       io.NopCloser(strings.NewReader(`{"message":"unavailable"}`)),
+			}, nil
+		}
+		f.succeeded--
+	}
+
+	return f.next.RoundTrip(req)
+}
+
+func TestSubmitBatchPartialFailure(t *testing.T) {
+	shoes, _ := NewGoogleShoppingSearchRequest("shoes")
+	product, _ := NewAmazonProductRequest("B07FZ8S74R")
+	socks, _ := NewGoogleShoppingSearchRequest("socks")
+
+	c := InitAsync("user", "pass", WithHttpClient(&http.Client{
+		Transport: &failingSubmits{next: oxylabstest.NewSimulator(), succeeded: 1},
+	}))
+	_, err := c.SubmitBatch(context.Background(), []*oxylabs.Request{shoes, product, socks})
+
+	var partialErr *oxylabs.PartialSubmitError
+	if !assert.ErrorAs(t, err, &partialErr) {
+		return
+	}
+	assert.Equal(t, []string{"1", "", "2"}, partialErr.JobIDs)
+	var statusErr *oxylabs.StatusError
+	assert.ErrorAs(t, err, &statusErr)
+	assert.Equal(t, http.StatusInternalServerError, statusErr.StatusCode)
+
+	// Nothing submitted is no partial failure.
+	c = InitAsync("user", "pass", WithHttpClient(&http.Client{
+		Transport: &failingSubmits{next: oxylabstest.NewSimulator()},
+	}))
+	_, err = c.SubmitBatch(context.Background(), []*oxylabs.Request{shoes, product})
+	assert.Error(t, err)
+	assert.False(t, errors.As(err, &partialErr))
+}
@@ -0,0 +1,91 @@
+package ecommerce
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeGoogleShoppingSearchBatch(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond, FaultRate: 0.5, Seed: 1})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5*time.Millisecond))
+
+	queries := []string{"shoes", "socks", "boots", "hats", "gloves", "scarves"}
+	batch, err := c.ScrapeGoogleShoppingSearchBatch(context.Background(), queries)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, batch.JobIDs, len(queries))
+
+	jobs := sim.Jobs()
+	results := make(map[string]JobResult)
+	for result := range batch.Results() {
+		assert.NotContains(t, results, result.JobID, "every job is delivered once")
+		results[result.JobID] = result
+	}
+
+	assert.Len(t, results, len(queries))
+	faulted := 0
+	for _, job := range jobs {
+		result := results[job.ID]
+		assert.Contains(t, batch.JobIDs, job.ID)
+		if job.Faulted {
+			faulted++
+			assert.Error(t, result.Err)
+			assert.Nil(t, result.Resp)
+			continue
+		}
+		if assert.NoError(t, result.Err) && assert.Len(t, result.Resp.Results, 1) {
+			assert.Contains(t, result.Resp.Results[0].Content, job.Payload["query"])
+		}
+	}
+	assert.NotZero(t, faulted, "the seed faults some jobs")
+	assert.Less(t, faulted, len(queries), "the seed completes some jobs")
+}
+
+func TestScrapeGoogleShoppingSearchBatchNoQueries(t *testing.T) {
+	sim := oxylabstest.NewSimulator()
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()))
+
+	_, err := c.ScrapeGoogleShoppingSearchBatch(context.Background(), nil)
+	assert.ErrorContains(t, err, "at least one query must be provided")
+	assert.Empty(t, sim.Jobs())
+}
+
+func TestScrapeGoogleShoppingSearchBatchClientDefaults(t *testing.T) {
+	sim := oxylabstest.NewSimulator()
+	c := InitAsync("user", "pass",
+		WithHttpClient(sim.HttpClient()),
+		WithDefaultDomain(oxylabs.DOMAIN_DE),
+		WithDefaultGeoLocation("Germany"),
+		WithDefaultPollInterval(5*time.Millisecond),
+	)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	batch, err := c.ScrapeGoogleShoppingSearchBatch(ctx, []string{"shoes"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	for range batch.Results() {
+	}
+
+	_, err = c.ScrapeGoogleShoppingSearchBatchWithCallback(ctx, []string{"boots"}, callback.NewReceiver("https://example.com/callback"), time.Hour, &GoogleShoppingSearchOpts{Domain: oxylabs.DOMAIN_FR})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	jobs := sim.Jobs()
+	if !assert.Len(t, jobs, 2) {
+		return
+	}
+	assert.Equal(t, "de", jobs[0].Payload["domain"])
+	assert.Equal(t, "Germany", jobs[0].Payload["geo_location"])
+	assert.Equal(t, "fr", jobs[1].Payload["domain"], "the opts override the client defaults")
+	assert.Equal(t, "Germany", jobs[1].Payload["geo_location"])
+}
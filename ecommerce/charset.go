@@ -0,0 +1,12 @@
+package ecommerce
+
+import "github.com/revvim/oxylabs-sdk-go/internal"
+
+// normalizeCharset converts the html content of every result to UTF-8.
+func (r *Resp) normalizeCharset() {
+	for i := range r.Results {
+		if r.Results[i].Content != "" {
+			r.Results[i].Content, _ = internal.ToUTF8(r.Results[i].Content)
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package ecommerce
+
+import (
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// classify sets the classification of the resp to the one of its first page
+// that is not ok, per the classifier of the client. A resp without pages is empty.
+func (r *Resp) classify(c *internal.Client) {
+	if len(r.Results) == 0 {
+		r.Classification = oxylabs.ClassificationEmpty
+		return
+	}
+
+	r.Classification = oxylabs.ClassificationOK
+	for _, result := range r.Results {
+		// The content of parsed pages is kept only as raw json.
+		content, parsed := []byte(result.Content), result.Content == ""
+		if parsed {
+			content = result.ContentRaw
+		}
+
+		if classification := c.Classify(result.StatusCode, content, parsed); classification != oxylabs.ClassificationOK {
+			r.Classification = classification
+			return
+		}
+	}
+}
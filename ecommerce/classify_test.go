@@ -0,0 +1,45 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespClassify(t *testing.T) {
+	c := internal.NewClient("", "user", "pass")
+
+	tests := []struct {
+		name    string
+		results []Results
+		want    oxylabs.Classification
+	}{
+		{name: "no pages", want: oxylabs.ClassificationEmpty},
+		{
+			name:    "raw captcha",
+			results: []Results{{Content: `<div class="g-recaptcha"></div>`, StatusCode: 200}},
+			want:    oxylabs.ClassificationCaptcha,
+		},
+		{
+			name:    "parsed content quoting a marker",
+			results: []Results{{ContentRaw: json.RawMessage(`{"title": "Request blocked by the jury"}`), StatusCode: 200}},
+			want:    oxylabs.ClassificationOK,
+		},
+		{
+			name:    "parsed content blocked status code",
+			results: []Results{{ContentRaw: json.RawMessage(`{"title": "Shoe"}`), StatusCode: 429}},
+			want:    oxylabs.ClassificationBlocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Resp{Results: tt.results}
+			r.classify(c)
+			assert.Equal(t, tt.want, r.Classification)
+		})
+	}
+}
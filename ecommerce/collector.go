@@ -0,0 +1,116 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/internal"
+)
+
+// Future resolves to the result of a single job once the job completes.
+type Future struct {
+	JobID  string
+	done   chan struct{}
+	result JobResult
+}
+
+// Done returns a channel that is closed once the future is resolved.
+func (f *Future) Done() <-chan struct{} {
+	return f.done
+}
+
+// Result waits for the future to be resolved and returns the job's resp.
+func (f *Future) Result(ctx context.Context) (*Resp, error) {
+	select {
+	case <-f.done:
+		return f.result.Resp, f.result.Err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Collector resolves the futures of a batch submitted with a callback receiver.
+type Collector struct {
+	JobIDs  []string
+	futures map[string]*Future
+}
+
+// Future returns the future of the given job, or nil if the job is not part of the batch.
+func (c *Collector) Future(jobID string) *Future {
+	return c.futures[jobID]
+}
+
+// Futures returns the futures of every job, in submission order.
+func (c *Collector) Futures() []*Future {
+	futures := make([]*Future, 0, len(c.JobIDs))
+	for _, jobID := range c.JobIDs {
+		futures = append(futures, c.futures[jobID])
+	}
+
+	return futures
+}
+
+// newCollector resolves a future per job as soon as its callback arrives at the receiver,
+// falling back to polling the job status after fallbackAfter.
+func newCollector(
+	ctx context.Context,
+	c *internal.Client,
+	jobIDs []string,
+	receiver *callback.Receiver,
+	fallbackAfter time.Duration,
+	opt *batchOpts,
+) *Collector {
+	collector := &Collector{
+		JobIDs:  jobIDs,
+		futures: make(map[string]*Future, len(jobIDs)),
+	}
+
+	for _, jobID := range jobIDs {
+		future := &Future{JobID: jobID, done: make(chan struct{})}
+		collector.futures[jobID] = future
+
+		go func() {
+			defer close(future.done)
+
+			httpResp, err := c.AwaitCallback(ctx, future.JobID, receiver, fallbackAfter, opt.pollInterval)
+			if err != nil {
+				future.result = JobResult{JobID: future.JobID, Err: err}
+				return
+			}
+
+			resp, err := GetResp(httpResp, opt.parse, opt.customParserFlag)
+			future.result = JobResult{JobID: future.JobID, Resp: resp, Err: err}
+		}()
+	}
+
+	return collector
+}
+
+// ScrapeGoogleShoppingSearchBatchWithCallback submits one google_shopping_search job
+// per query in a single batch req via Oxylabs E-Commerce API, with the receiver as callback_url.
+// Each job's future is resolved once its callback arrives, falling back to polling
+// after fallbackAfter (callback.DefaultFallback if 0).
+func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchBatchWithCallback(
+	ctx context.Context,
+	queries []string,
+	receiver *callback.Receiver,
+	fallbackAfter time.Duration,
+	opts ...*GoogleShoppingSearchOpts,
+) (*Collector, error) {
+	if receiver == nil || receiver.Url == "" {
+		return nil, fmt.Errorf("callback receiver with a url must be provided")
+	}
+
+	// Prepare options, pointing callback_url at the receiver.
+	opt := internal.WithClientDefaults(c.C, opts)
+	opt.CallbackURL = receiver.Url
+
+	jobIDs, bOpt, err := c.submitGoogleShoppingSearchBatch(ctx, queries, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCollector(ctx, c.C, jobIDs, receiver, fallbackAfter, bOpt), nil
+}
@@ -0,0 +1,80 @@
+package ecommerce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeGoogleShoppingSearchBatchWithCallback(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5*time.Millisecond))
+	receiver := callback.NewReceiver("https://example.com/callback")
+	ctx := context.Background()
+
+	collector, err := c.ScrapeGoogleShoppingSearchBatchWithCallback(ctx, []string{"adidas", "nike"}, receiver, 200*time.Millisecond)
+	if !assert.NoError(t, err) || !assert.Len(t, collector.JobIDs, 2) {
+		return
+	}
+	for _, job := range sim.Jobs() {
+		assert.Equal(t, receiver.Url, job.Payload["callback_url"])
+	}
+
+	// Only the first job calls back, the second one is polled after the fallback.
+	time.Sleep(30 * time.Millisecond)
+	body := `{"id":"` + collector.JobIDs[0] + `","status":"done","source":"google_shopping_search"}`
+	receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	futures := collector.Futures()
+	if !assert.Len(t, futures, 2) {
+		return
+	}
+	for i, query := range []string{"adidas", "nike"} {
+		assert.Same(t, futures[i], collector.Future(collector.JobIDs[i]))
+		resp, err := futures[i].Result(ctx)
+		if assert.NoError(t, err) && assert.Len(t, resp.Results, 1) {
+			assert.Contains(t, resp.Results[0].Content, query)
+		}
+	}
+	assert.Nil(t, collector.Future("unknown"))
+
+	jobs := sim.Jobs()
+	assert.Zero(t, jobs[0].Polls, "the job is not polled once notified")
+	assert.NotZero(t, jobs[1].Polls, "the job is polled without a callback")
+}
+
+func TestScrapeGoogleShoppingSearchBatchWithCallbackFaulted(t *testing.T) {
+	sim := oxylabstest.NewSimulator()
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()))
+	receiver := callback.NewReceiver("https://example.com/callback")
+
+	collector, err := c.ScrapeGoogleShoppingSearchBatchWithCallback(context.Background(), []string{"adidas"}, receiver, time.Hour)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	body := `{"id":"` + collector.JobIDs[0] + `","status":"faulted","source":"google_shopping_search"}`
+	receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+
+	future := collector.Future(collector.JobIDs[0])
+	<-future.Done()
+	_, err = future.Result(context.Background())
+	assert.Error(t, err)
+}
+
+func TestScrapeGoogleShoppingSearchBatchWithCallbackNoReceiver(t *testing.T) {
+	c := InitAsync("user", "pass")
+
+	_, err := c.ScrapeGoogleShoppingSearchBatchWithCallback(context.Background(), []string{"adidas"}, nil, 0)
+	assert.EqualError(t, err, "callback receiver with a url must be provided")
+
+	_, err = c.ScrapeGoogleShoppingSearchBatchWithCallback(context.Background(), []string{"adidas"}, callback.NewReceiver(""), 0)
+	assert.Error(t, err)
+}
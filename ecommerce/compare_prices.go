@@ -0,0 +1,101 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/revvim/oxylabs-sdk-go/bulk"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// ProductOffers is a google shopping product found by ComparePrices along with
+// the offers of its sellers. Err is set if the offers could not be scraped.
+type ProductOffers struct {
+	ProductId string
+	Product   Organic
+	Offers    []Pricing
+	Err       error
+}
+
+// ComparePricesOpts contains the options of ComparePrices.
+// Search and Pricing are the base of the google_shopping_search and
+// google_shopping_pricing options, Limit the maximum number of products whose
+// offers are scraped, all of them if 0, and Concurrency the number of products
+// whose offers are scraped simultaneously, bulk.DefaultConcurrency if 0.
+type ComparePricesOpts struct {
+	Search      *GoogleShoppingSearchOpts
+	Pricing     *GoogleShoppingPricingOpts
+	Limit       int
+	Concurrency int
+}
+
+// ComparePrices searches google shopping for the query and scrapes the offers
+// of every product found, chaining google_shopping_search with one
+// google_shopping_pricing scrape per product ID via Oxylabs E-Commerce API.
+// Products are returned in search order, without the results lacking a product
+// ID or repeating one. It fails only if the search fails, the products whose
+// offers could not be scraped carrying their own error.
+func (c *EcommerceClient) ComparePrices(
+	ctx context.Context,
+	query string,
+	opts ...*ComparePricesOpts,
+) ([]ProductOffers, error) {
+	// Prepare options.
+	opt := &ComparePricesOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// Search the products.
+	searchOpt := &GoogleShoppingSearchOpts{}
+	if opt.Search != nil {
+		*searchOpt = *opt.Search
+	}
+	searchOpt.Parse = oxylabs.Bool(true)
+
+	resp, err := c.ScrapeGoogleShoppingSearchCtx(ctx, query, searchOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	organic, _ := MergeOrganic(resp)
+	products := make([]ProductOffers, 0, len(organic))
+	for _, o := range organic {
+		if o.ProductId == "" {
+			continue
+		}
+		if opt.Limit > 0 && len(products) == opt.Limit {
+			break
+		}
+		products = append(products, ProductOffers{ProductId: o.ProductId, Product: o})
+	}
+
+	// Scrape the offers of every product.
+	result := bulk.Execute(ctx, products, func(ctx context.Context, product ProductOffers) ([]Pricing, error) {
+		pricingOpt := &GoogleShoppingPricingOpts{}
+		if opt.Pricing != nil {
+			*pricingOpt = *opt.Pricing
+		}
+		pricingOpt.Parse = oxylabs.Bool(true)
+
+		resp, err := c.ScrapeGoogleShoppingPricingCtx(ctx, product.ProductId, pricingOpt)
+		if err != nil {
+			return nil, err
+		}
+
+		var offers []Pricing
+		for _, result := range resp.Results {
+			offers = append(offers, result.ContentParsed.Pricing...)
+		}
+
+		return offers, nil
+	}, &bulk.Opts{Concurrency: opt.Concurrency})
+
+	for _, success := range result.Successes {
+		products[success.Index].Offers = success.Output
+	}
+	for _, itemErr := range result.Errors {
+		products[itemErr.Index].Err = itemErr.Err
+	}
+
+	return products, nil
+}
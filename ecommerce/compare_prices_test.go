@@ -0,0 +1,65 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func TestComparePrices(t *testing.T) {
+	c := Init("user", "pass")
+	c.C.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		payload := struct {
+			Source string `json:"source"`
+			Query  string `json:"query"`
+		}{}
+		body, _ := io.ReadAll(req.Body)
+		_ = json.Unmarshal(body, &payload)
+
+		content := `{"results":{"organic":[` +
+			`{"pos":1,"title":"Shoe","product_id":"111"},` +
+			`{"pos":2,"title":"Ad without product"},` +
+			`{"pos":3,"title":"Shoe again","product_id":"111"},` +
+			`{"pos":4,"title":"Boot","product_id":"222"}]}}`
+		status := http.StatusOK
+		switch {
+		case payload.Source == "google_shopping_pricing" && payload.Query == "222":
+			status, content = http.StatusBadRequest, `{}`
+		case payload.Source == "google_shopping_pricing":
+			content = `{"pricing":[{"seller":"A","price":10},{"seller":"B","price":12}]}`
+		}
+
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":` + content + `}]}`)),
+		}
+	})}
+
+	products, err := c.ComparePrices(context.Background(), "shoes")
+	assert.NoError(t, err)
+	assert.Len(t, products, 2, "results without or repeating a product ID are dropped")
+
+	assert.Equal(t, "111", products[0].ProductId)
+	assert.Equal(t, "Shoe", products[0].Product.Title)
+	assert.NoError(t, products[0].Err)
+	assert.Len(t, products[0].Offers, 2)
+	assert.Equal(t, "B", products[0].Offers[1].Seller)
+
+	assert.Equal(t, "222", products[1].ProductId)
+	assert.Error(t, products[1].Err)
+
+	products, err = c.ComparePrices(context.Background(), "shoes", &ComparePricesOpts{Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, products, 1)
+}
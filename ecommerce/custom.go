@@ -0,0 +1,33 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// ScrapeCustomSource scrapes any source via Oxylabs E-Commerce API, sending params as is.
+// It allows using sources that have no dedicated scrape method yet.
+func (c *EcommerceClient) ScrapeCustomSource(
+	ctx context.Context,
+	source string,
+	params map[string]interface{},
+) (*Resp, error) {
+	return c.Do(ctx, &oxylabs.Request{
+		Source: oxylabs.Source(source),
+		Params: params,
+	})
+}
+
+// ScrapeCustomSource scrapes any source with async polling runtime via Oxylabs E-Commerce API,
+// sending params as is. It allows using sources that have no dedicated scrape method yet.
+func (c *EcommerceClientAsync) ScrapeCustomSource(
+	ctx context.Context,
+	source string,
+	params map[string]interface{},
+) (chan *Resp, error) {
+	return c.Do(ctx, &oxylabs.Request{
+		Source: oxylabs.Source(source),
+		Params: params,
+	})
+}
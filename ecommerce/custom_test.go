@@ -0,0 +1,35 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeCustomSource(t *testing.T) {
+	var payload map[string]interface{}
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":{"title":"Shoe"},"page":1}]}`)),
+		}
+	})}))
+
+	resp, err := c.ScrapeCustomSource(context.Background(), "new_product", map[string]interface{}{
+		"query": "123",
+		"parse": true,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]interface{}{"source": "new_product", "query": "123", "parse": true}, payload)
+	if assert.Len(t, resp.Results, 1) {
+		assert.JSONEq(t, `{"title":"Shoe"}`, string(resp.Results[0].ContentRaw))
+	}
+}
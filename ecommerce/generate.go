@@ -0,0 +1,4 @@
+package ecommerce
+
+// Fixtures of live responses decoded by fixtures_test.go, see cmd/fixturegen.
+//go:generate go run ../cmd/fixturegen -package ecommerce
@@ -2,7 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -22,50 +21,51 @@ var AcceptedSortByParameters = []string{
 type GoogleShoppingUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
 	GeoLocation       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleShoppingUrlOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeGoogleShoppingUrl parameters.
 func (opt *GoogleShoppingUrlOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleShoppingUrl, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
-}
-
-// ScrapeGoogleShoppingUrl scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
-func (c *EcommerceClient) ScrapeGoogleShoppingUrl(
-	url string,
-	opts ...*GoogleShoppingUrlOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeGoogleShoppingUrlCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
-	ctx context.Context,
+// NewGoogleShoppingUrlRequest prepares and validates the request of ScrapeGoogleShoppingUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleShoppingUrlRequest(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Check validity of url.
 	err := internal.ValidateUrl(url, "shopping.google")
 	if err != nil {
@@ -89,8 +89,6 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleShoppingUrl,
-		"url":             url,
 		"user_agent_type": opt.UserAgent,
 		"render":          opt.Render,
 		"callback_url":    opt.CallbackUrl,
@@ -98,103 +96,134 @@ func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleShoppingUrl,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeGoogleShoppingUrl scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
+func (c *EcommerceClient) ScrapeGoogleShoppingUrl(
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeGoogleShoppingUrlCtx scrapes google shopping via Oxylabs E-Commerce API with google_shopping as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) (*Resp, error) {
+	req, err := NewGoogleShoppingUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleShoppingSearchOpts contains all the query parameters available for google shopping search.
+// Nfpr, if set to oxylabs.Bool(true), turns off the spelling auto-correction of the query
+// by Google, which otherwise silently returns the results of the corrected query instead,
+// skewing e.g. price monitoring. It sets the nfpr context parameter and must not conflict
+// with an oxylabs.Nfpr context modifier.
 type GoogleShoppingSearchOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Pages             int
+	Limit             int
 	Locale            oxylabs.Locale
+	AutoLocale        bool
 	ResultsLanguage   string
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
+	Nfpr              *bool
 	CallbackURL       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 	Context           []func(oxylabs.ContextOption)
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleShoppingSearchOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeGoogleShoppingSearch parameters.
 func (opt *GoogleShoppingSearchOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleShoppingSearch, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.Limit < 0 || opt.Limit > internal.MaxLimit_GOOGLE_SHOPPING {
+		errs = append(errs, fmt.Errorf(
+			"limit parameter must be between 1 and %d", internal.MaxLimit_GOOGLE_SHOPPING,
+		))
 	}
 
 	if ctx["sort_by"] != nil && !internal.InList(ctx["sort_by"].(string), AcceptedSortByParameters) {
-		return fmt.Errorf("invalid sort_by parameter: %v", ctx["sort_by"])
+		errs = append(errs, fmt.Errorf("invalid sort_by parameter: %v", ctx["sort_by"]))
 	}
 
 	if (ctx["min_price"] != nil || ctx["max_price"] != nil) &&
 		(ctx["min_price"].(int) < 0 || ctx["max_price"].(int) < 0) {
-		return fmt.Errorf("min and max prices should be greater than 0")
+		errs = append(errs, fmt.Errorf("min and max prices should be greater than 0"))
+	}
+
+	if opt.Nfpr != nil && ctx["nfpr"] != nil && ctx["nfpr"] != *opt.Nfpr {
+		errs = append(errs, fmt.Errorf("nfpr parameter conflicts with the nfpr context parameter"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeGoogleShoppingSearch scrapes google shopping via Oxylabs E-Commerce API
-// with google_shopping_search as source.
-func (c *EcommerceClient) ScrapeGoogleShoppingSearch(
+// NewGoogleShoppingSearchRequest prepares and validates the request of ScrapeGoogleShoppingSearch
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleShoppingSearchRequest(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
-}
-
-// ScrapeGoogleShoppingSearchCtx scrapes google shopping via Oxylabs E-Commerce API
-// with google_shopping_search as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
-	ctx context.Context,
-	query string,
-	opts ...*GoogleShoppingSearchOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleShoppingSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -207,9 +236,20 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleShoppingSearch, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
 	internal.SetDefaultSortBy(context)
 	internal.SetDefaultPages(&opt.Pages)
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
 	internal.SetDefaultUserAgent(&opt.UserAgent)
@@ -219,12 +259,13 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 	if err != nil {
 		return nil, err
 	}
+	if opt.Nfpr != nil {
+		context["nfpr"] = *opt.Nfpr
+	}
 
 	// Prepare payload with common parameters.
 	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingSearch,
 		"domain":           opt.Domain,
-		"query":            query,
 		"start_page":       opt.StartPage,
 		"pages":            opt.Pages,
 		"locale":           opt.Locale,
@@ -254,87 +295,109 @@ func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
+	// Add limit to the payload if provided.
+	if opt.Limit != 0 {
+		payload["limit"] = opt.Limit
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleShoppingSearch,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeGoogleShoppingSearch scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearch(
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleShoppingSearchCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*Resp, error) {
+	req, err := NewGoogleShoppingSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleShoppingProductOpts contains all the query parameters available for google shopping product.
 type GoogleShoppingProductOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	Locale            oxylabs.Locale
+	AutoLocale        bool
 	ResultsLanguage   string
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackURL       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleShoppingProductOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeGoogleShoppingProduct parameters.
 func (opt *GoogleShoppingProductOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleShoppingProduct, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
-}
-
-// ScrapeGoogleShoppingProduct scrapes google shopping via Oxylabs E-Commerce API
-// with google_shopping_product as source.
-func (c *EcommerceClient) ScrapeGoogleShoppingProduct(
-	query string,
-	opts ...*GoogleShoppingProductOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeGoogleShoppingProductCtx scrapes google shopping via Oxylabs E-Commerce API
-// with google_shopping_product as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
-	ctx context.Context,
+// NewGoogleShoppingProductRequest prepares and validates the request of ScrapeGoogleShoppingProduct
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleShoppingProductRequest(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleShoppingProductOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -342,6 +405,12 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
 	}
 
 	// Set defaults.
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultUserAgent(&opt.UserAgent)
 
@@ -353,9 +422,7 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
 
 	// Prepare payload with common parameters.
 	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingProduct,
 		"domain":           opt.Domain,
-		"query":            query,
 		"locale":           opt.Locale,
 		"results_language": opt.ResultsLanguage,
 		"geo_location":     opt.GeoLocation,
@@ -365,93 +432,110 @@ func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
 		"parse":            opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleShoppingProduct,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeGoogleShoppingProduct scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_product as source.
+func (c *EcommerceClient) ScrapeGoogleShoppingProduct(
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleShoppingProductCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_product as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingProductCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*Resp, error) {
+	req, err := NewGoogleShoppingProductRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleShoppingPricingOpts contains all the query parameters available for google shopping pricing.
 type GoogleShoppingPricingOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Pages             int
 	Locale            oxylabs.Locale
+	AutoLocale        bool
 	ResultsLanguage   string
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackURL       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleShoppingPricingOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeGoogleShoppingPricing parameters.
 func (opt *GoogleShoppingPricingOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleShoppingPricing, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeGoogleShoppingPricing scrapes google shopping via Oxylabs E-Commerce API
-// with google_shopping_pricing as source.
-func (c *EcommerceClient) ScrapeGoogleShoppingPricing(
+// NewGoogleShoppingPricingRequest prepares and validates the request of ScrapeGoogleShoppingPricing
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleShoppingPricingRequest(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
-}
-
-// ScrapeGoogleShoppingPricingCtx scrapes google shopping via Oxylabs E-Commerce API
-// with google_shopping_pricing as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
-	ctx context.Context,
-	query string,
-	opts ...*GoogleShoppingPricingOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleShoppingPricingOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -460,6 +544,12 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
 
 	// Set defaults.
 	internal.SetDefaultPages(&opt.Pages)
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
 	internal.SetDefaultUserAgent(&opt.UserAgent)
@@ -472,9 +562,7 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
 
 	// Prepare payload with common parameters.
 	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingPricing,
 		"domain":           opt.Domain,
-		"query":            query,
 		"start_page":       opt.StartPage,
 		"pages":            opt.Pages,
 		"locale":           opt.Locale,
@@ -486,30 +574,46 @@ func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
 		"parse":            opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleShoppingPricing,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeGoogleShoppingPricing scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_pricing as source.
+func (c *EcommerceClient) ScrapeGoogleShoppingPricing(
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleShoppingPricingCtx scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_pricing as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingPricingCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*Resp, error) {
+	req, err := NewGoogleShoppingPricingRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
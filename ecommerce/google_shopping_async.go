@@ -2,12 +2,8 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
-	"net/http"
 
 	"github.com/revvim/oxylabs-sdk-go/internal"
-	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 // ScrapeGoogleShoppingUrl scrapes google shopping with async polling runtime
@@ -16,7 +12,7 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingUrl(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
 ) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingUrlCtx(ctx, url, opts...)
@@ -30,90 +26,12 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingUrlCtx(
 	url string,
 	opts ...*GoogleShoppingUrlOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Check validity of url.
-	err := internal.ValidateUrl(url, "shopping.google")
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare options.
-	opt := &GoogleShoppingUrlOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err = opt.checkParameterValidity()
-	if err != nil {
-		return nil, err
-	}
-
-	// Prepare payload.
-	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleShoppingUrl,
-		"url":             url,
-		"user_agent_type": opt.UserAgent,
-		"render":          opt.Render,
-		"callback_url":    opt.CallbackUrl,
-		"geo_location":    opt.GeoLocation,
-		"parse":           opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	req, err := NewGoogleShoppingUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Do(ctx, req)
 }
 
 // ScrapeGoogleShoppingSearch scrapes google shopping with async polling runtime
@@ -122,7 +40,7 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearch(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
 ) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingSearchCtx(ctx, query, opts...)
@@ -136,117 +54,12 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingSearchCtx(
 	query string,
 	opts ...*GoogleShoppingSearchOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingSearchOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Initialize the context map and apply each provided context modifier function.
-	context := make(oxylabs.ContextOption)
-	for _, modifier := range opt.Context {
-		modifier(context)
-	}
-
-	// Set defaults.
-	internal.SetDefaultSortBy(context)
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity(context)
+	req, err := NewGoogleShoppingSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingSearch,
-		"domain":           opt.Domain,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-		"context": []map[string]interface{}{
-			{
-				"key":   "nfpr",
-				"value": context["nfpr"],
-			},
-			{
-				"key":   "sort_by",
-				"value": context["sort_by"],
-			},
-			{
-				"key":   "min_price",
-				"value": context["min_price"],
-			},
-			{
-				"key":   "max_price",
-				"value": context["max_price"],
-			},
-		},
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Do(ctx, req)
 }
 
 // ScrapeGoogleShoppingProduct scrapes google shopping with async polling runtime
@@ -255,7 +68,7 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingProduct(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
 ) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingProductCtx(ctx, query, opts...)
@@ -269,87 +82,12 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingProductCtx(
 	query string,
 	opts ...*GoogleShoppingProductOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingProductOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+	req, err := NewGoogleShoppingProductRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingProduct,
-		"domain":           opt.Domain,
-		"query":            query,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
-
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
-
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
-	if err != nil {
-		return nil, err
-	}
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
-
-	// Handle error.
-	err = <-errChan
-	if err != nil {
-		return nil, err
-	}
-
-	// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
-	if err != nil {
-		return nil, err
-	}
-
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Do(ctx, req)
 }
 
 // ScrapeGoogleShoppingPricing scrapes google shopping with async polling runtime
@@ -358,7 +96,7 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricing(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
 ) (chan *Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
 	defer cancel()
 
 	return c.ScrapeGoogleShoppingPricingCtx(ctx, query, opts...)
@@ -372,90 +110,70 @@ func (c *EcommerceClientAsync) ScrapeGoogleShoppingPricingCtx(
 	query string,
 	opts ...*GoogleShoppingPricingOpts,
 ) (chan *Resp, error) {
-	errChan := make(chan error)
-	httpRespChan := make(chan *http.Response)
-	respChan := make(chan *Resp)
-
-	// Prepare options.
-	opt := &GoogleShoppingPricingOpts{}
-	if len(opts) > 0 && opts[len(opts)-1] != nil {
-		opt = opts[len(opts)-1]
-	}
-
-	// Set defaults.
-	internal.SetDefaultPages(&opt.Pages)
-	internal.SetDefaultDomain(&opt.Domain)
-	internal.SetDefaultStartPage(&opt.StartPage)
-	internal.SetDefaultUserAgent(&opt.UserAgent)
-
-	// Check validity of parameters.
-	err := opt.checkParameterValidity()
+	req, err := NewGoogleShoppingPricingRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	// Prepare payload with common parameters.
-	payload := map[string]interface{}{
-		"source":           oxylabs.GoogleShoppingPricing,
-		"domain":           opt.Domain,
-		"query":            query,
-		"start_page":       opt.StartPage,
-		"pages":            opt.Pages,
-		"locale":           opt.Locale,
-		"results_language": opt.ResultsLanguage,
-		"geo_location":     opt.GeoLocation,
-		"user_agent_type":  opt.UserAgent,
-		"render":           opt.Render,
-		"callback_url":     opt.CallbackURL,
-		"parse":            opt.Parse,
-	}
-
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	return c.Do(ctx, req)
+}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
+// SubmitGoogleShoppingUrl submits a google shopping job via Oxylabs E-Commerce API
+// with google_shopping as source and returns a handle to the job without waiting for it.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingUrl(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleShoppingUrlOpts,
+) (*JobHandle, error) {
+	req, err := NewGoogleShoppingUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+		return nil, err
 	}
 
-	// Get job ID.
-	jobID, err := c.C.GetJobID(jsonPayload)
+	return c.Submit(ctx, req)
+}
+
+// SubmitGoogleShoppingSearch submits a google shopping job via Oxylabs E-Commerce API
+// with google_shopping_search as source and returns a handle to the job without waiting for it.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingSearch(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (*JobHandle, error) {
+	req, err := NewGoogleShoppingSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	// Poll job status.
-	go c.C.PollJobStatus(
-		ctx,
-		jobID,
-		opt.PollInterval,
-		httpRespChan,
-		errChan,
-	)
+	return c.Submit(ctx, req)
+}
 
-	// Handle error.
-	err = <-errChan
+// SubmitGoogleShoppingProduct submits a google shopping job via Oxylabs E-Commerce API
+// with google_shopping_product as source and returns a handle to the job without waiting for it.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingProduct(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*JobHandle, error) {
+	req, err := NewGoogleShoppingProductRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	/// Unmarshal the http Response and get the response.
-	httpResp := <-httpRespChan
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	return c.Submit(ctx, req)
+}
+
+// SubmitGoogleShoppingPricing submits a google shopping job via Oxylabs E-Commerce API
+// with google_shopping_pricing as source and returns a handle to the job without waiting for it.
+func (c *EcommerceClientAsync) SubmitGoogleShoppingPricing(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*JobHandle, error) {
+	req, err := NewGoogleShoppingPricingRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	// Retrieve internal resp and forward it to the
-	// resp channel.
-	go func() {
-		respChan <- resp
-	}()
-
-	return respChan, nil
+	return c.Submit(ctx, req)
 }
@@ -0,0 +1,103 @@
+package ecommerce
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitGoogleShopping(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5*time.Millisecond))
+	ctx := context.Background()
+
+	tests := []struct {
+		source oxylabs.Source
+		input  string
+		submit func() (*JobHandle, error)
+	}{
+		{oxylabs.GoogleShoppingUrl, "https://shopping.google.com/product/123", func() (*JobHandle, error) {
+			return c.SubmitGoogleShoppingUrl(ctx, "https://shopping.google.com/product/123")
+		}},
+		{oxylabs.GoogleShoppingSearch, "adidas", func() (*JobHandle, error) {
+			return c.SubmitGoogleShoppingSearch(ctx, "adidas")
+		}},
+		{oxylabs.GoogleShoppingProduct, "123", func() (*JobHandle, error) {
+			return c.SubmitGoogleShoppingProduct(ctx, "123")
+		}},
+		{oxylabs.GoogleShoppingPricing, "456", func() (*JobHandle, error) {
+			return c.SubmitGoogleShoppingPricing(ctx, "456")
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.source), func(t *testing.T) {
+			h, err := tt.submit()
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			resp, err := h.Result(ctx)
+			if assert.NoError(t, err) && assert.Len(t, resp.Results, 1) {
+				assert.Contains(t, resp.Results[0].Content, tt.input)
+			}
+			jobs := sim.Jobs()
+			assert.Equal(t, tt.source, jobs[len(jobs)-1].Source)
+		})
+	}
+
+	_, err := c.SubmitGoogleShoppingSearch(ctx, "adidas", &GoogleShoppingSearchOpts{Pages: -1})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.Len(t, sim.Jobs(), len(tests), "invalid reqs are not submitted")
+}
+
+func TestJobHandleResultFromCallback(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(time.Hour))
+	receiver := callback.NewReceiver("https://example.com/callback")
+	ctx := context.Background()
+
+	h, err := c.SubmitGoogleShoppingSearch(ctx, "adidas", &GoogleShoppingSearchOpts{CallbackURL: receiver.Url})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Deliver the callback once the job is done.
+	go func() {
+		time.Sleep(40 * time.Millisecond)
+		body := `{"id":"` + h.JobID + `","status":"done","source":"google_shopping_search"}`
+		receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	}()
+
+	resp, err := h.ResultFromCallback(ctx, receiver, time.Hour)
+	if assert.NoError(t, err) && assert.Len(t, resp.Results, 1) {
+		assert.Contains(t, resp.Results[0].Content, "adidas")
+	}
+	assert.Zero(t, sim.Jobs()[0].Polls, "the job is not polled once notified")
+}
+
+func TestJobHandleResultFromCallbackFallback(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5*time.Millisecond))
+	receiver := callback.NewReceiver("https://example.com/callback")
+	ctx := context.Background()
+
+	h, err := c.SubmitGoogleShoppingPricing(ctx, "456", &GoogleShoppingPricingOpts{CallbackURL: receiver.Url})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	resp, err := h.ResultFromCallback(ctx, receiver, 10*time.Millisecond)
+	if assert.NoError(t, err) && assert.Len(t, resp.Results, 1) {
+		assert.Contains(t, resp.Results[0].Content, "456")
+	}
+	assert.NotZero(t, sim.Jobs()[0].Polls, "the job is polled without a callback")
+}
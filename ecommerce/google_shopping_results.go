@@ -0,0 +1,258 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// GoogleShoppingMerchant is a merchant of google shopping.
+type GoogleShoppingMerchant struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+// GoogleShoppingProduct is a product of a parsed google_shopping_search page.
+// Shipping is the delivery details shown with the product, e.g. "Free delivery".
+type GoogleShoppingProduct struct {
+	Page         int                    `json:"page"`
+	Pos          int                    `json:"pos"`
+	ProductId    string                 `json:"product_id"`
+	Title        string                 `json:"title"`
+	Url          string                 `json:"url"`
+	Price        Money                  `json:"price"`
+	PriceStr     string                 `json:"price_str"`
+	Merchant     GoogleShoppingMerchant `json:"merchant"`
+	Shipping     string                 `json:"shipping"`
+	Rating       float64                `json:"rating"`
+	ReviewsCount int                    `json:"reviews_count"`
+	Thumbnail    string                 `json:"thumbnail"`
+	Sponsored    bool                   `json:"sponsored"`
+}
+
+// GoogleShoppingOffer is an offer of a parsed google_shopping_pricing page.
+type GoogleShoppingOffer struct {
+	Merchant      GoogleShoppingMerchant `json:"merchant"`
+	Price         float64                `json:"price"`
+	PriceShipping float64                `json:"price_shipping"`
+	PriceTax      float64                `json:"price_tax"`
+	PriceTotal    float64                `json:"price_total"`
+	Currency      string                 `json:"currency"`
+	Condition     string                 `json:"condition"`
+	Details       string                 `json:"details"`
+}
+
+// GoogleShoppingPricing are the offers of a google shopping product.
+// ProductId is the query of the job.
+type GoogleShoppingPricing struct {
+	ProductId    string                `json:"product_id"`
+	Title        string                `json:"title"`
+	Url          string                `json:"url"`
+	Rating       float64               `json:"rating"`
+	ReviewsCount int                   `json:"reviews_count"`
+	Offers       []GoogleShoppingOffer `json:"offers"`
+}
+
+// Total returns the total price of the offer, including shipping and tax
+// if the total is not given.
+func (o GoogleShoppingOffer) Total() float64 {
+	if o.PriceTotal != 0 {
+		return o.PriceTotal
+	}
+
+	return o.Price + o.PriceShipping + o.PriceTax
+}
+
+// Lowest returns the offer with the lowest total price, and false if there is none.
+func (p *GoogleShoppingPricing) Lowest() (GoogleShoppingOffer, bool) {
+	if len(p.Offers) == 0 {
+		return GoogleShoppingOffer{}, false
+	}
+
+	lowest := p.Offers[0]
+	for _, offer := range p.Offers[1:] {
+		if offer.Total() < lowest.Total() {
+			lowest = offer
+		}
+	}
+
+	return lowest, true
+}
+
+// GoogleShoppingProducts decodes the paid and organic products of every
+// parsed google_shopping_search page of the resp, in order of the pages.
+func (r *Resp) GoogleShoppingProducts() ([]GoogleShoppingProduct, error) {
+	var products []GoogleShoppingProduct
+	for _, result := range r.Results {
+		if len(result.ContentRaw) == 0 || result.ContentRaw[0] != '{' {
+			return nil, fmt.Errorf("page %d has no parsed content", result.Page)
+		}
+
+		content := struct {
+			Results map[string]interface{} `json:"results"`
+		}{}
+		if err := json.Unmarshal(result.ContentRaw, &content); err != nil {
+			return nil, fmt.Errorf("error decoding products: %v", err)
+		}
+
+		for _, section := range []string{"paid", "organic"} {
+			for _, entry := range objects(content.Results[section]) {
+				product := googleShoppingProduct(entry)
+				product.Page = result.Page
+				product.Sponsored = section == "paid"
+				products = append(products, product)
+			}
+		}
+	}
+
+	return products, nil
+}
+
+// GoogleShoppingPricing decodes the offers of every parsed
+// google_shopping_pricing page of the resp.
+func (r *Resp) GoogleShoppingPricing() (*GoogleShoppingPricing, error) {
+	pricing := &GoogleShoppingPricing{ProductId: r.Job.Query}
+	for _, result := range r.Results {
+		if len(result.ContentRaw) == 0 || result.ContentRaw[0] != '{' {
+			return nil, fmt.Errorf("page %d has no parsed content", result.Page)
+		}
+
+		var raw map[string]interface{}
+		if err := json.Unmarshal(result.ContentRaw, &raw); err != nil {
+			return nil, fmt.Errorf("error decoding pricing: %v", err)
+		}
+
+		if title := firstString(raw, "title"); title != "" {
+			pricing.Title = title
+		}
+		if url := firstString(raw, "url"); url != "" && pricing.Url == "" {
+			pricing.Url = url
+		}
+		if rating := number(raw["rating"]); rating != 0 {
+			pricing.Rating = rating
+		}
+		if count := number(raw["review_count"]); count != 0 {
+			pricing.ReviewsCount = int(count)
+		}
+
+		for _, entry := range objects(raw["pricing"]) {
+			pricing.Offers = append(pricing.Offers, GoogleShoppingOffer{
+				Merchant: GoogleShoppingMerchant{
+					Name: firstString(entry, "seller"),
+					Url:  firstString(entry, "seller_link"),
+				},
+				Price:         number(entry["price"]),
+				PriceShipping: number(entry["price_shipping"]),
+				PriceTax:      number(entry["price_tax"]),
+				PriceTotal:    number(entry["price_total"]),
+				Currency:      firstString(entry, "currency"),
+				Condition:     firstString(entry, "condition"),
+				Details:       firstString(entry, "details"),
+			})
+		}
+	}
+
+	return pricing, nil
+}
+
+// googleShoppingProduct returns the product of a parsed search result.
+func googleShoppingProduct(raw map[string]interface{}) GoogleShoppingProduct {
+	product := GoogleShoppingProduct{
+		Pos:          int(number(raw["pos"])),
+		ProductId:    firstString(raw, "product_id"),
+		Title:        firstString(raw, "title"),
+		Url:          firstString(raw, "url"),
+		Price:        Money{Amount: number(raw["price"]), Currency: firstString(raw, "currency")},
+		PriceStr:     firstString(raw, "price_str"),
+		Shipping:     firstString(raw, "delivery", "shipping"),
+		Rating:       number(raw["rating"]),
+		ReviewsCount: int(number(raw["reviews_count"])),
+		Thumbnail:    firstString(raw, "thumbnail", "url_image"),
+	}
+
+	// The merchant is an object, or only its name.
+	switch merchant := raw["merchant"].(type) {
+	case map[string]interface{}:
+		product.Merchant = GoogleShoppingMerchant{Name: firstString(merchant, "name"), Url: firstString(merchant, "url")}
+	case string:
+		product.Merchant.Name = merchant
+	}
+
+	return product
+}
+
+// ScrapeGoogleShoppingSearchProducts searches google shopping via Oxylabs
+// E-Commerce API with google_shopping_search as source and parsed results,
+// and returns the products found.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearchProducts(
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) ([]GoogleShoppingProduct, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingSearchProductsCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleShoppingSearchProductsCtx searches google shopping via Oxylabs
+// E-Commerce API with google_shopping_search as source and parsed results,
+// and returns the products found.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearchProductsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) ([]GoogleShoppingProduct, error) {
+	// Prepare options.
+	opt := GoogleShoppingSearchOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = *opts[len(opts)-1]
+	}
+	opt.Parse = oxylabs.Bool(true)
+
+	resp, err := c.ScrapeGoogleShoppingSearchCtx(ctx, query, &opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GoogleShoppingProducts()
+}
+
+// ScrapeGoogleShoppingPricingOffers scrapes the offers of a google shopping
+// product via Oxylabs E-Commerce API with google_shopping_pricing as source and
+// parsed results.
+func (c *EcommerceClient) ScrapeGoogleShoppingPricingOffers(
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*GoogleShoppingPricing, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingPricingOffersCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleShoppingPricingOffersCtx scrapes the offers of a google shopping
+// product via Oxylabs E-Commerce API with google_shopping_pricing as source and
+// parsed results.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingPricingOffersCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingPricingOpts,
+) (*GoogleShoppingPricing, error) {
+	// Prepare options.
+	opt := GoogleShoppingPricingOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = *opts[len(opts)-1]
+	}
+	opt.Parse = oxylabs.Bool(true)
+
+	resp, err := c.ScrapeGoogleShoppingPricingCtx(ctx, query, &opt)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GoogleShoppingPricing()
+}
@@ -0,0 +1,54 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoogleShoppingProducts(t *testing.T) {
+	content := `{"results": {
+		"paid": [{"pos": 1, "title": "Ad shoe", "price": 49.5, "currency": "USD", "merchant": "Shop A"}],
+		"organic": [{"pos": 1, "product_id": "123", "title": "Shoe", "url": "https://a.com/shoe", "price": "$59.99",
+			"currency": "USD", "price_str": "$59.99", "merchant": {"name": "Shop B", "url": "https://b.com"},
+			"delivery": "Free delivery", "rating": 4.5, "reviews_count": 120, "thumbnail": "https://img"}]
+	}}`
+	resp := &Resp{Results: []Results{{Page: 2, ContentRaw: json.RawMessage(content)}}}
+
+	products, err := resp.GoogleShoppingProducts()
+	assert.NoError(t, err)
+	assert.Equal(t, []GoogleShoppingProduct{
+		{
+			Page: 2, Pos: 1, Title: "Ad shoe", Price: Money{Amount: 49.5, Currency: "USD"},
+			Merchant: GoogleShoppingMerchant{Name: "Shop A"}, Sponsored: true,
+		},
+		{
+			Page: 2, Pos: 1, ProductId: "123", Title: "Shoe", Url: "https://a.com/shoe",
+			Price: Money{Amount: 59.99, Currency: "USD"}, PriceStr: "$59.99",
+			Merchant: GoogleShoppingMerchant{Name: "Shop B", Url: "https://b.com"},
+			Shipping: "Free delivery", Rating: 4.5, ReviewsCount: 120, Thumbnail: "https://img",
+		},
+	}, products)
+}
+
+func TestGoogleShoppingPricing(t *testing.T) {
+	content := `{"title": "Shoe", "url": "https://shopping.google.com/product/123", "rating": 4.5, "review_count": 120,
+		"pricing": [
+			{"seller": "Shop A", "seller_link": "https://a.com", "price": 59.99, "price_shipping": 5, "currency": "USD"},
+			{"seller": "Shop B", "price": 62, "price_total": 62, "currency": "USD", "condition": "New"}
+		]}`
+	resp := &Resp{Job: Job{Query: "123"}, Results: []Results{{ContentRaw: json.RawMessage(content)}}}
+
+	pricing, err := resp.GoogleShoppingPricing()
+	assert.NoError(t, err)
+	assert.Equal(t, "123", pricing.ProductId)
+	assert.Equal(t, "Shoe", pricing.Title)
+	assert.Equal(t, 120, pricing.ReviewsCount)
+	assert.Len(t, pricing.Offers, 2)
+	assert.Equal(t, GoogleShoppingMerchant{Name: "Shop A", Url: "https://a.com"}, pricing.Offers[0].Merchant)
+
+	lowest, ok := pricing.Lowest()
+	assert.True(t, ok)
+	assert.Equal(t, "Shop B", lowest.Merchant.Name, "the lowest total includes shipping")
+}
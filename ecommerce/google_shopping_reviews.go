@@ -0,0 +1,129 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// GoogleShoppingReview is a single review of a google shopping product.
+type GoogleShoppingReview struct {
+	Author string  `json:"author"`
+	Title  string  `json:"title"`
+	Text   string  `json:"text"`
+	Rating float64 `json:"rating"`
+	Date   string  `json:"date"`
+	Source string  `json:"source"`
+}
+
+// GoogleShoppingReviews are the reviews of a google shopping product.
+// ByStars is the number of reviews per star rating.
+type GoogleShoppingReviews struct {
+	Rating  float64                `json:"rating"`
+	Count   int                    `json:"reviews_count"`
+	ByStars map[int]int            `json:"reviews_by_stars,omitempty"`
+	Reviews []GoogleShoppingReview `json:"reviews"`
+}
+
+// GoogleShoppingReviews decodes the reviews section of the parsed content
+// of every google_shopping_product page of the resp.
+func (r *Resp) GoogleShoppingReviews() (*GoogleShoppingReviews, error) {
+	reviews := &GoogleShoppingReviews{}
+	for _, result := range r.Results {
+		if len(result.ContentRaw) == 0 || result.ContentRaw[0] != '{' {
+			return nil, fmt.Errorf("page %d has no parsed content", result.Page)
+		}
+
+		content := struct {
+			Reviews map[string]interface{} `json:"reviews"`
+		}{}
+		if err := json.Unmarshal(result.ContentRaw, &content); err != nil {
+			return nil, fmt.Errorf("error decoding reviews: %v", err)
+		}
+		if content.Reviews == nil {
+			continue
+		}
+
+		if rating := number(content.Reviews["rating"]); rating != 0 {
+			reviews.Rating = rating
+		}
+		if count := number(content.Reviews["reviews_count"]); count != 0 {
+			reviews.Count = int(count)
+		}
+		if byStars, ok := content.Reviews["reviews_by_stars"].(map[string]interface{}); ok {
+			reviews.ByStars = make(map[int]int, len(byStars))
+			for stars, count := range byStars {
+				if n, err := strconv.Atoi(stars); err == nil {
+					reviews.ByStars[n] = int(number(count))
+				}
+			}
+		}
+
+		// Reviews are listed, or only the top review is given.
+		var entries []interface{}
+		for _, key := range []string{"reviews", "items"} {
+			if list, ok := content.Reviews[key].([]interface{}); ok {
+				entries = list
+				break
+			}
+		}
+		if topReview, ok := content.Reviews["top_review"]; ok && entries == nil {
+			entries = []interface{}{topReview}
+		}
+		for _, entry := range entries {
+			if raw, ok := entry.(map[string]interface{}); ok {
+				reviews.Reviews = append(reviews.Reviews, GoogleShoppingReview{
+					Author: firstString(raw, "author", "name"),
+					Title:  firstString(raw, "title"),
+					Text:   firstString(raw, "text", "content"),
+					Rating: number(raw["rating"]),
+					Date:   firstString(raw, "date"),
+					Source: firstString(raw, "source"),
+				})
+			}
+		}
+	}
+
+	return reviews, nil
+}
+
+// ScrapeGoogleShoppingProductReviews scrapes the reviews of a google shopping product
+// via Oxylabs E-Commerce API with google_shopping_product as source and parsed results.
+func (c *EcommerceClient) ScrapeGoogleShoppingProductReviews(
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*GoogleShoppingReviews, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleShoppingProductReviewsCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleShoppingProductReviewsCtx scrapes the reviews of a google shopping product
+// via Oxylabs E-Commerce API with google_shopping_product as source and parsed results.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeGoogleShoppingProductReviewsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingProductOpts,
+) (*GoogleShoppingReviews, error) {
+	// Prepare options.
+	opt := internal.WithClientDefaults(c.C, opts)
+	opt.Parse = oxylabs.Bool(true)
+
+	req, err := NewGoogleShoppingProductRequest(query, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Do(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GoogleShoppingReviews()
+}
@@ -0,0 +1,84 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoogleShoppingReviews(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    *GoogleShoppingReviews
+	}{
+		{
+			name:    "listed reviews",
+			content: `{"reviews":{"rating":4.5,"reviews_count":"1,204","reviews_by_stars":{"5":900,"1":20},"reviews":[{"author":"Ann","rating":5,"text":"Great"}]}}`,
+			want: &GoogleShoppingReviews{
+				Rating:  4.5,
+				Count:   1204,
+				ByStars: map[int]int{5: 900, 1: 20},
+				Reviews: []GoogleShoppingReview{{Author: "Ann", Rating: 5, Text: "Great"}},
+			},
+		},
+		{
+			name:    "top review",
+			content: `{"reviews":{"rating":4,"top_review":{"author":"Bo","rating":4,"source":"shop.example"}}}`,
+			want: &GoogleShoppingReviews{
+				Rating:  4,
+				Reviews: []GoogleShoppingReview{{Author: "Bo", Rating: 4, Source: "shop.example"}},
+			},
+		},
+		{name: "no reviews", content: `{"title":"x"}`, want: &GoogleShoppingReviews{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &Resp{Results: []Results{{ContentRaw: json.RawMessage(tt.content)}}}
+			got, err := resp.GoogleShoppingReviews()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestScrapeGoogleShoppingProductReviewsClientDefaults(t *testing.T) {
+	var payload map[string]interface{}
+	c := Init("user", "pass", WithDefaultGeoLocation("Germany"), WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":{"reviews":{"rating":4.5}},"page":1}]}`)),
+		}
+	})}))
+
+	reviews, err := c.ScrapeGoogleShoppingProductReviews("123")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, 4.5, reviews.Rating)
+	assert.Equal(t, "Germany", payload["geo_location"], "the client defaults are applied")
+	assert.Equal(t, true, payload["parse"])
+}
+
+func TestGetRespMismatchedContent(t *testing.T) {
+	httpResp := &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":{"title":"Shoe","reviews":{"rating":4}},"page":1}]}`)),
+	}
+
+	resp, err := GetResp(httpResp, true, false)
+	if !assert.NoError(t, err) || !assert.Len(t, resp.Results, 1) {
+		return
+	}
+	assert.Equal(t, "Shoe", resp.Results[0].ContentParsed.Title, "the other fields are decoded")
+	if assert.Len(t, resp.Warnings, 1) {
+		assert.Equal(t, 1, resp.Warnings[0].Page)
+		assert.Contains(t, resp.Warnings[0].Message, "reviews")
+	}
+}
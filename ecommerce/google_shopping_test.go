@@ -0,0 +1,187 @@
+package ecommerce
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewGoogleShoppingSearchRequestNfpr(t *testing.T) {
+	nfprOf := func(req *oxylabs.Request) interface{} {
+		payload, err := req.Payload()
+		assert.NoError(t, err)
+		for _, param := range payload["context"].([]map[string]interface{}) {
+			if param["key"] == "nfpr" {
+				return param["value"]
+			}
+		}
+		return nil
+	}
+
+	req, err := NewGoogleShoppingSearchRequest("adiddas", &GoogleShoppingSearchOpts{Nfpr: oxylabs.Bool(true)})
+	assert.NoError(t, err)
+	assert.Equal(t, true, nfprOf(req))
+
+	req, err = NewGoogleShoppingSearchRequest("adiddas", &GoogleShoppingSearchOpts{})
+	assert.NoError(t, err)
+	assert.Nil(t, nfprOf(req))
+
+	_, err = NewGoogleShoppingSearchRequest("adiddas", &GoogleShoppingSearchOpts{
+		Nfpr:    oxylabs.Bool(false),
+		Context: []func(oxylabs.ContextOption){oxylabs.Nfpr(oxylabs.Bool(true))},
+	})
+	assert.ErrorContains(t, err, "nfpr parameter conflicts with the nfpr context parameter")
+}
+
+func TestNewGoogleShoppingSearchRequestParse(t *testing.T) {
+	tests := []struct {
+		name  string
+		parse *bool
+		want  interface{}
+	}{
+		{name: "unset", parse: nil, want: nil},
+		{name: "false", parse: oxylabs.Bool(false), want: false},
+		{name: "true", parse: oxylabs.Bool(true), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{Parse: tt.parse})
+			assert.NoError(t, err)
+			payload, err := req.Payload()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, payload["parse"])
+			assert.Equal(t, tt.want == true, req.Parse())
+		})
+	}
+}
+
+func TestNewGoogleShoppingSearchRequestViewport(t *testing.T) {
+	_, err := NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{
+		Render:   oxylabs.PNG,
+		Viewport: &oxylabs.Viewport{Width: 1920, Height: 1080},
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "viewport parameter is not supported by the google_shopping_search source")
+}
+
+func TestNewUniversalUrlRequestViewport(t *testing.T) {
+	req, err := NewUniversalUrlRequest("https://example.com", &UniversalUrlOpts{
+		Render:   oxylabs.PNG,
+		Viewport: &oxylabs.Viewport{Width: 1920, Height: 1080},
+	})
+	assert.NoError(t, err)
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{"width": 1920, "height": 1080}, payload["viewport"])
+
+	_, err = NewUniversalUrlRequest("https://example.com", &UniversalUrlOpts{
+		Render:   oxylabs.PNG,
+		Viewport: &oxylabs.Viewport{Width: 1920},
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "width and height must be greater than 0")
+}
+
+func TestNewRequestLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		newReq  func(limit int) (*oxylabs.Request, error)
+		limit   int
+		want    interface{}
+		wantErr string
+	}{
+		{
+			name: "google_shopping_search unset",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{Limit: limit})
+			},
+			want: nil,
+		},
+		{
+			name: "google_shopping_search max",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{Limit: limit})
+			},
+			limit: 100,
+			want:  100,
+		},
+		{
+			name: "google_shopping_search above max",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{Limit: limit})
+			},
+			limit:   101,
+			wantErr: "limit parameter must be between 1 and 100",
+		},
+		{
+			name: "google_shopping_search negative",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{Limit: limit})
+			},
+			limit:   -1,
+			wantErr: "limit parameter must be between 1 and 100",
+		},
+		{
+			name: "wayfair_search default",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewWayfairSearchRequest("chair", &WayfairSearchOpts{Limit: limit})
+			},
+			want: 48,
+		},
+		{
+			name: "wayfair_search invalid",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewWayfairSearchRequest("chair", &WayfairSearchOpts{Limit: limit})
+			},
+			limit:   50,
+			wantErr: "invalid limit parameter: 50",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.newReq(tt.limit)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			payload, err := req.Payload()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, payload["limit"])
+		})
+	}
+}
+
+func TestNewGoogleShoppingSearchRequestSanitizesQuery(t *testing.T) {
+	req, err := NewGoogleShoppingSearchRequest(" adidas\x00\n shoes ")
+	assert.NoError(t, err)
+	assert.Equal(t, "adidas shoes", req.Query)
+}
+
+func TestDoWarnsAboutUrlQuery(t *testing.T) {
+	c := Init("user", "pass")
+	c.C.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":"<html></html>"}]}`)),
+		}
+	})}
+
+	resp, err := c.ScrapeGoogleShoppingSearchCtx(context.Background(), "https://www.example.com/shoes")
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Warnings, 1) {
+		assert.Contains(t, resp.Warnings[0].Message, "query parameter is a url")
+	}
+
+	resp, err = c.ScrapeGoogleShoppingSearchCtx(context.Background(), "adidas shoes")
+	assert.NoError(t, err)
+	assert.Empty(t, resp.Warnings)
+}
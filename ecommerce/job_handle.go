@@ -0,0 +1,120 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// JobHandle is a handle to a job submitted via Oxylabs E-Commerce API.
+type JobHandle struct {
+	JobID string
+
+	h                *internal.JobHandle
+	parse            bool
+	customParserFlag bool
+}
+
+// Submit submits the req with async polling runtime via Oxylabs E-Commerce API
+// and returns a handle to the job without waiting for it to be done.
+func (c *EcommerceClientAsync) Submit(
+	ctx context.Context,
+	req *oxylabs.Request,
+) (*JobHandle, error) {
+	jsonPayload, err := marshalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get job ID.
+	jobID, err := c.C.SubmitJob(ctx, req, jsonPayload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &JobHandle{
+		JobID:            jobID,
+		h:                c.C.NewJobHandle(jobID, req.PollInterval),
+		parse:            req.Parse(),
+		customParserFlag: req.CustomParser(),
+	}, nil
+}
+
+// DoCallback submits the req via Oxylabs E-Commerce API with the receiver as callback_url
+// and returns the response once the job's callback arrives at the receiver,
+// falling back to polling after callback.DefaultFallback.
+func (c *EcommerceClientAsync) DoCallback(
+	ctx context.Context,
+	req *oxylabs.Request,
+	receiver *callback.Receiver,
+) (*Resp, error) {
+	h, err := c.Submit(ctx, internal.CallbackRequest(req, receiver.Url))
+	if err != nil {
+		return nil, err
+	}
+
+	return h.ResultFromCallback(ctx, receiver, 0)
+}
+
+// Result waits for the job to be done and returns the response.
+func (j *JobHandle) Result(ctx context.Context) (*Resp, error) {
+	httpResp, err := j.h.Wait(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetResp(httpResp, j.parse, j.customParserFlag)
+}
+
+// ResultFromCallback waits for the job's callback to arrive at the receiver and
+// returns the response, falling back to polling after fallbackAfter
+// (callback.DefaultFallback if 0). The job must be submitted with the receiver as callback_url.
+func (j *JobHandle) ResultFromCallback(
+	ctx context.Context,
+	receiver *callback.Receiver,
+	fallbackAfter time.Duration,
+) (*Resp, error) {
+	httpResp, err := j.h.WaitCallback(ctx, receiver, fallbackAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetResp(httpResp, j.parse, j.customParserFlag)
+}
+
+// ResultInto waits for the job to be done and decodes the results
+// as returned by the API into v, e.g. a user defined struct.
+func (j *JobHandle) ResultInto(ctx context.Context, v interface{}) error {
+	httpResp, err := j.h.Wait(ctx)
+	if err != nil {
+		return err
+	}
+
+	return decodeInto(httpResp.Body, v)
+}
+
+// PartialResults returns the pages of a multi-page job that are done so far,
+// while the other pages are still being processed.
+func (j *JobHandle) PartialResults(ctx context.Context) (*Resp, error) {
+	httpResp, err := j.h.Partial(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetResp(httpResp, j.parse, j.customParserFlag)
+}
+
+// decodeInto decodes the json body into v.
+func decodeInto(body io.Reader, v interface{}) error {
+	if err := json.NewDecoder(body).Decode(v); err != nil {
+		return fmt.Errorf("error decoding results: %v", err)
+	}
+
+	return nil
+}
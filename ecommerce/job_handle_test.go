@@ -0,0 +1,103 @@
+package ecommerce
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobHandle(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 100 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(10*time.Millisecond))
+	req, _ := NewAmazonSearchRequest("shoes")
+
+	ctx := context.Background()
+	h, err := c.Submit(ctx, req)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// No page is done yet.
+	partial, err := h.PartialResults(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, partial.Results)
+
+	// Partial results are available while waiting for the job.
+	waited := make(chan *Resp, 1)
+	go func() {
+		resp, err := h.Result(ctx)
+		assert.NoError(t, err)
+		waited <- resp
+	}()
+	time.Sleep(20 * time.Millisecond)
+	partialDone := make(chan struct{})
+	go func() {
+		defer close(partialDone)
+		_, err := h.PartialResults(ctx)
+		assert.NoError(t, err)
+	}()
+	select {
+	case <-partialDone:
+	case <-waited:
+		t.Fatal("partial results blocked by the pending wait")
+	}
+
+	resp := <-waited
+	if assert.Len(t, resp.Results, 1) {
+		assert.Contains(t, resp.Results[0].Content, "shoes")
+	}
+
+	// The kept results are decoded again without polling.
+	polls := sim.Jobs()[0].Polls
+	v := struct {
+		Results []struct {
+			Content string `json:"content"`
+			Page    int    `json:"page"`
+		} `json:"results"`
+	}{}
+	assert.NoError(t, h.ResultInto(ctx, &v))
+	if assert.Len(t, v.Results, 1) {
+		assert.Contains(t, v.Results[0].Content, "shoes")
+		assert.Equal(t, 1, v.Results[0].Page)
+	}
+	partial, err = h.PartialResults(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, partial.Results, 1)
+	assert.Equal(t, polls, sim.Jobs()[0].Polls)
+}
+
+func TestJobHandleFaulted(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{FaultRate: 1})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(10*time.Millisecond))
+	req, _ := NewAmazonSearchRequest("shoes")
+
+	ctx := context.Background()
+	h, err := c.Submit(ctx, req)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = h.Result(ctx)
+	assert.ErrorIs(t, err, oxylabs.ErrJobFaulted)
+	assert.ErrorIs(t, h.ResultInto(ctx, &struct{}{}), oxylabs.ErrJobFaulted)
+}
+
+func TestJobHandlePartialResultsCanceled(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: time.Hour})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()))
+	req, _ := NewAmazonSearchRequest("shoes")
+
+	h, err := c.Submit(context.Background(), req)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = h.PartialResults(ctx)
+	assert.ErrorContains(t, err, context.Canceled.Error())
+}
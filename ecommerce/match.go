@@ -0,0 +1,241 @@
+package ecommerce
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Match is the outcome of matching two products across sources.
+// Score ranges from 0 to 1, By is the evidence of the match:
+// "gtin", "asin", "model" or "title".
+type Match struct {
+	Score float64
+	By    string
+}
+
+// DefaultMatchScore is the minimum score of the title matches of BestMatch.
+var DefaultMatchScore = 0.6
+
+// gtinKeys are the identifiers holding a GTIN, of any length.
+var gtinKeys = []string{"gtin", "gtin13", "upc", "ean"}
+
+// titleStopWords are the words left out of normalized titles.
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "for": true, "with": true,
+	"of": true, "in": true, "new": true,
+}
+
+// measureUnits are the units of the measures of titles, e.g. "128gb",
+// which are not model numbers.
+var measureUnits = map[string]bool{
+	"gb": true, "tb": true, "mb": true, "mah": true, "mm": true, "cm": true,
+	"inch": true, "hz": true, "ghz": true, "mhz": true, "ml": true, "oz": true,
+	"kg": true, "lbs": true, "pack": true, "pcs": true, "wh": true,
+}
+
+// ProductResult returns the source-agnostic product of the organic result,
+// e.g. of google_shopping_search or amazon_search, for matching.
+func (o Organic) ProductResult() ProductResult {
+	p := ProductResult{
+		Title:  o.Title,
+		Brand:  o.Manufacturer,
+		Price:  Money{Amount: o.Price, Currency: o.Currency},
+		Rating: o.Rating,
+	}
+	for key, id := range map[string]string{"asin": o.Asin, "product_id": o.ProductId} {
+		if id != "" {
+			if p.Identifiers == nil {
+				p.Identifiers = make(map[string]string)
+			}
+			p.Identifiers[key] = id
+		}
+	}
+
+	return p
+}
+
+// MatchProducts matches two products, e.g. of different retailers. Identifiers
+// shared across retailers are compared first: GTINs, whatever their length,
+// ASINs and model numbers, either given as mpn or found in the titles. The score
+// of products without any shared identifier is the similarity of their titles.
+// Conflicting GTINs or ASINs score 0.
+func MatchProducts(a, b ProductResult) Match {
+	if gtinA, gtinB := gtin(a), gtin(b); gtinA != "" && gtinB != "" {
+		if gtinA == gtinB {
+			return Match{Score: 1, By: "gtin"}
+		}
+		return Match{By: "gtin"}
+	}
+	if asinA, asinB := a.Identifiers["asin"], b.Identifiers["asin"]; asinA != "" && asinB != "" {
+		if strings.EqualFold(asinA, asinB) {
+			return Match{Score: 1, By: "asin"}
+		}
+		return Match{By: "asin"}
+	}
+
+	modelsB := make(map[string]bool)
+	for _, model := range modelNumbers(b) {
+		modelsB[model] = true
+	}
+	for _, model := range modelNumbers(a) {
+		if modelsB[model] {
+			return Match{Score: 0.9, By: "model"}
+		}
+	}
+
+	return Match{Score: TitleSimilarity(a.Title, b.Title), By: "title"}
+}
+
+// BestMatch returns the index of the candidate best matching the product and the
+// match, false if no candidate matches by identifier or scores at least minScore
+// by title. A minScore of 0 uses DefaultMatchScore.
+func BestMatch(product ProductResult, candidates []ProductResult, minScore float64) (int, Match, bool) {
+	if minScore <= 0 {
+		minScore = DefaultMatchScore
+	}
+
+	best, bestMatch := -1, Match{}
+	for i, candidate := range candidates {
+		match := MatchProducts(product, candidate)
+		if match.Score > bestMatch.Score {
+			best, bestMatch = i, match
+		}
+	}
+	if best < 0 || (bestMatch.By == "title" && bestMatch.Score < minScore) {
+		return -1, Match{}, false
+	}
+
+	return best, bestMatch, true
+}
+
+// NormalizeTitle returns the title lowercased, without punctuation and stop words,
+// its words separated by single spaces, e.g. "sony wh 1000xm5 wireless headphones"
+// of "Sony WH-1000XM5 Wireless Headphones - Black, New".
+func NormalizeTitle(title string) string {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	kept := words[:0]
+	for _, word := range words {
+		if !titleStopWords[word] {
+			kept = append(kept, word)
+		}
+	}
+
+	return strings.Join(kept, " ")
+}
+
+// TitleSimilarity returns the similarity of the normalized titles, from 0 to 1,
+// as the Dice coefficient of their character bigrams, which tolerates
+// reordered words and small spelling differences. The similarity of titles with
+// different numbers, e.g. "pegasus 39" and "pegasus 40", is halved, as they
+// usually are different versions of a product.
+func TitleSimilarity(a, b string) float64 {
+	a, b = NormalizeTitle(a), NormalizeTitle(b)
+	similarity := diceCoefficient(bigrams(a), bigrams(b))
+	if numbersA, numbersB := numbers(a), numbers(b); len(numbersA) > 0 && len(numbersB) > 0 && numbersA != numbersB {
+		similarity /= 2
+	}
+
+	return similarity
+}
+
+// diceCoefficient returns the Dice coefficient of the bigram counts.
+func diceCoefficient(bigramsA, bigramsB map[string]int) float64 {
+	total := 0
+	for _, n := range bigramsA {
+		total += n
+	}
+	for _, n := range bigramsB {
+		total += n
+	}
+	if total == 0 {
+		return 0
+	}
+
+	shared := 0
+	for bigram, n := range bigramsA {
+		shared += min(n, bigramsB[bigram])
+	}
+
+	return 2 * float64(shared) / float64(total)
+}
+
+// numbers returns the sorted numeric words of the normalized title, joined by spaces.
+func numbers(title string) string {
+	var nums []string
+	for _, word := range strings.Fields(title) {
+		if strings.IndexFunc(word, func(r rune) bool { return !unicode.IsDigit(r) }) < 0 {
+			nums = append(nums, word)
+		}
+	}
+	sort.Strings(nums)
+
+	return strings.Join(nums, " ")
+}
+
+// bigrams counts the character bigrams of every word of the normalized title.
+func bigrams(title string) map[string]int {
+	counts := make(map[string]int)
+	for _, word := range strings.Fields(title) {
+		runes := []rune(word)
+		if len(runes) == 1 {
+			counts[word]++
+		}
+		for i := 0; i+1 < len(runes); i++ {
+			counts[string(runes[i:i+2])]++
+		}
+	}
+
+	return counts
+}
+
+// gtin returns the GTIN of the product zero-padded to 14 digits, so that
+// UPCs, EANs and GTINs of the same product compare equal. It is empty if none.
+func gtin(p ProductResult) string {
+	for _, key := range gtinKeys {
+		id := strings.TrimSpace(p.Identifiers[key])
+		if id == "" || strings.IndexFunc(id, func(r rune) bool { return r < '0' || r > '9' }) >= 0 || len(id) > 14 {
+			continue
+		}
+		return strings.Repeat("0", 14-len(id)) + id
+	}
+
+	return ""
+}
+
+// modelNumbers returns the model numbers of the product: its mpn and the words of
+// its title mixing letters and digits, e.g. "wh1000xm5" of "WH-1000XM5",
+// lowercased and without separators.
+func modelNumbers(p ProductResult) []string {
+	var models []string
+	if mpn := normalizeModel(p.Identifiers["mpn"]); mpn != "" {
+		models = append(models, mpn)
+	}
+	for _, word := range strings.Fields(p.Title) {
+		model := normalizeModel(word)
+		if len(model) >= 4 && strings.ContainsFunc(model, unicode.IsLetter) &&
+			strings.ContainsFunc(model, unicode.IsDigit) && !isMeasure(model) {
+			models = append(models, model)
+		}
+	}
+
+	return models
+}
+
+// isMeasure returns whether the word is a measure, e.g. "128gb", instead of a model number.
+func isMeasure(word string) bool {
+	return measureUnits[strings.TrimLeftFunc(word, unicode.IsDigit)]
+}
+
+// normalizeModel returns the model number lowercased, without separators.
+func normalizeModel(model string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, model)
+}
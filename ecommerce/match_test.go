@@ -0,0 +1,97 @@
+package ecommerce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeTitle(t *testing.T) {
+	assert.Equal(t,
+		"sony wh 1000xm5 wireless headphones black",
+		NormalizeTitle("Sony WH-1000XM5 Wireless Headphones - Black, New"),
+	)
+}
+
+func TestMatchProducts(t *testing.T) {
+	tests := []struct {
+		name      string
+		a, b      ProductResult
+		wantBy    string
+		wantScore float64
+		wantAbove float64
+	}{
+		{
+			name:      "upc and ean",
+			a:         ProductResult{Identifiers: map[string]string{"upc": "027242923782"}},
+			b:         ProductResult{Identifiers: map[string]string{"ean": "0027242923782"}},
+			wantBy:    "gtin",
+			wantScore: 1,
+		},
+		{
+			name:   "conflicting gtins",
+			a:      ProductResult{Title: "Sony WH-1000XM5", Identifiers: map[string]string{"gtin": "027242923782"}},
+			b:      ProductResult{Title: "Sony WH-1000XM5", Identifiers: map[string]string{"gtin": "027242923799"}},
+			wantBy: "gtin",
+		},
+		{
+			name:      "asin",
+			a:         ProductResult{Identifiers: map[string]string{"asin": "B09XS7JWHH"}},
+			b:         ProductResult{Identifiers: map[string]string{"asin": "b09xs7jwhh"}},
+			wantBy:    "asin",
+			wantScore: 1,
+		},
+		{
+			name:      "model number in title",
+			a:         ProductResult{Title: "Sony WH-1000XM5 Wireless Noise Canceling Headphones"},
+			b:         ProductResult{Title: "WH1000XM5/B Headphones, Black", Identifiers: map[string]string{"mpn": "WH1000XM5"}},
+			wantBy:    "model",
+			wantScore: 0.9,
+		},
+		{
+			name:      "measures are not model numbers",
+			a:         ProductResult{Title: "Apple iPhone 15 128GB"},
+			b:         ProductResult{Title: "Samsung Galaxy S24 128GB"},
+			wantBy:    "title",
+			wantAbove: 0,
+		},
+		{
+			name:      "similar titles",
+			a:         ProductResult{Title: "Nike Air Zoom Pegasus 40 Running Shoes"},
+			b:         ProductResult{Title: "Running Shoes Nike Air Zoom Pegasus 40"},
+			wantBy:    "title",
+			wantAbove: 0.9,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match := MatchProducts(tt.a, tt.b)
+			assert.Equal(t, tt.wantBy, match.By)
+			if tt.wantAbove > 0 {
+				assert.Greater(t, match.Score, tt.wantAbove)
+			} else if tt.wantBy != "title" {
+				assert.Equal(t, tt.wantScore, match.Score)
+			} else {
+				assert.Less(t, match.Score, DefaultMatchScore)
+			}
+		})
+	}
+}
+
+func TestBestMatch(t *testing.T) {
+	product := Organic{Title: "Nike Air Zoom Pegasus 40", Asin: "B0C1234567"}.ProductResult()
+	candidates := []ProductResult{
+		{Title: "Adidas Ultraboost Light"},
+		{Title: "Nike Pegasus 40 Air Zoom, Men's"},
+		{Title: "Nike Air Zoom Pegasus 39"},
+	}
+
+	i, match, ok := BestMatch(product, candidates, 0)
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+	assert.Equal(t, "title", match.By)
+
+	_, _, ok = BestMatch(product, candidates[:1], 0)
+	assert.False(t, ok)
+}
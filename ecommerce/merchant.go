@@ -0,0 +1,61 @@
+package ecommerce
+
+import "strings"
+
+// Total returns the total price of the offer, including shipping and tax
+// if the total is not given.
+func (p Pricing) Total() float64 {
+	if p.PriceTotal != 0 {
+		return p.PriceTotal
+	}
+
+	return p.Price + p.PriceShipping + p.PriceTax
+}
+
+// MerchantOffers are the offers of a single merchant.
+type MerchantOffers struct {
+	Merchant string
+	Rating   float64
+	Offers   []Pricing
+}
+
+// Lowest returns the offer of the merchant with the lowest total price.
+func (m MerchantOffers) Lowest() Pricing {
+	lowest := m.Offers[0]
+	for _, offer := range m.Offers[1:] {
+		if offer.Total() < lowest.Total() {
+			lowest = offer
+		}
+	}
+
+	return lowest
+}
+
+// GroupOffersByMerchant groups the pricing offers of every page of the resps
+// by merchant, in order of first appearance.
+func GroupOffersByMerchant(resps ...*Resp) []MerchantOffers {
+	var (
+		merchants []MerchantOffers
+		index     = make(map[string]int)
+	)
+	for _, resp := range resps {
+		for _, result := range resp.Results {
+			for _, offer := range result.ContentParsed.Pricing {
+				key := strings.ToLower(strings.TrimSpace(offer.Seller))
+				i, ok := index[key]
+				if !ok {
+					i = len(merchants)
+					index[key] = i
+					merchants = append(merchants, MerchantOffers{Merchant: strings.TrimSpace(offer.Seller)})
+				}
+
+				merchants[i].Offers = append(merchants[i].Offers, offer)
+				if offer.SellerRating != 0 {
+					merchants[i].Rating = offer.SellerRating
+				}
+			}
+		}
+	}
+
+	return merchants
+}
@@ -0,0 +1,27 @@
+package ecommerce
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupOffersByMerchant(t *testing.T) {
+	page := func(offers ...Pricing) *Resp {
+		result := Results{}
+		result.ContentParsed.Pricing = offers
+		return &Resp{Results: []Results{result}}
+	}
+
+	merchants := GroupOffersByMerchant(
+		page(Pricing{Seller: "Shop A", Price: 10, PriceShipping: 5}, Pricing{Seller: "Shop B", PriceTotal: 12, SellerRating: 4.2}),
+		page(Pricing{Seller: "shop a ", Price: 11, SellerRating: 4.8}),
+	)
+
+	assert.Len(t, merchants, 2)
+	assert.Equal(t, "Shop A", merchants[0].Merchant)
+	assert.Equal(t, 4.8, merchants[0].Rating)
+	assert.Len(t, merchants[0].Offers, 2)
+	assert.Equal(t, 11.0, merchants[0].Lowest().Total())
+	assert.Equal(t, 12.0, merchants[1].Lowest().Total())
+}
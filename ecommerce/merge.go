@@ -0,0 +1,79 @@
+package ecommerce
+
+import "github.com/revvim/oxylabs-sdk-go/internal"
+
+// DedupReport reports the results dropped as duplicates when merging pages.
+type DedupReport struct {
+	Total   int
+	Kept    int
+	Dropped int
+}
+
+// MergeOrganic merges the organic results of every page of the resps, in order,
+// dropping results repeated across pages by product id, asin or url.
+func MergeOrganic(resps ...*Resp) ([]Organic, DedupReport) {
+	var items []Organic
+	for _, resp := range resps {
+		for _, result := range resp.Results {
+			items = append(items, result.ContentParsed.Results.Organic...)
+		}
+	}
+
+	kept, dropped := internal.Dedup(items, organicKey)
+
+	return kept, DedupReport{Total: len(items), Kept: len(kept), Dropped: dropped}
+}
+
+// MergePages merges the organic results of the pages of a stream until it is closed,
+// dropping results repeated across pages. It returns the first page error, if any.
+func MergePages(pages <-chan PageResult) ([]Organic, DedupReport, error) {
+	var (
+		resps    []*Resp
+		firstErr error
+	)
+	for page := range pages {
+		if page.Err != nil {
+			if firstErr == nil {
+				firstErr = page.Err
+			}
+			continue
+		}
+		resps = append(resps, page.Resp)
+	}
+
+	items, report := MergeOrganic(resps...)
+	return items, report, firstErr
+}
+
+// dedupOrganic drops the organic results repeated across the pages of the resp,
+// or already in seen, e.g. the results of previous resps, and returns the report.
+// The raw content of the results is left as returned by the API.
+func (r *Resp) dedupOrganic(seen map[string]bool) DedupReport {
+	report := DedupReport{}
+	for i := range r.Results {
+		organic := r.Results[i].ContentParsed.Results.Organic
+		if len(organic) == 0 {
+			continue
+		}
+
+		kept, dropped := internal.DedupSeen(organic, organicKey, seen)
+		r.Results[i].ContentParsed.Results.Organic = kept
+		report.Total += len(organic)
+		report.Kept += len(kept)
+		report.Dropped += dropped
+	}
+
+	return report
+}
+
+// organicKey returns the key of the organic result: its product id, asin or normalized url.
+func organicKey(o Organic) string {
+	switch {
+	case o.ProductId != "":
+		return "product_id:" + o.ProductId
+	case o.Asin != "":
+		return "asin:" + o.Asin
+	default:
+		return internal.NormalizeUrl(o.Url)
+	}
+}
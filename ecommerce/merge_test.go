@@ -0,0 +1,51 @@
+package ecommerce
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoPagesDedup(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{
+		Content: func(payload map[string]interface{}) interface{} {
+			page, _ := payload["start_page"].(float64)
+			return map[string]interface{}{
+				"page": page,
+				"results": map[string]interface{}{
+					"organic": []map[string]interface{}{
+						{"product_id": fmt.Sprint(page), "pos": 1},
+						{"product_id": fmt.Sprint(page + 1), "pos": 2},
+					},
+				},
+			}
+		},
+	})
+	c := Init("user", "pass",
+		WithHttpClient(sim.HttpClient()),
+		WithPagesGuard(oxylabs.PagesGuard{MaxUnits: 1, Split: true}),
+		WithDefaultPollInterval(5*time.Millisecond),
+	)
+
+	resp, err := c.ScrapeGoogleShoppingSearch("adidas", &GoogleShoppingSearchOpts{Pages: 2, Parse: oxylabs.Bool(true)})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.Results, 2) {
+		return
+	}
+	assert.Equal(t, DedupReport{Total: 4, Kept: 3, Dropped: 1}, resp.Dedup)
+
+	var productIds []string
+	for _, result := range resp.Results {
+		for _, o := range result.ContentParsed.Results.Organic {
+			productIds = append(productIds, o.ProductId)
+		}
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, productIds)
+
+	items, report := MergeOrganic(resp)
+	assert.Len(t, items, 3)
+	assert.Equal(t, DedupReport{Total: 3, Kept: 3}, report)
+}
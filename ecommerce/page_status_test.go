@@ -0,0 +1,26 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailedPages(t *testing.T) {
+	resp := &Resp{Parse: true}
+	err := json.Unmarshal([]byte(`{"results":[
+		{"content":{"parse_status_code":12000},"page":1,"job_id":"1","status_code":200},
+		{"content":{"parse_status_code":12004},"page":2,"job_id":"1","status_code":200},
+		{"content":{},"page":3,"job_id":"1","status_code":613}
+	]}`), resp)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []int{2, 3}, resp.FailedPages())
+
+	_, err = resp.RetryPages(context.Background(), resp.FailedPages()...)
+	assert.EqualError(t, err, "resp was not returned by a client")
+}
@@ -0,0 +1,110 @@
+package ecommerce
+
+import (
+	"strings"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// PricePoint is an offer of a product at a point in time, in a flat and stable
+// schema ready for time-series storage. ProductKey identifies the product
+// across observations, e.g. its product ID or ASIN. Availability is
+// "in_stock" or "out_of_stock", empty if the offer doesn't tell it.
+type PricePoint struct {
+	Timestamp    time.Time      `json:"timestamp"`
+	Source       oxylabs.Source `json:"source"`
+	Seller       string         `json:"seller"`
+	ProductKey   string         `json:"product_key"`
+	Price        float64        `json:"price"`
+	Currency     string         `json:"currency"`
+	Shipping     float64        `json:"shipping"`
+	Availability string         `json:"availability"`
+}
+
+// resultTimeLayouts are the layouts of the created_at and updated_at times of results.
+var resultTimeLayouts = []string{"2006-01-02 15:04:05", time.RFC3339}
+
+// PricePoints returns a price point per pricing offer of every parsed page of
+// the resp, or per product of the pages without offers. The points are timed
+// at the update of their page, and keyed by the query of the job, e.g. the
+// product ID, or by the url of the page.
+func (r *Resp) PricePoints() []PricePoint {
+	var points []PricePoint
+	for _, result := range r.Results {
+		content := result.ContentParsed
+		point := PricePoint{
+			Timestamp:  resultTime(result),
+			Source:     oxylabs.Source(r.Job.Source),
+			ProductKey: r.Job.Query,
+			Currency:   content.Currency,
+		}
+		if point.ProductKey == "" {
+			point.ProductKey = content.Url
+		}
+
+		if len(content.Pricing) == 0 {
+			if content.Price != 0 {
+				point.Seller = content.BusinessName
+				point.Price = content.Price
+				point.Shipping = content.PriceShipping
+				point.Availability = offerAvailability(content.Stock)
+				points = append(points, point)
+			}
+			continue
+		}
+
+		for _, offer := range content.Pricing {
+			offerPoint := point
+			offerPoint.Seller = strings.TrimSpace(offer.Seller)
+			offerPoint.Price = offer.Price
+			offerPoint.Shipping = offer.PriceShipping
+			offerPoint.Availability = offerAvailability(offer.Details + " " + offer.Delivery)
+			if offer.Currency != "" {
+				offerPoint.Currency = offer.Currency
+			}
+			points = append(points, offerPoint)
+		}
+	}
+
+	return points
+}
+
+// PricePoint returns the price point of the product observed at the time.
+func (p ProductResult) PricePoint(at time.Time, source oxylabs.Source, productKey string) PricePoint {
+	return PricePoint{
+		Timestamp:    at.UTC(),
+		Source:       source,
+		ProductKey:   productKey,
+		Price:        p.Price.Amount,
+		Currency:     p.Price.Currency,
+		Availability: p.Availability,
+	}
+}
+
+// resultTime returns the time the result was updated, or created, or now if unknown.
+func resultTime(result Results) time.Time {
+	for _, value := range []string{result.UpdatedAt, result.CreatedAt} {
+		for _, layout := range resultTimeLayouts {
+			if t, err := time.Parse(layout, value); err == nil {
+				return t.UTC()
+			}
+		}
+	}
+
+	return time.Now().UTC()
+}
+
+// offerAvailability returns the availability of an offer from its stock or
+// delivery text, empty if there is no text to tell it from.
+func offerAvailability(text string) string {
+	text = strings.ToLower(strings.TrimSpace(text))
+	switch {
+	case text == "":
+		return ""
+	case strings.Contains(text, "out of stock"), strings.Contains(text, "unavailable"):
+		return "out_of_stock"
+	default:
+		return "in_stock"
+	}
+}
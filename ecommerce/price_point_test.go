@@ -0,0 +1,47 @@
+package ecommerce
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestRespPricePoints(t *testing.T) {
+	resp := &Resp{
+		Job: Job{Source: string(oxylabs.GoogleShoppingPricing), Query: "1234"},
+		Results: []Results{{
+			UpdatedAt: "2024-03-01 10:00:00",
+			ContentParsed: Content{Pricing: []Pricing{
+				{Seller: " Shop A ", Price: 10, PriceShipping: 2, Currency: "EUR"},
+				{Seller: "Shop B", Price: 12, Details: "Out of stock online"},
+			}},
+		}},
+	}
+
+	at := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	assert.Equal(t, []PricePoint{
+		{Timestamp: at, Source: oxylabs.GoogleShoppingPricing, Seller: "Shop A", ProductKey: "1234", Price: 10, Currency: "EUR", Shipping: 2},
+		{Timestamp: at, Source: oxylabs.GoogleShoppingPricing, Seller: "Shop B", ProductKey: "1234", Price: 12, Availability: "out_of_stock"},
+	}, resp.PricePoints())
+}
+
+func TestOfferAvailability(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{text: "", want: ""},
+		{text: "  ", want: ""},
+		{text: "In stock", want: "in_stock"},
+		{text: "Free delivery by Mon", want: "in_stock"},
+		{text: "Out of stock online", want: "out_of_stock"},
+		{text: "Currently unavailable.", want: "out_of_stock"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, offerAvailability(tt.text), tt.text)
+	}
+}
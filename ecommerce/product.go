@@ -0,0 +1,196 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Money is an amount in a currency.
+type Money struct {
+	Amount   float64 `json:"amount"`
+	Currency string  `json:"currency"`
+}
+
+// ProductResult is a source-agnostic product, decoded from the parsed content
+// of adaptive parsing (universal) or retailer specific sources like Walmart or Target.
+// Identifiers holds the product ids found, e.g. sku, gtin or asin.
+type ProductResult struct {
+	Title        string            `json:"title"`
+	Brand        string            `json:"brand"`
+	Price        Money             `json:"price"`
+	Availability string            `json:"availability"`
+	Rating       float64           `json:"rating"`
+	Identifiers  map[string]string `json:"identifiers,omitempty"`
+}
+
+// identifierKeys are the keys of the parsed content holding product ids.
+var identifierKeys = []string{
+	"sku", "asin", "gtin", "gtin13", "upc", "ean", "mpn", "product_id", "item_id",
+}
+
+// Custom function to unmarshal into the ProductResult struct.
+// Because of different layouts of the parsed product depending on the source.
+func (p *ProductResult) UnmarshalJSON(data []byte) error {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	// Flatten the general section of retailer specific parsers,
+	// and the identifiers of marshalled products.
+	for _, section := range []string{"general", "identifiers"} {
+		if fields, ok := raw[section].(map[string]interface{}); ok {
+			for k, v := range fields {
+				if _, ok := raw[k]; !ok {
+					raw[k] = v
+				}
+			}
+		}
+	}
+
+	*p = ProductResult{
+		Title:        firstString(raw, "title", "product_name", "name"),
+		Brand:        firstString(raw, "brand", "manufacturer"),
+		Availability: availability(raw),
+		Rating:       number(raw["rating"], "rating", "value", "score"),
+	}
+
+	// Price may be a number, a string or an object with its currency.
+	p.Price.Amount = number(raw["price"], "price", "amount", "value")
+	p.Price.Currency = firstString(raw, "currency")
+	if price, ok := raw["price"].(map[string]interface{}); ok && p.Price.Currency == "" {
+		p.Price.Currency = firstString(price, "currency")
+	}
+
+	for _, key := range identifierKeys {
+		if id := firstString(raw, key); id != "" {
+			if p.Identifiers == nil {
+				p.Identifiers = make(map[string]string)
+			}
+			p.Identifiers[key] = id
+		}
+	}
+
+	return nil
+}
+
+// Key returns the first identifier of the product prefixed with its key, e.g.
+// "asin:B07FZ8S74R", or its title if it has none.
+func (p ProductResult) Key() string {
+	for _, key := range identifierKeys {
+		if id := p.Identifiers[key]; id != "" {
+			return key + ":" + id
+		}
+	}
+
+	return p.Title
+}
+
+// Products decodes the parsed content of every page of the resp into products.
+func (r *Resp) Products() ([]ProductResult, error) {
+	products := make([]ProductResult, 0, len(r.Results))
+	for _, result := range r.Results {
+		if len(result.ContentRaw) == 0 || result.ContentRaw[0] != '{' {
+			return nil, fmt.Errorf("page %d has no parsed content", result.Page)
+		}
+
+		product := ProductResult{}
+		if err := json.Unmarshal(result.ContentRaw, &product); err != nil {
+			return nil, fmt.Errorf("error decoding product: %v", err)
+		}
+		products = append(products, product)
+	}
+
+	return products, nil
+}
+
+// availability returns the availability of the parsed product.
+func availability(raw map[string]interface{}) string {
+	if inStock, ok := raw["in_stock"].(bool); ok {
+		if inStock {
+			return "in_stock"
+		}
+		return "out_of_stock"
+	}
+
+	return firstString(raw, "availability", "stock")
+}
+
+// firstString returns the first non-empty value of the keys as a string.
+func firstString(raw map[string]interface{}, keys ...string) string {
+	for _, key := range keys {
+		switch v := raw[key].(type) {
+		case string:
+			if v != "" {
+				return strings.TrimSpace(v)
+			}
+		case float64:
+			return strconv.FormatFloat(v, 'f', -1, 64)
+		}
+	}
+
+	return ""
+}
+
+// number returns v as a number. If v is an object, the first numeric value of the keys is used.
+func number(v interface{}, keys ...string) float64 {
+	switch v := v.(type) {
+	case float64:
+		return v
+	case string:
+		// Drop currency symbols, e.g. "$1,299.99" or "1.299,99 €".
+		cleaned := strings.Map(func(r rune) rune {
+			if (r >= '0' && r <= '9') || r == '.' || r == ',' {
+				return r
+			}
+			return -1
+		}, v)
+		n, _ := strconv.ParseFloat(decimalPoint(cleaned), 64)
+		return n
+	case map[string]interface{}:
+		for _, key := range keys {
+			if n := number(v[key]); n != 0 {
+				return n
+			}
+		}
+	}
+
+	return 0
+}
+
+// decimalPoint returns the number s with its thousands separators dropped and
+// its decimal separator, a comma or a point, replaced by a point. The last
+// separator is the decimal one if both are used, e.g. "1.299,99", otherwise a
+// single separator followed by other than 3 digits, e.g. "12,99" but not "1,299".
+func decimalPoint(s string) string {
+	decimal := byte(0)
+	lastComma, lastPoint := strings.LastIndexByte(s, ','), strings.LastIndexByte(s, '.')
+	switch {
+	case lastComma >= 0 && lastPoint >= 0:
+		decimal = ','
+		if lastPoint > lastComma {
+			decimal = '.'
+		}
+	case lastComma >= 0 || lastPoint >= 0:
+		last := max(lastComma, lastPoint)
+		if strings.Count(s, string(s[last])) == 1 && len(s)-last-1 != 3 {
+			decimal = s[last]
+		}
+	}
+
+	var b strings.Builder
+	last := strings.LastIndexByte(s, decimal)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case decimal != 0 && i == last:
+			b.WriteByte('.')
+		case s[i] == ',' || s[i] == '.':
+		default:
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package ecommerce
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProductResultUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want ProductResult
+	}{
+		{
+			name: "Adaptive parsing",
+			data: `{"title": "Shoe", "brand": "Adidas", "price": 59.99, "currency": "EUR", "availability": "InStock", "rating": 4.5, "gtin": "4062"}`,
+			want: ProductResult{
+				Title:        "Shoe",
+				Brand:        "Adidas",
+				Price:        Money{Amount: 59.99, Currency: "EUR"},
+				Availability: "InStock",
+				Rating:       4.5,
+				Identifiers:  map[string]string{"gtin": "4062"},
+			},
+		},
+		{
+			name: "Walmart",
+			data: `{"general": {"title": "Shoe", "brand": "Adidas"}, "price": {"price": 1299.5, "currency": "USD"}, "rating": {"rating": 4, "count": 10}, "in_stock": false, "item_id": 123}`,
+			want: ProductResult{
+				Title:        "Shoe",
+				Brand:        "Adidas",
+				Price:        Money{Amount: 1299.5, Currency: "USD"},
+				Availability: "out_of_stock",
+				Rating:       4,
+				Identifiers:  map[string]string{"item_id": "123"},
+			},
+		},
+		{
+			name: "Price string",
+			data: `{"product_name": "Shoe", "price": "$1,299.99"}`,
+			want: ProductResult{
+				Title: "Shoe",
+				Price: Money{Amount: 1299.99},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			product := ProductResult{}
+			assert.NoError(t, json.Unmarshal([]byte(tt.data), &product))
+			assert.Equal(t, tt.want, product)
+		})
+	}
+}
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		v    interface{}
+		want float64
+	}{
+		{v: 12.5, want: 12.5},
+		{v: "$1,299.99", want: 1299.99},
+		{v: "1.299,99 €", want: 1299.99},
+		{v: "1 299,99 zł", want: 1299.99},
+		{v: "12,99 €", want: 12.99},
+		{v: "$12.99", want: 12.99},
+		{v: "1,299", want: 1299},
+		{v: "1.299", want: 1299},
+		{v: "1,234,567.89", want: 1234567.89},
+		{v: "1.234.567,89", want: 1234567.89},
+		{v: "1.234.567", want: 1234567},
+		{v: "$5", want: 5},
+		{v: "free", want: 0},
+		{v: map[string]interface{}{"amount": "19,90"}, want: 19.9},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, number(tt.v, "amount"), "%v", tt.v)
+	}
+}
+
+func TestProductResultKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		product ProductResult
+		want    string
+	}{
+		{name: "first identifier", product: ProductResult{Title: "Shoe", Identifiers: map[string]string{"upc": "0123", "asin": "B07FZ8S74R"}}, want: "asin:B07FZ8S74R"},
+		{name: "empty identifier", product: ProductResult{Title: "Shoe", Identifiers: map[string]string{"sku": "", "mpn": "M1"}}, want: "mpn:M1"},
+		{name: "title", product: ProductResult{Title: "Shoe"}, want: "Shoe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.product.Key())
+		})
+	}
+}
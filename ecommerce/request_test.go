@@ -0,0 +1,60 @@
+package ecommerce
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoFireAndForget(t *testing.T) {
+	tests := []struct {
+		name          string
+		clientOpt     bool
+		reqOpt        bool
+		callbackUrl   string
+		wantSubmitted bool
+	}{
+		{name: "client option", clientOpt: true, callbackUrl: "https://example.com/callback", wantSubmitted: true},
+		{name: "req option", reqOpt: true, callbackUrl: "https://example.com/callback", wantSubmitted: true},
+		{name: "no option", callbackUrl: "https://example.com/callback"},
+		{name: "no callback url", clientOpt: true, reqOpt: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+			opts := []ClientOption{WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5 * time.Millisecond)}
+			if tt.clientOpt {
+				opts = append(opts, WithFireAndForget())
+			}
+			c := InitAsync("user", "pass", opts...)
+
+			req, _ := NewGoogleShoppingSearchRequest("adidas", &GoogleShoppingSearchOpts{CallbackURL: tt.callbackUrl})
+			req.FireAndForget = tt.reqOpt
+			respChan, err := c.Do(context.Background(), req)
+			if !assert.NoError(t, err) {
+				return
+			}
+			resp := <-respChan
+			if !assert.NotNil(t, resp) {
+				return
+			}
+
+			job := sim.Jobs()[0]
+			assert.Equal(t, job.ID, resp.Job.ID)
+			if tt.wantSubmitted {
+				assert.Equal(t, "pending", resp.Job.Status)
+				assert.Equal(t, tt.callbackUrl, resp.Job.CallbackUrl)
+				assert.Equal(t, "google_shopping_search", resp.Job.Source)
+				assert.Empty(t, resp.Results)
+				assert.Zero(t, job.Polls, "the job is not polled")
+				return
+			}
+			assert.Len(t, resp.Results, 1)
+			assert.NotZero(t, job.Polls)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package ecommerce
+
+import (
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequests(t *testing.T) {
+	toaster := oxylabs.UserAgent("toaster")
+	tests := []struct {
+		source  oxylabs.Source
+		input   string
+		newReq  func(input string) (*oxylabs.Request, error)
+		invalid func(input string) (*oxylabs.Request, error)
+	}{
+		{
+			oxylabs.AmazonUrl, "https://www.amazon.com/dp/B07FZ8S74R",
+			func(url string) (*oxylabs.Request, error) { return NewAmazonUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewAmazonUrlRequest(url, &AmazonUrlOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonSearch, "nirvana tshirt",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonSearchRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonSearchRequest(query, &AmazonSearchOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonProduct, "B07FZ8S74R",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonProductRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonProductRequest(query, &AmazonProductOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonPricing, "B07FZ8S74R",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonPricingRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonPricingRequest(query, &AmazonPricingOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonReviews, "B07FZ8S74R",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonReviewsRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonReviewsRequest(query, &AmazonReviewsOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonQuestions, "B07FZ8S74R",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonQuestionsRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonQuestionsRequest(query, &AmazonQuestionsOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonBestsellers, "120225786011",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonBestsellersRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonBestsellersRequest(query, &AmazonBestsellersOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.AmazonSellers, "A2L77EE7U53NWQ",
+			func(query string) (*oxylabs.Request, error) { return NewAmazonSellersRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewAmazonSellersRequest(query, &AmazonSellersOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleShoppingUrl, "https://shopping.google.com/product/123",
+			func(url string) (*oxylabs.Request, error) { return NewGoogleShoppingUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewGoogleShoppingUrlRequest(url, &GoogleShoppingUrlOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleShoppingSearch, "adidas",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleShoppingSearchRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleShoppingSearchRequest(query, &GoogleShoppingSearchOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleShoppingProduct, "123",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleShoppingProductRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleShoppingProductRequest(query, &GoogleShoppingProductOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleShoppingPricing, "123",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleShoppingPricingRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleShoppingPricingRequest(query, &GoogleShoppingPricingOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.Universal, "https://www.example.com/product/1",
+			func(url string) (*oxylabs.Request, error) { return NewUniversalUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewUniversalUrlRequest(url, &UniversalUrlOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.WalmartUrl, "https://www.walmart.com/ip/123",
+			func(url string) (*oxylabs.Request, error) { return NewWalmartUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewWalmartUrlRequest(url, &WalmartUrlOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.WayfairSearch, "chair",
+			func(query string) (*oxylabs.Request, error) { return NewWayfairSearchRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewWayfairSearchRequest(query, &WayfairSearchOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.Wayfair, "https://www.wayfair.com/furniture/pdp/123.html",
+			func(url string) (*oxylabs.Request, error) { return NewWayfairUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewWayfairUrlRequest(url, &WayfairUrlOpts{UserAgent: toaster})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.source), func(t *testing.T) {
+			req, err := tt.newReq(tt.input)
+			if !assert.NoError(t, err) {
+				return
+			}
+			payload, err := req.Payload()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.source, payload["source"])
+			assert.Contains(t, []interface{}{payload["query"], payload["url"]}, tt.input)
+
+			_, err = tt.invalid(tt.input)
+			assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter, "the options are validated by the constructor")
+			assert.ErrorContains(t, err, "invalid user agent parameter: toaster")
+		})
+	}
+}
+
+func TestNewUniversalUrlRequestSession(t *testing.T) {
+	session := oxylabs.NewSession(0)
+	req, err := NewUniversalUrlRequest("https://example.com", &UniversalUrlOpts{Session: session})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	sessionId := func() interface{} {
+		payload, err := req.Payload()
+		if !assert.NoError(t, err) {
+			return nil
+		}
+		for _, param := range payload["context"].([]map[string]interface{}) {
+			if param["key"] == "session_id" {
+				return param["value"]
+			}
+		}
+		return nil
+	}
+
+	assert.Equal(t, session.ID(), sessionId())
+
+	// The id of the current session is sent, so rotated sessions apply to prepared reqs.
+	session.Rotate()
+	assert.Equal(t, session.ID(), sessionId())
+
+	_, err = NewUniversalUrlRequest("https://example.com", &UniversalUrlOpts{
+		Session: session,
+		Context: []func(oxylabs.ContextOption){oxylabs.SessionId("abc")},
+	})
+	assert.Error(t, err)
+}
@@ -1,33 +1,77 @@
 package ecommerce
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 // Resp is the response struct for all ecommerce sources.
 type Resp struct {
-	Parse             bool      `json:"parse"`
-	ParseInstructions bool      `json:"parse_instructions"`
-	Results           []Results `json:"results"`
-	Job               Job       `json:"job"`
-	StatusCode        int       `json:"status_code"`
-	Status            string    `json:"status"`
+	Parse             bool                   `json:"parse"`
+	ParseInstructions bool                   `json:"parse_instructions"`
+	Results           []Results              `json:"results"`
+	Job               Job                    `json:"job"`
+	StatusCode        int                    `json:"status_code"`
+	Status            string                 `json:"status"`
+	Warnings          []oxylabs.Warning      `json:"-"`
+	Attempts          []oxylabs.Attempt      `json:"-"`
+	RetryHistory      oxylabs.RetryHistory   `json:"-"`
+	Classification    oxylabs.Classification `json:"-"`
+	SchemaVersion     string                 `json:"-"`
+	Dedup             DedupReport            `json:"-"`
+
+	req *oxylabs.Request
+	do  func(context.Context, *oxylabs.Request) (*Resp, error)
+}
+
+// String returns a compact summary of the resp for logging,
+// without the content of the results.
+func (r *Resp) String() string {
+	if r == nil {
+		return "<nil>"
+	}
+
+	fields := []string{fmt.Sprintf("Source: %s", r.Job.Source)}
+	if r.Job.Query != "" {
+		fields = append(fields, fmt.Sprintf("Query: %s", internal.Truncate(r.Job.Query, 64)))
+	}
+	if url, ok := r.Job.Url.(string); ok && url != "" {
+		fields = append(fields, fmt.Sprintf("Url: %s", internal.Truncate(url, 64)))
+	}
+	if r.Job.Pages != 0 {
+		fields = append(fields, fmt.Sprintf("Pages: %d", r.Job.Pages))
+	}
+	if r.Job.GeoLocation != nil {
+		fields = append(fields, fmt.Sprintf("GeoLocation: %v", r.Job.GeoLocation))
+	}
+	fields = append(fields,
+		fmt.Sprintf("Status: %s", r.Status),
+		fmt.Sprintf("Results: %d", len(r.Results)),
+	)
+
+	return fmt.Sprintf("Resp{%s}", strings.Join(fields, ", "))
 }
 
 type Results struct {
 	CustomContentParsed map[string]interface{}
 	ContentParsed       Content
 	Content             string
-	CreatedAt           string `json:"created_at"`
-	UpdatedAt           string `json:"updated_at"`
-	Page                int    `json:"page"`
-	Url                 string `json:"url"`
-	JobID               string `json:"job_id"`
-	StatusCode          int    `json:"status_code"`
-	ParserType          string `json:"parser_type"`
+	ContentRaw          json.RawMessage `json:"-"`
+	CreatedAt           string          `json:"created_at"`
+	UpdatedAt           string          `json:"updated_at"`
+	Page                int             `json:"page"`
+	Url                 string          `json:"url"`
+	JobID               string          `json:"job_id"`
+	StatusCode          int             `json:"status_code"`
+	ParserType          string          `json:"parser_type"`
 }
 
 type Content struct {
@@ -73,13 +117,13 @@ type Content struct {
 	ProductName            string                         `json:"product_name"`
 	BulletPoints           string                         `json:"bullet_points"`
 	IsAddonItem            bool                           `json:"is_addon_item"`
-	PriceInitial           int                            `json:"price_initial"`
+	PriceInitial           float64                        `json:"price_initial"`
 	PricingCount           int                            `json:"pricing_count"`
 	ReviewsCount           int                            `json:"reviews_count"`
 	SNSDiscounts           []interface{}                  `json:"sns_discounts"`
 	DeveloperInfo          []interface{}                  `json:"developer_info"`
 	LightningDeal          interface{}                    `json:"lightning_deal"`
-	PriceShipping          int                            `json:"price_shipping"`
+	PriceShipping          float64                        `json:"price_shipping"`
 	IsPrimePantry          bool                           `json:"is_prime_pantry"`
 	ProductDetails         ProductDetails                 `json:"product_details"`
 	FeaturedMerchant       []interface{}                  `json:"featured_merchant"`
@@ -260,6 +304,7 @@ type Pricing struct {
 
 	Delivery        string      `json:"delivery"`
 	SellerId        string      `json:"seller_id"`
+	SellerRating    float64     `json:"seller_rating"`
 	RatingCount     int         `json:"rating_count"`
 	DeliveryOptions interface{} `json:"delivery_options"`
 }
@@ -506,6 +551,14 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 
 		// Unmarshal each result into the Results slice.
 		for _, resultRawMessage := range resultsRawMessages {
+			// Keep the raw content for decoding into typed models.
+			var rawResult struct {
+				Content json.RawMessage `json:"content"`
+			}
+			if err := json.Unmarshal(resultRawMessage, &rawResult); err != nil {
+				return err
+			}
+
 			if r.Parse && !r.ParseInstructions {
 				var result struct {
 					ContentParsed Content `json:"content"`
@@ -516,10 +569,21 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 					JobID         string  `json:"job_id"`
 					StatusCode    int     `json:"status_code"`
 				}
+				// Fields whose type differs from the typed model, e.g. the reviews
+				// object of google_shopping_product pages, are left unset; the
+				// content is still decodable from ContentRaw.
 				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
-					return err
+					var typeErr *json.UnmarshalTypeError
+					if !errors.As(err, &typeErr) {
+						return err
+					}
+					r.Warnings = append(r.Warnings, oxylabs.Warning{
+						Page:    result.Page,
+						Message: fmt.Sprintf("%s does not match the typed content: %v", typeErr.Field, typeErr.Value),
+					})
 				}
 				r.Results = append(r.Results, Results{
+					ContentRaw:    rawResult.Content,
 					ContentParsed: result.ContentParsed,
 					CreatedAt:     result.CreatedAt,
 					UpdatedAt:     result.UpdatedAt,
@@ -542,6 +606,7 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 					return err
 				}
 				r.Results = append(r.Results, Results{
+					ContentRaw:          rawResult.Content,
 					CustomContentParsed: result.CustomContentParsed,
 					CreatedAt:           result.CreatedAt,
 					UpdatedAt:           result.UpdatedAt,
@@ -564,6 +629,7 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 					return err
 				}
 				r.Results = append(r.Results, Results{
+					ContentRaw: rawResult.Content,
 					Content:    result.Content,
 					CreatedAt:  result.CreatedAt,
 					UpdatedAt:  result.UpdatedAt,
@@ -604,7 +670,11 @@ func GetResp(
 
 	// If status code not 200, return error.
 	if httpResp.StatusCode != 200 {
-		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
+		return nil, &oxylabs.StatusError{
+			StatusCode: httpResp.StatusCode,
+			Status:     httpResp.Status,
+			Body:       respBody,
+		}
 	}
 
 	// Unmarshal the JSON object.
@@ -619,5 +689,10 @@ func GetResp(
 	res.StatusCode = httpResp.StatusCode
 	res.Status = httpResp.Status
 
+	// Set warnings of partially parsed pages.
+	for _, result := range res.Results {
+		res.Warnings = append(res.Warnings, internal.ParseWarnings(result.Page, result.ContentRaw)...)
+	}
+
 	return res, nil
 }
@@ -0,0 +1,62 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+)
+
+// PageResult is a single page of a streamed paginated scrape.
+// Err is set if scraping the page failed, in which case Resp is nil.
+type PageResult struct {
+	Page int
+	Resp *Resp
+	Err  error
+}
+
+// ScrapeGoogleShoppingSearchStream scrapes google shopping via Oxylabs E-Commerce API
+// with google_shopping_search as source, one page per request.
+// Each page is sent to the returned channel as soon as it arrives. The channel is
+// closed once all pages have been scraped or the provided context is done.
+func (c *EcommerceClient) ScrapeGoogleShoppingSearchStream(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleShoppingSearchOpts,
+) (<-chan PageResult, error) {
+	// Prepare options.
+	opt := internal.WithClientDefaults(c.C, opts)
+
+	// Check validity of parameters up front, so an invalid
+	// request fails before any page is scraped.
+	_, err := NewGoogleShoppingSearchRequest(query, opt)
+	if err != nil {
+		return nil, err
+	}
+
+	pageChan := make(chan PageResult)
+
+	go func() {
+		defer close(pageChan)
+
+		for page := opt.StartPage; page < opt.StartPage+opt.Pages; page++ {
+			// Scrape a single page with otherwise identical options.
+			pageOpt := *opt
+			pageOpt.StartPage = page
+			pageOpt.Pages = 1
+
+			resp, err := c.ScrapeGoogleShoppingSearchCtx(ctx, query, &pageOpt)
+
+			select {
+			case pageChan <- PageResult{Page: page, Resp: resp, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+
+			if ctx.Err() != nil {
+				return
+			}
+		}
+	}()
+
+	return pageChan, nil
+}
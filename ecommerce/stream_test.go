@@ -0,0 +1,126 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+// pagesApi returns a transport serving a result per page of the payload,
+// failing the pages of failed, and recording the payloads.
+func pagesApi(failed ...int) (http.RoundTripper, *[]map[string]interface{}) {
+	var mu sync.Mutex
+	var payloads []map[string]interface{}
+
+	return roundTripFunc(func(req *http.Request) *http.Response {
+		payload := map[string]interface{}{}
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		mu.Lock()
+		payloads = append(payloads, payload)
+		mu.Unlock()
+
+		page := int(payload["start_page"].(float64))
+		for _, f := range failed {
+			if f == page {
+				return &http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(`{}`))}
+			}
+		}
+
+		body := fmt.Sprintf(`{"results":[{"content":"page %d","page":%d}]}`, page, page)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	}), &payloads
+}
+
+func TestScrapeGoogleShoppingSearchStream(t *testing.T) {
+	transport, payloads := pagesApi(3)
+	c := Init("user", "pass")
+	c.C.HttpClient = &http.Client{Transport: transport}
+
+	pageChan, err := c.ScrapeGoogleShoppingSearchStream(context.Background(), "adidas", &GoogleShoppingSearchOpts{StartPage: 2, Pages: 3})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var pages []PageResult
+	for page := range pageChan {
+		pages = append(pages, page)
+	}
+	if !assert.Len(t, pages, 3) {
+		return
+	}
+
+	assert.Equal(t, 2, pages[0].Page)
+	assert.NoError(t, pages[0].Err)
+	assert.Equal(t, "page 2", pages[0].Resp.Results[0].Content)
+
+	assert.Equal(t, 3, pages[1].Page)
+	assert.Error(t, pages[1].Err, "a failed page does not end the stream")
+	assert.Nil(t, pages[1].Resp)
+
+	assert.Equal(t, 4, pages[2].Page)
+	assert.Equal(t, "page 4", pages[2].Resp.Results[0].Content)
+
+	for i, payload := range *payloads {
+		assert.EqualValues(t, 2+i, payload["start_page"])
+		assert.EqualValues(t, 1, payload["pages"], "every page is scraped by its own req")
+	}
+}
+
+func TestScrapeGoogleShoppingSearchStreamInvalid(t *testing.T) {
+	transport, payloads := pagesApi()
+	c := Init("user", "pass")
+	c.C.HttpClient = &http.Client{Transport: transport}
+
+	pageChan, err := c.ScrapeGoogleShoppingSearchStream(context.Background(), "adidas", &GoogleShoppingSearchOpts{Pages: -1})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.Nil(t, pageChan)
+	assert.Empty(t, *payloads)
+}
+
+func TestScrapeGoogleShoppingSearchStreamCancelled(t *testing.T) {
+	transport, payloads := pagesApi()
+	c := Init("user", "pass")
+	c.C.HttpClient = &http.Client{Transport: transport}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	pageChan, err := c.ScrapeGoogleShoppingSearchStream(ctx, "adidas", &GoogleShoppingSearchOpts{Pages: 10})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	page := <-pageChan
+	assert.Equal(t, 1, page.Page)
+	cancel()
+
+	for range pageChan {
+	}
+	assert.Less(t, len(*payloads), 10, "no page is scraped after the ctx is done")
+}
+
+func TestScrapeGoogleShoppingSearchStreamClientDefaults(t *testing.T) {
+	transport, payloads := pagesApi()
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: transport}), WithDefaultPages(2), WithDefaultDomain(oxylabs.DOMAIN_DE))
+
+	pageChan, err := c.ScrapeGoogleShoppingSearchStream(context.Background(), "adidas")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var pages []int
+	for page := range pageChan {
+		pages = append(pages, page.Page)
+	}
+	assert.Equal(t, []int{1, 2}, pages, "the client default pages are streamed")
+	for _, payload := range *payloads {
+		assert.Equal(t, "de", payload["domain"])
+	}
+}
@@ -0,0 +1,28 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// translate translates the titles and snippets of the parsed results with the
+// translator of the client. A failed translation is reported as a warning.
+func (r *Resp) translate(ctx context.Context, c *internal.Client) {
+	var texts []*string
+	for i := range r.Results {
+		content := &r.Results[i].ContentParsed
+		texts = append(texts, &content.Title, &content.Description)
+		for j := range content.Results.Organic {
+			texts = append(texts, &content.Results.Organic[j].Title)
+		}
+		for j := range content.Results.Paid {
+			texts = append(texts, &content.Results.Paid[j].Title, &content.Results.Paid[j].Desc)
+		}
+	}
+
+	if err := c.Translate(ctx, texts); err != nil {
+		r.Warnings = append(r.Warnings, oxylabs.Warning{Message: err.Error()})
+	}
+}
@@ -2,7 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -17,60 +16,66 @@ type UniversalUrlOpts struct {
 	GeoLocation       string
 	Locale            oxylabs.Locale
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	ContentEncoding   string
 	Context           []func(oxylabs.ContextOption)
+	Session           *oxylabs.Session
 	CallbackURL       string
-	Parse             bool
+	Parse             *bool
 	ParserType        interface{}
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *UniversalUrlOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of UniversalUrlOpts parameters.
 func (opt *UniversalUrlOpts) checkParametersValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.Universal, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if ctx["http_method"] != "post" && ctx["http_method"] != "get" {
-		return fmt.Errorf("invalid http method")
+		errs = append(errs, fmt.Errorf("invalid http method"))
+	}
+
+	if opt.Session != nil && ctx["session_id"] != nil {
+		errs = append(errs, fmt.Errorf("session and session_id context option cannot both be set"))
 	}
 
 	if ctx["content"] != nil && ctx["http_method"] != "post" {
-		return fmt.Errorf("content is useful only if http method is post")
+		errs = append(errs, fmt.Errorf("content is useful only if http method is post"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
-}
-
-// ScrapeUniversalUrl scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
-func (c *EcommerceClient) ScrapeUniversalUrl(
-	url string,
-	opts ...*UniversalUrlOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeUniversalUrlCtx(ctx, url, opts...)
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeUniversalUrlCtx scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeUniversalUrlCtx(
-	ctx context.Context,
+// NewUniversalUrlRequest prepares and validates the request of ScrapeUniversalUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewUniversalUrlRequest(
 	url string,
 	opts ...*UniversalUrlOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &UniversalUrlOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -83,6 +88,11 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.Universal, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
 	internal.SetDefaultHttpMethod(context)
 	internal.SetDefaultUserAgent(&opt.UserAgent)
@@ -96,8 +106,6 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":           oxylabs.Universal,
-		"url":              url,
 		"user_agent_type":  opt.UserAgent,
 		"geo_location":     opt.GeoLocation,
 		"locale":           opt.Locale,
@@ -138,30 +146,45 @@ func (c *EcommerceClient) ScrapeUniversalUrlCtx(
 		"parser_type":  opt.ParserType,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
+
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.Universal,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
+		Session:           opt.Session,
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeUniversalUrl scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
+func (c *EcommerceClient) ScrapeUniversalUrl(
+	url string,
+	opts ...*UniversalUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeUniversalUrlCtx(ctx, url, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeUniversalUrlCtx scrapes all urls via Oxylabs E-Commerce API with universal_ecommerce as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeUniversalUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*UniversalUrlOpts,
+) (*Resp, error) {
+	req, err := NewUniversalUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
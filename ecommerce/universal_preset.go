@@ -0,0 +1,88 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/presets"
+)
+
+// NewUniversalUrlPresetRequest prepares and validates the request of ScrapeUniversalUrl
+// with the parsing instructions preset of the url's domain from the default preset registry.
+func NewUniversalUrlPresetRequest(
+	url string,
+	opts ...*UniversalUrlOpts,
+) (*oxylabs.Request, error) {
+	instructions, ok := presets.Lookup(url)
+	if !ok {
+		return nil, fmt.Errorf("no parsing instructions preset for %s", url)
+	}
+
+	// Prepare options.
+	opt := UniversalUrlOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = *opts[len(opts)-1]
+	}
+	opt.Parse = oxylabs.Bool(true)
+	opt.ParseInstructions = &instructions
+
+	return NewUniversalUrlRequest(url, &opt)
+}
+
+// ScrapeUniversalUrlWithPreset scrapes the url via Oxylabs E-Commerce API with universal_ecommerce
+// as source, parsed with the preset of the url's domain.
+func (c *EcommerceClient) ScrapeUniversalUrlWithPreset(
+	url string,
+	opts ...*UniversalUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeUniversalUrlWithPresetCtx(ctx, url, opts...)
+}
+
+// ScrapeUniversalUrlWithPresetCtx scrapes the url via Oxylabs E-Commerce API with universal_ecommerce
+// as source, parsed with the preset of the url's domain.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeUniversalUrlWithPresetCtx(
+	ctx context.Context,
+	url string,
+	opts ...*UniversalUrlOpts,
+) (*Resp, error) {
+	req, err := NewUniversalUrlPresetRequest(url, internal.WithClientDefaults(c.C, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req)
+}
+
+// ScrapeUniversalUrlWithPreset scrapes the url with async polling runtime via Oxylabs E-Commerce API
+// and universal_ecommerce as source, parsed with the preset of the url's domain.
+func (c *EcommerceClientAsync) ScrapeUniversalUrlWithPreset(
+	url string,
+	opts ...*UniversalUrlOpts,
+) (chan *Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeUniversalUrlWithPresetCtx(ctx, url, opts...)
+}
+
+// ScrapeUniversalUrlWithPresetCtx scrapes the url with async polling runtime via Oxylabs E-Commerce API
+// and universal_ecommerce as source, parsed with the preset of the url's domain.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeUniversalUrlWithPresetCtx(
+	ctx context.Context,
+	url string,
+	opts ...*UniversalUrlOpts,
+) (chan *Resp, error) {
+	req, err := NewUniversalUrlPresetRequest(url, internal.WithClientDefaults(c.C, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req)
+}
@@ -0,0 +1,64 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrapeUniversalUrlWithPresetClientDefaults(t *testing.T) {
+	url := "https://books.toscrape.com/catalogue/a-light-in-the-attic_1000/index.html"
+
+	var payload map[string]interface{}
+	c := Init("user", "pass",
+		WithDefaultGeoLocation("Germany"),
+		WithDefaultUserAgent(oxylabs.UA_MOBILE),
+		WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+			_ = json.NewDecoder(req.Body).Decode(&payload)
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":{"title":"A Light in the Attic"},"page":1}]}`)),
+			}
+		})}),
+	)
+
+	_, err := c.ScrapeUniversalUrlWithPreset(url)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "Germany", payload["geo_location"])
+	assert.Equal(t, "mobile", payload["user_agent_type"])
+	assert.NotNil(t, payload["parsing_instructions"])
+
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{
+		Content: func(map[string]interface{}) interface{} {
+			return map[string]interface{}{"title": "A Light in the Attic"}
+		},
+	})
+	ca := InitAsync("user", "pass",
+		WithHttpClient(sim.HttpClient()),
+		WithDefaultGeoLocation("Germany"),
+		WithDefaultUserAgent(oxylabs.UA_MOBILE),
+		WithDefaultPollInterval(5*time.Millisecond),
+	)
+	respChan, err := ca.ScrapeUniversalUrlWithPresetCtx(context.Background(), url, &UniversalUrlOpts{GeoLocation: "France"})
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp := <-respChan
+	if assert.NotNil(t, resp) && assert.Len(t, resp.Results, 1) {
+		assert.Equal(t, "A Light in the Attic", resp.Results[0].CustomContentParsed["title"])
+	}
+	if jobs := sim.Jobs(); assert.Len(t, jobs, 1) {
+		assert.Equal(t, "France", jobs[0].Payload["geo_location"], "the opts override the client defaults")
+		assert.Equal(t, "mobile", jobs[0].Payload["user_agent_type"])
+	}
+}
@@ -0,0 +1,129 @@
+package ecommerce
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WalmartUrlOpts contains all the query parameters available for walmart.
+type WalmartUrlOpts struct {
+	UserAgent         oxylabs.UserAgent
+	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
+	CallbackUrl       string
+	Parse             *bool
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+}
+
+// String returns a compact summary of the set options for logging.
+func (opt *WalmartUrlOpts) String() string {
+	return internal.Summary(opt)
+}
+
+// checkParameterValidity checks validity of ScrapeWalmartUrl parameters.
+func (opt *WalmartUrlOpts) checkParameterValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
+	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.WalmartUrl, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
+	}
+
+	if opt.ParseInstructions != nil {
+		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
+		}
+	}
+
+	return oxylabs.NewValidationError(errs)
+}
+
+// NewWalmartUrlRequest prepares and validates the request of ScrapeWalmartUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewWalmartUrlRequest(
+	url string,
+	opts ...*WalmartUrlOpts,
+) (*oxylabs.Request, error) {
+	// Check validity of url.
+	err := internal.ValidateUrl(url, "walmart")
+	if err != nil {
+		return nil, err
+	}
+
+	// Prepare options.
+	opt := &WalmartUrlOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	internal.SetDefaultUserAgent(&opt.UserAgent)
+
+	// Check validity of parameters.
+	err = opt.checkParameterValidity()
+	if err != nil {
+		return nil, err
+	}
+
+	//Prepare payload.
+	payload := map[string]interface{}{
+		"user_agent_type": opt.UserAgent,
+		"render":          opt.Render,
+		"callback_url":    opt.CallbackUrl,
+		"parse":           opt.Parse,
+	}
+
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
+
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.WalmartUrl,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
+	}
+
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeWalmartUrl scrapes walmart via Oxylabs E-Commerce API with walmart as source.
+func (c *EcommerceClient) ScrapeWalmartUrl(
+	url string,
+	opts ...*WalmartUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeWalmartUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeWalmartUrlCtx scrapes walmart via Oxylabs E-Commerce API with walmart as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeWalmartUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*WalmartUrlOpts,
+) (*Resp, error) {
+	req, err := NewWalmartUrlRequest(url, internal.WithClientDefaults(c.C, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req)
+}
@@ -0,0 +1,35 @@
+package ecommerce
+
+import (
+	"context"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+)
+
+// ScrapeWalmartUrl scrapes walmart with async polling runtime via Oxylabs E-Commerce API
+// and walmart as source.
+func (c *EcommerceClientAsync) ScrapeWalmartUrl(
+	url string,
+	opts ...*WalmartUrlOpts,
+) (chan *Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeWalmartUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeWalmartUrlCtx scrapes walmart with async polling runtime via Oxylabs E-Commerce API
+// and walmart as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClientAsync) ScrapeWalmartUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*WalmartUrlOpts,
+) (chan *Resp, error) {
+	req, err := NewWalmartUrlRequest(url, internal.WithClientDefaults(c.C, opts))
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req)
+}
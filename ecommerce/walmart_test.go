@@ -0,0 +1,81 @@
+package ecommerce
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewWalmartUrlRequest(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		wantErr bool
+	}{
+		{name: "product", url: "https://www.walmart.com/ip/123"},
+		{name: "search", url: "https://www.walmart.com/search?q=shoes"},
+		{name: "empty", url: "", wantErr: true},
+		{name: "missing scheme", url: "www.walmart.com/ip/123", wantErr: true},
+		{name: "other host", url: "https://www.amazon.com/dp/B0000", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := NewWalmartUrlRequest(tt.url)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, oxylabs.ErrInvalidParameter), "got %v", err)
+				return
+			}
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, oxylabs.WalmartUrl, req.Source)
+			assert.Equal(t, tt.url, req.Url)
+			assert.Equal(t, oxylabs.UA_DESKTOP, req.Params["user_agent_type"])
+		})
+	}
+}
+
+func TestScrapeWalmartUrl(t *testing.T) {
+	url := "https://www.walmart.com/ip/123"
+
+	var payload map[string]interface{}
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":{"title":"Shoe"},"page":1}]}`)),
+		}
+	})}))
+	resp, err := c.ScrapeWalmartUrl(url, &WalmartUrlOpts{Parse: oxylabs.Bool(true)})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Len(t, resp.Results, 1)
+	assert.Equal(t, "walmart", payload["source"])
+	assert.Equal(t, url, payload["url"])
+	assert.Equal(t, true, payload["parse"])
+
+	sim := oxylabstest.NewSimulator(nil)
+	ca := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5*time.Millisecond))
+	respChan, err := ca.ScrapeWalmartUrlCtx(context.Background(), url)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if resp := <-respChan; assert.NotNil(t, resp) {
+		assert.Len(t, resp.Results, 1)
+	}
+	if jobs := sim.Jobs(); assert.Len(t, jobs, 1) {
+		assert.Equal(t, oxylabs.WalmartUrl, jobs[0].Source)
+		assert.Equal(t, url, jobs[0].Payload["url"])
+	}
+}
@@ -2,7 +2,6 @@ package ecommerce
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -12,25 +11,27 @@ import (
 
 // checkParameterValidity checks validity of ScrapeWayfairSearch parameters.
 func (opt *WayfairSearchOpts) checkParametersValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.Limit != 24 && opt.Limit != 48 && opt.Limit != 96 {
-		return fmt.Errorf("invalid limit parameter: %v", opt.Limit)
+		errs = append(errs, fmt.Errorf("invalid limit parameter: %v", opt.Limit))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // WayfairSearchOpts contains all the query parameters available for wayfair_search.
@@ -44,24 +45,17 @@ type WayfairSearchOpts struct {
 	PollInterval      time.Duration
 }
 
-// ScrapeWayfairSearch scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
-func (c *EcommerceClient) ScrapeWayfairSearch(
-	query string,
-	opts ...*WayfairSearchOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeWayfairSearchCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *WayfairSearchOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeWayfairSearchCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeWayfairSearchCtx(
-	ctx context.Context,
+// NewWayfairSearchRequest prepares and validates the request of ScrapeWayfairSearch
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewWayfairSearchRequest(
 	query string,
 	opts ...*WayfairSearchOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &WayfairSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -82,8 +76,6 @@ func (c *EcommerceClient) ScrapeWayfairSearchCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.WayfairSearch,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"limit":           opt.Limit,
@@ -91,33 +83,45 @@ func (c *EcommerceClient) ScrapeWayfairSearchCtx(
 		"callback_url":    opt.CallbackUrl,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
+	// Custom parsing instructions require parsed results.
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Prepare request.
+	return &oxylabs.Request{
+		Source:            oxylabs.WayfairSearch,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
+	}, nil
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeWayfairSearch scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
+func (c *EcommerceClient) ScrapeWayfairSearch(
+	query string,
+	opts ...*WayfairSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeWayfairSearchCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// ScrapeWayfairSearchCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeWayfairSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*WayfairSearchOpts,
+) (*Resp, error) {
+	req, err := NewWayfairSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // WayfairUrlOpts contains all the query parameters available for wayfair.
@@ -128,39 +132,34 @@ type WayfairUrlOpts struct {
 	PollInterval      time.Duration
 }
 
+// String returns a compact summary of the set options for logging.
+func (opt *WayfairUrlOpts) String() string {
+	return internal.Summary(opt)
+}
+
 // checkParameterValidity checks validity of ScrapeWayfairUrl parameters.
 func (opt *WayfairUrlOpts) checkParametersValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
-// ScrapeWayfairUrl scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
-func (c *EcommerceClient) ScrapeWayfairUrl(
+// NewWayfairUrlRequest prepares and validates the request of ScrapeWayfairUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewWayfairUrlRequest(
 	url string,
 	opts ...*WayfairUrlOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeWayfairUrlCtx(ctx, url, opts...)
-}
-
-// ScrapeWayfairUrlCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *EcommerceClient) ScrapeWayfairUrlCtx(
-	ctx context.Context,
-	url string,
-	opts ...*WayfairUrlOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Check validity of url.
 	err := internal.ValidateUrl(url, "wayfair")
 	if err != nil {
@@ -184,36 +183,46 @@ func (c *EcommerceClient) ScrapeWayfairUrlCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.Wayfair,
-		"url":             url,
 		"user_agent_type": opt.UserAgent,
 		"callback_url":    opt.CallbackUrl,
 	}
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
+	// Custom parsing instructions require parsed results.
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Prepare request.
+	return &oxylabs.Request{
+		Source:            oxylabs.Wayfair,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
+	}, nil
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeWayfairUrl scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
+func (c *EcommerceClient) ScrapeWayfairUrl(
+	url string,
+	opts ...*WayfairUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+	return c.ScrapeWayfairUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeWayfairUrlCtx scrapes wayfair via Oxylabs E-Commerce API with wayfair as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *EcommerceClient) ScrapeWayfairUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*WayfairUrlOpts,
+) (*Resp, error) {
+	req, err := NewWayfairUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WithArchiveHook archives the raw response of every successful call with hook.
+func WithArchiveHook(hook oxylabs.ArchiveHook) ClientOption {
+	return func(c *Client) {
+		c.archiveHook = hook
+	}
+}
+
+// archive passes the raw body of the successful resp to the archive hook,
+// leaving the body readable.
+func (c *Client) archive(ctx context.Context, resp *http.Response, record oxylabs.ArchiveRecord) error {
+	if c.archiveHook == nil || resp.StatusCode != http.StatusOK {
+		return nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return fmt.Errorf("error reading resp body: %v", err)
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	record.Timestamp = time.Now().UTC()
+	record.StatusCode = resp.StatusCode
+	record.Metadata = oxylabs.RequestMetadata(ctx)
+	if err := c.archiveHook.Archive(ctx, record, body); err != nil {
+		return fmt.Errorf("error archiving resp: %v", err)
+	}
+
+	return nil
+}
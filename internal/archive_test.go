@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type archiveFunc func(ctx context.Context, record oxylabs.ArchiveRecord, body []byte) error
+
+func (f archiveFunc) Archive(ctx context.Context, record oxylabs.ArchiveRecord, body []byte) error {
+	return f(ctx, record, body)
+}
+
+func TestArchiveHook(t *testing.T) {
+	var archived []oxylabs.ArchiveRecord
+	var archivedBody string
+	c := NewClient(SyncBaseUrl, "user", "pass", WithArchiveHook(archiveFunc(func(_ context.Context, record oxylabs.ArchiveRecord, body []byte) error {
+		archived = append(archived, record)
+		archivedBody = string(body)
+		return nil
+	})))
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"results":[]}`))}
+	})}
+
+	ctx := oxylabs.WithRequestMetadata(context.Background(), map[string]string{"tenant": "acme"})
+	resp, err := c.Req(ctx, []byte(`{"source":"google_search"}`), "POST")
+	assert.NoError(t, err)
+	body, _ := io.ReadAll(resp.Body)
+
+	assert.Equal(t, `{"results":[]}`, string(body), "body is left readable")
+	assert.Equal(t, `{"results":[]}`, archivedBody)
+	assert.Len(t, archived, 1)
+	assert.Equal(t, oxylabs.GoogleSearch, archived[0].Source)
+	assert.Equal(t, PayloadHash([]byte(`{"source":"google_search"}`)), archived[0].PayloadHash)
+	assert.Equal(t, map[string]string{"tenant": "acme"}, archived[0].Metadata)
+
+	// Errors of the hook fail the call.
+	c.archiveHook = archiveFunc(func(context.Context, oxylabs.ArchiveRecord, []byte) error {
+		return io.ErrShortWrite
+	})
+	_, err = c.Req(ctx, []byte(`{"source":"google_search"}`), "POST")
+	assert.ErrorContains(t, err, "error archiving resp")
+}
+
+func TestArchiveHookNotifiedJob(t *testing.T) {
+	var archived []oxylabs.ArchiveRecord
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithArchiveHook(archiveFunc(func(_ context.Context, record oxylabs.ArchiveRecord, _ []byte) error {
+		archived = append(archived, record)
+		return nil
+	})))
+	c.HttpClient = &http.Client{Transport: fakeJobs(0)}
+
+	ctx := oxylabs.WithRequestMetadata(context.Background(), map[string]string{"tenant": "acme"})
+	payload := []byte(`{"source":"google_search"}`)
+	jobID, err := c.GetJobID(ctx, payload)
+	assert.NoError(t, err)
+
+	jobChan := make(chan Job, 1)
+	jobChan <- Job{ID: jobID, Status: "done", Source: oxylabs.GoogleSearch}
+	resp, err := c.AwaitJob(ctx, jobID, jobChan, time.Hour, time.Millisecond)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp.Body.Close()
+
+	if !assert.Len(t, archived, 1) {
+		return
+	}
+	assert.Equal(t, []oxylabs.ArchiveRecord{{
+		JobID:       jobID,
+		Source:      oxylabs.GoogleSearch,
+		PayloadHash: PayloadHash(payload),
+		Timestamp:   archived[0].Timestamp,
+		StatusCode:  http.StatusOK,
+		Metadata:    map[string]string{"tenant": "acme"},
+	}}, archived)
+}
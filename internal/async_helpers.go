@@ -8,24 +8,55 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 // GetJobID Helper function to make a POST req and retrieve the Job ID.
 func (c *Client) GetJobID(
+	ctx context.Context,
 	jsonPayload []byte,
 ) (string, error) {
-	req, _ := NewRequest(
+	end, err := c.begin()
+	if err != nil {
+		return "", err
+	}
+	defer end()
+
+	// Wait for a free slot of the client.
+	release, err := c.Acquire(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer release()
+
+	reqUrl, err := callUrl(ctx, c.jobsUrl())
+	if err != nil {
+		return "", err
+	}
+	req, _ := NewRequestWithContext(
+		ctx,
 		"POST",
-		c.BaseUrl,
+		reqUrl,
 		bytes.NewBuffer(jsonPayload),
 	)
 	req.Header.Add("Content-type", "application/json")
-	req.SetBasicAuth(
-		c.ApiCredentials.Username,
-		c.ApiCredentials.Password,
-	)
-	resp, err := c.HttpClient.Do(req)
+	c.setPayloadHash(req, jsonPayload)
+	source := payloadSource(jsonPayload)
+	if payloadCallbackUrl(jsonPayload) == "" {
+		if err = c.shedLoad(ctx, source, true); err != nil {
+			return "", err
+		}
+	}
+	if err = c.pace(ctx, jsonPayload); err != nil {
+		return "", err
+	}
+	c.audit(ctx, jsonPayload)
+	c.RecordRequest(source)
+	resp, err := c.DoAuthorized(req)
 	if err != nil {
+		c.RecordFault(source)
 		return "", fmt.Errorf("error performing req: %v", err)
 	}
 
@@ -36,7 +67,8 @@ func (c *Client) GetJobID(
 	defer resp.Body.Close()
 
 	if resp.StatusCode >= 300 {
-		return "", fmt.Errorf("error with status code %s: %s", resp.Status, respBody)
+		c.RecordFault(source)
+		return "", &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: respBody}
 	}
 
 	// Unmarshal into job.
@@ -44,6 +76,7 @@ func (c *Client) GetJobID(
 	if err = json.Unmarshal(respBody, &job); err != nil {
 		return "", fmt.Errorf("error unmarshalling job resp body: %v", err)
 	}
+	c.rememberJob(job.ID, PayloadHash(jsonPayload))
 
 	return job.ID, nil
 }
@@ -54,17 +87,43 @@ func (c *Client) GetHttpResp(
 	httpChan chan *http.Response,
 	errChan chan error,
 ) {
-	req, _ := NewRequest(
+	c.getHttpResp(context.Background(), oxylabs.ArchiveRecord{JobID: jobID}, httpChan, errChan)
+}
+
+// getHttpResp gets the http response of the job of the archive record,
+// archiving it with the request metadata of ctx.
+func (c *Client) getHttpResp(
+	ctx context.Context,
+	record oxylabs.ArchiveRecord,
+	httpChan chan *http.Response,
+	errChan chan error,
+) {
+	reqUrl, err := callUrl(ctx, fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s/results", record.JobID))
+	if err != nil {
+		errChan <- err
+		close(httpChan)
+		return
+	}
+	req, _ := NewRequestWithContext(
+		ctx,
 		"GET",
-		fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s/results", jobID),
+		reqUrl,
 		nil,
 	)
 	req.Header.Add("Content-type", "application/json")
-	req.SetBasicAuth(
-		c.ApiCredentials.Username,
-		c.ApiCredentials.Password,
-	)
-	resp, err := c.HttpClient.Do(req)
+	resp, err := c.doRetrying(req, record.Source)
+	if err != nil && ctx.Err() != nil {
+		err = fmt.Errorf("%w: %v", oxylabs.ErrTimeout, err)
+	}
+	if err == nil && resp.StatusCode != http.StatusOK {
+		err = jobStatusError(resp, record.JobID)
+	}
+	if err == nil {
+		err = c.limitBody(resp)
+	}
+	if err == nil {
+		err = c.archive(ctx, resp, record)
+	}
 	if err != nil {
 		errChan <- err
 		close(httpChan)
@@ -88,38 +147,52 @@ func (c *Client) PollJobStatus(
 	httpRespChan chan *http.Response,
 	errChan chan error,
 ) {
+	defer c.track()()
+
+	// Drop the job once polling ends without completing it, e.g. on timeout.
+	defer c.forgetJob(jobID)
+
 	// Add default timeout if ctx has no deadline.
 	if _, ok := ctx.Deadline(); !ok {
-		ctxWithTimeout, cancel := context.WithTimeout(ctx, DefaultTimeout)
+		ctxWithTimeout, cancel := context.WithTimeout(ctx, c.Timeout())
 		defer cancel()
 		ctx = ctxWithTimeout
 	}
 
 	// Set wait time between requests.
-	sleepTime := DefaultPollInterval
-	if pollInterval != 0 {
-		sleepTime = pollInterval
-	}
+	sleepTime := c.PollInterval(pollInterval)
 
 	for {
 		// Perform a req to query job status.
-		req, _ := NewRequest(
+		reqUrl, err := callUrl(ctx, fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s", jobID))
+		if err != nil {
+			errChan <- err
+			close(httpRespChan)
+			return
+		}
+		req, _ := NewRequestWithContext(
+			ctx,
 			"GET",
-			fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s", jobID),
+			reqUrl,
 			nil,
 		)
 		req.Header.Add("Content-type", "application/json")
-		req.SetBasicAuth(
-			c.ApiCredentials.Username,
-			c.ApiCredentials.Password,
-		)
-		resp, err := c.HttpClient.Do(req)
+		resp, err := c.doRetrying(req, "")
+		if err != nil && ctx.Err() != nil {
+			err = fmt.Errorf("%w: %v", oxylabs.ErrTimeout, err)
+		}
 		if err != nil {
 			errChan <- err
 			close(httpRespChan)
 			return
 		}
 
+		if resp.StatusCode != http.StatusOK {
+			errChan <- jobStatusError(resp, jobID)
+			close(httpRespChan)
+			return
+		}
+
 		// Read the resp body into a buffer.
 		respBody, err := io.ReadAll(resp.Body)
 		resp.Body.Close()
@@ -141,29 +214,132 @@ func (c *Client) PollJobStatus(
 
 		// Check job status.
 		if job.Status == "done" {
-			c.GetHttpResp(job.ID, httpRespChan, errChan)
+			c.recordJobLatency(job.ID, job.Source)
+			hash := c.forgetJob(job.ID)
+			c.RecordSuccess(job.Source)
+			record := oxylabs.ArchiveRecord{JobID: job.ID, Source: job.Source, PayloadHash: hash}
+			c.getHttpResp(ctx, record, httpRespChan, errChan)
 			return
 		} else if job.Status == "faulted" {
-			err = fmt.Errorf("there was an error processing your query")
-			errChan <- err
+			errChan <- c.faultedJobError(job)
 			close(httpRespChan)
 			return
 		}
 
 		select {
 		case <-ctx.Done():
-			err = fmt.Errorf("timeout exceeded")
+			err = oxylabs.ErrTimeout
 			errChan <- err
 			close(httpRespChan)
 			return
-		default:
-			time.Sleep(sleepTime)
+		case <-c.aborted():
+			errChan <- oxylabs.ErrClientClosed
+			close(httpRespChan)
+			return
+		case <-time.After(sleepTime):
 		}
 	}
 }
 
+// jobStatusError returns the error of a non-successful resp on the status or
+// results of the job, and closes its body.
+func jobStatusError(resp *http.Response, jobID string) error {
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	return &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body, JobID: jobID}
+}
+
 // Job struct to get job id and status for the async polling.
 type Job struct {
-	ID     string `json:"id"`
-	Status string `json:"status"`
+	ID       string         `json:"id"`
+	Status   string         `json:"status"`
+	Source   oxylabs.Source `json:"source"`
+	Statuses []JobStatus    `json:"statuses"`
+}
+
+// AwaitJob waits for the completed job to be notified on jobChan and retrieves
+// the job's http resp. If no job is notified within fallbackAfter,
+// it falls back to polling the job status.
+func (c *Client) AwaitJob(
+	ctx context.Context,
+	jobID string,
+	jobChan <-chan Job,
+	fallbackAfter time.Duration,
+	pollInterval time.Duration,
+) (*http.Response, error) {
+	defer c.track()()
+
+	httpRespChan := make(chan *http.Response)
+	errChan := make(chan error)
+
+	timer := time.NewTimer(fallbackAfter)
+	defer timer.Stop()
+
+	select {
+	case job := <-jobChan:
+		if job.Status == "faulted" {
+			job.ID = jobID
+			return nil, c.faultedJobError(&job)
+		}
+		c.recordJobLatency(jobID, job.Source)
+		hash := c.forgetJob(jobID)
+		c.RecordSuccess(job.Source)
+		record := oxylabs.ArchiveRecord{JobID: jobID, Source: job.Source, PayloadHash: hash}
+		go c.getHttpResp(ctx, record, httpRespChan, errChan)
+	case <-timer.C:
+		go c.PollJobStatus(ctx, jobID, pollInterval, httpRespChan, errChan)
+	case <-ctx.Done():
+		c.forgetJob(jobID)
+		return nil, oxylabs.ErrTimeout
+	case <-c.aborted():
+		c.forgetJob(jobID)
+		return nil, oxylabs.ErrClientClosed
+	}
+
+	// Handle error.
+	if err := <-errChan; err != nil {
+		return nil, err
+	}
+
+	return <-httpRespChan, nil
+}
+
+// AwaitCallback waits for the callback of the completed job to arrive at the
+// receiver and retrieves the job's http resp, falling back to polling the job
+// status after fallbackAfter (callback.DefaultFallback if 0).
+// The job is forgotten by the receiver once AwaitCallback returns.
+func (c *Client) AwaitCallback(
+	ctx context.Context,
+	jobID string,
+	receiver *callback.Receiver,
+	fallbackAfter time.Duration,
+	pollInterval time.Duration,
+) (*http.Response, error) {
+	if fallbackAfter == 0 {
+		fallbackAfter = callback.DefaultFallback
+	}
+	defer receiver.Forget(jobID)
+
+	// Forward the job once its callback arrives.
+	jobChan := make(chan Job, 1)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case job := <-receiver.Await(jobID):
+			jobChan <- Job{
+				ID:     job.ID,
+				Status: job.Status,
+				Source: oxylabs.Source(job.Source),
+			}
+		case <-done:
+		}
+	}()
+
+	return c.AwaitJob(ctx, jobID, jobChan, fallbackAfter, pollInterval)
 }
@@ -0,0 +1,91 @@
+package internal
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// auditor emits an audit record for every submitted payload.
+type auditor struct {
+	mu         sync.Mutex
+	w          io.Writer
+	fn         func(oxylabs.AuditRecord)
+	hashHeader bool
+}
+
+// WithAuditWriter writes an audit record of every submitted payload
+// to w as a JSON line.
+func WithAuditWriter(w io.Writer) ClientOption {
+	return func(c *Client) {
+		c.auditor.w = w
+	}
+}
+
+// WithAuditFunc calls fn with an audit record of every submitted payload.
+func WithAuditFunc(fn func(oxylabs.AuditRecord)) ClientOption {
+	return func(c *Client) {
+		c.auditor.fn = fn
+	}
+}
+
+// PayloadHash returns the hex encoded SHA-256 hash of the json payload.
+func PayloadHash(jsonPayload []byte) string {
+	sum := sha256.Sum256(jsonPayload)
+	return hex.EncodeToString(sum[:])
+}
+
+// audit emits the audit record of the json payload about to be submitted,
+// with the request metadata of ctx.
+// Credentials are sent as basic auth and thus never part of the record.
+func (c *Client) audit(ctx context.Context, jsonPayload []byte) {
+	if c.auditor.w == nil && c.auditor.fn == nil {
+		return
+	}
+
+	record := oxylabs.AuditRecord{
+		Timestamp: time.Now().UTC(),
+		Hash:      PayloadHash(jsonPayload),
+		Source:    payloadSource(jsonPayload),
+		Payload:   append(json.RawMessage{}, jsonPayload...),
+		Metadata:  oxylabs.RequestMetadata(ctx),
+	}
+
+	c.auditor.mu.Lock()
+	defer c.auditor.mu.Unlock()
+
+	if c.auditor.w != nil {
+		if line, err := json.Marshal(record); err == nil {
+			_, _ = c.auditor.w.Write(append(line, '\n'))
+		}
+	}
+	if c.auditor.fn != nil {
+		c.auditor.fn(record)
+	}
+}
+
+// PayloadHashHeader is the header of the payload hash of a req.
+const PayloadHashHeader = "x-oxylabs-sdk-payload-hash"
+
+// WithPayloadHashHeader attaches the payload hash, as in the audit records,
+// to every submitted req in the PayloadHashHeader header, so that the reqs
+// can be correlated with the submissions of the application.
+func WithPayloadHashHeader() ClientOption {
+	return func(c *Client) {
+		c.auditor.hashHeader = true
+	}
+}
+
+// setPayloadHash sets the payload hash header on the req if enabled.
+func (c *Client) setPayloadHash(req *http.Request, jsonPayload []byte) {
+	if c.auditor.hashHeader {
+		req.Header.Set(PayloadHashHeader, PayloadHash(jsonPayload))
+	}
+}
@@ -0,0 +1,113 @@
+package internal
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudit(t *testing.T) {
+	var buf bytes.Buffer
+	var mu sync.Mutex
+	var records []oxylabs.AuditRecord
+	var hashes []string
+
+	transport := roundTripFunc(func(req *http.Request) *http.Response {
+		mu.Lock()
+		hashes = append(hashes, req.Header.Get(PayloadHashHeader))
+		mu.Unlock()
+
+		body := `{"id":"job1"}`
+		if strings.HasSuffix(req.URL.Path, "/batch") {
+			body = `{"queries":[{"id":"job1"},{"id":"job2"}]}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})
+	c := NewClient(AsyncBaseUrl, "user", "secret",
+		WithHttpClient(&http.Client{Transport: transport}),
+		WithAuditWriter(&buf),
+		WithAuditFunc(func(record oxylabs.AuditRecord) {
+			mu.Lock()
+			defer mu.Unlock()
+			records = append(records, record)
+		}),
+		WithPayloadHashHeader(),
+	)
+
+	single := []byte(`{"source":"google_search","query":"shoes"}`)
+	batch := []byte(`{"source":"amazon_product","query":["B01","B02"]}`)
+	ctx := oxylabs.WithRequestMetadata(context.Background(), map[string]string{"team": "pricing"})
+	_, err := c.GetJobID(ctx, single)
+	assert.NoError(t, err)
+	_, err = c.GetJobIDs(context.Background(), batch)
+	assert.NoError(t, err)
+
+	if !assert.Len(t, records, 2, "a record per submission") {
+		return
+	}
+	assert.Equal(t, oxylabs.GoogleSearch, records[0].Source)
+	assert.Equal(t, PayloadHash(single), records[0].Hash)
+	assert.JSONEq(t, string(single), string(records[0].Payload))
+	assert.Equal(t, map[string]string{"team": "pricing"}, records[0].Metadata)
+	assert.False(t, records[0].Timestamp.IsZero())
+
+	assert.Equal(t, oxylabs.AmazonProduct, records[1].Source)
+	assert.Equal(t, PayloadHash(batch), records[1].Hash)
+	assert.Nil(t, records[1].Metadata)
+
+	assert.Equal(t, []string{records[0].Hash, records[1].Hash}, hashes, "the reqs carry the hash of their record")
+
+	// The writer gets the same records as JSON lines, without the credentials.
+	assert.NotContains(t, buf.String(), "secret")
+	var lines []oxylabs.AuditRecord
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var record oxylabs.AuditRecord
+		assert.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+		lines = append(lines, record)
+	}
+	if assert.Len(t, lines, 2) {
+		assert.Equal(t, records[0].Hash, lines[0].Hash)
+		assert.Equal(t, records[1].Source, lines[1].Source)
+		assert.True(t, records[0].Timestamp.Equal(lines[0].Timestamp))
+	}
+}
+
+func TestAuditDisabled(t *testing.T) {
+	var headers []string
+	transport := roundTripFunc(func(req *http.Request) *http.Response {
+		headers = append(headers, req.Header.Get(PayloadHashHeader))
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"id":"job1"}`))}
+	})
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithHttpClient(&http.Client{Transport: transport}))
+
+	_, err := c.GetJobID(context.Background(), []byte(`{"source":"google_search","query":"shoes"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, headers, "the hash header is opt-in")
+}
+
+func TestPayloadHashHeader(t *testing.T) {
+	var headers []string
+	transport := roundTripFunc(func(req *http.Request) *http.Response {
+		headers = append(headers, req.Header.Get(PayloadHashHeader))
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"results":[]}`))}
+	})
+	c := NewClient(SyncBaseUrl, "user", "pass", WithHttpClient(&http.Client{Transport: transport}), WithPayloadHashHeader())
+
+	payload := []byte(`{"source":"google_search","query":"shoes"}`)
+	resp, err := c.Req(context.Background(), payload, http.MethodPost)
+	if !assert.NoError(t, err) {
+		return
+	}
+	resp.Body.Close()
+	assert.Equal(t, []string{PayloadHash(payload)}, headers, "the header does not need an audit writer")
+}
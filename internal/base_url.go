@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// callUrl returns the url of a req of the call of ctx, directed at the
+// base url carried by ctx, if any. It returns an error if the base url is not
+// an absolute url, instead of sending the req to the API.
+func callUrl(ctx context.Context, rawUrl string) (string, error) {
+	baseUrl := oxylabs.BaseUrl(ctx)
+	if baseUrl == "" {
+		return rawUrl, nil
+	}
+
+	base, err := url.Parse(baseUrl)
+	if err != nil {
+		return "", oxylabs.NewValidationError([]error{fmt.Errorf("invalid base url %q: %v", baseUrl, err)})
+	}
+	if base.Scheme == "" || base.Host == "" {
+		return "", oxylabs.NewValidationError([]error{fmt.Errorf("invalid base url %q: scheme and host must be set", baseUrl)})
+	}
+	reqUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return "", fmt.Errorf("error parsing req url: %v", err)
+	}
+	reqUrl.Scheme = base.Scheme
+	reqUrl.Host = base.Host
+	reqUrl.Path = strings.TrimSuffix(base.Path, "/") + reqUrl.Path
+
+	return reqUrl.String(), nil
+}
+
+// WithRequestBaseUrl returns a copy of ctx directing the reqs of the call at the
+// base url of req, if set.
+func WithRequestBaseUrl(ctx context.Context, req *oxylabs.Request) context.Context {
+	if req == nil || req.BaseUrl == "" {
+		return ctx
+	}
+
+	return oxylabs.WithBaseUrl(ctx, req.BaseUrl)
+}
@@ -0,0 +1,101 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCallUrl(t *testing.T) {
+	tests := []struct {
+		name    string
+		baseUrl string
+		rawUrl  string
+		want    string
+		wantErr string
+	}{
+		{name: "no override", rawUrl: SyncBaseUrl, want: SyncBaseUrl},
+		{name: "override", baseUrl: "http://localhost:8080/mirror/", rawUrl: SyncBaseUrl, want: "http://localhost:8080/mirror/v1/queries"},
+		{
+			name:    "override with query",
+			baseUrl: "http://localhost:8080/mirror/",
+			rawUrl:  AsyncBaseUrl + "/job1/results?page=2",
+			want:    "http://localhost:8080/mirror/v1/queries/job1/results?page=2",
+		},
+		{name: "malformed", baseUrl: "http://local host:8080", rawUrl: SyncBaseUrl, wantErr: "invalid base url"},
+		{name: "missing scheme", baseUrl: "localhost:8080", rawUrl: SyncBaseUrl, wantErr: "scheme and host must be set"},
+		{name: "missing host", baseUrl: "/mirror", rawUrl: SyncBaseUrl, wantErr: "scheme and host must be set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.baseUrl != "" {
+				ctx = oxylabs.WithBaseUrl(ctx, tt.baseUrl)
+			}
+
+			got, err := callUrl(ctx, tt.rawUrl)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestBaseUrlOverrideInvalid(t *testing.T) {
+	requested := false
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		requested = true
+		return nil
+	})}
+
+	ctx := WithRequestBaseUrl(context.Background(), &oxylabs.Request{BaseUrl: "recorder:8080"})
+	_, err := c.GetJobID(ctx, []byte(`{"source":"google_search"}`))
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+
+	httpRespChan := make(chan *http.Response)
+	errChan := make(chan error)
+	go c.PollJobStatus(ctx, "job1", 1, httpRespChan, errChan)
+	assert.ErrorIs(t, <-errChan, oxylabs.ErrInvalidParameter)
+	assert.False(t, requested)
+}
+
+func TestBaseUrlOverride(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		hosts []string
+	)
+	jobs := fakeJobs(0)
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		mu.Lock()
+		hosts = append(hosts, req.URL.Host)
+		mu.Unlock()
+		resp, _ := jobs.RoundTrip(req)
+		return resp
+	})}
+
+	req := &oxylabs.Request{Source: oxylabs.GoogleSearch, BaseUrl: "http://recorder:8080", PollInterval: 1}
+	ctx := WithRequestBaseUrl(context.Background(), req)
+	jobID, err := c.GetJobID(ctx, []byte(`{"source":"google_search"}`))
+	assert.NoError(t, err)
+	_, _, err = c.AwaitJobResubmitting(ctx, req, jobID, nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, []string{"recorder:8080", "recorder:8080", "recorder:8080"}, hosts, "submission, status and results")
+
+	// Calls without override are left untouched.
+	hosts = nil
+	_, err = c.GetJobID(context.Background(), []byte(`{"source":"google_search"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"data.oxylabs.io"}, hosts)
+}
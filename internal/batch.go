@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// JobHttpResp is the http resp or error of a single polled job.
+type JobHttpResp struct {
+	JobID    string
+	HttpResp *http.Response
+	Err      error
+}
+
+// GetJobIDs Helper function to make a POST batch req and retrieve the Job IDs.
+func (c *Client) GetJobIDs(
+	ctx context.Context,
+	jsonPayload []byte,
+) ([]string, error) {
+	end, err := c.begin()
+	if err != nil {
+		return nil, err
+	}
+	defer end()
+
+	// Wait for a free slot of the client.
+	release, err := c.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	reqUrl, err := callUrl(ctx, fmt.Sprintf("%s/batch", c.BaseUrl))
+	if err != nil {
+		return nil, err
+	}
+	req, _ := NewRequestWithContext(
+		ctx,
+		"POST",
+		reqUrl,
+		bytes.NewBuffer(jsonPayload),
+	)
+	req.Header.Add("Content-type", "application/json")
+	c.setPayloadHash(req, jsonPayload)
+	source := payloadSource(jsonPayload)
+	if payloadCallbackUrl(jsonPayload) == "" {
+		if err = c.shedLoad(ctx, source, true); err != nil {
+			return nil, err
+		}
+	}
+	if err = c.pace(ctx, jsonPayload); err != nil {
+		return nil, err
+	}
+	c.audit(ctx, jsonPayload)
+
+	// Count a request per job of the batch, and a fault per job if the batch
+	// is not submitted, as for single jobs.
+	jobs := payloadJobs(jsonPayload)
+	c.recordRequests(source, jobs)
+	resp, err := c.DoAuthorized(req)
+	if err != nil {
+		c.recordFaults(source, jobs)
+		return nil, fmt.Errorf("error performing req: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	if resp.StatusCode >= 300 {
+		c.recordFaults(source, jobs)
+		return nil, &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: respBody}
+	}
+
+	// Unmarshal into batch.
+	batch := &struct {
+		Queries []Job `json:"queries"`
+	}{}
+	if err = json.Unmarshal(respBody, &batch); err != nil {
+		return nil, fmt.Errorf("error unmarshalling batch resp body: %v", err)
+	}
+
+	jobIDs := make([]string, 0, len(batch.Queries))
+	hash := PayloadHash(jsonPayload)
+	for _, job := range batch.Queries {
+		c.rememberJob(job.ID, hash)
+		jobIDs = append(jobIDs, job.ID)
+	}
+
+	return jobIDs, nil
+}
+
+// PollJobs polls the status of every job concurrently and sends each job's
+// http resp or error to jobRespChan in order of completion.
+// jobRespChan is closed once all jobs have completed.
+func (c *Client) PollJobs(
+	ctx context.Context,
+	jobIDs []string,
+	pollInterval time.Duration,
+	jobRespChan chan<- JobHttpResp,
+) {
+	var wg sync.WaitGroup
+	for _, jobID := range jobIDs {
+		wg.Add(1)
+		go func(jobID string) {
+			defer wg.Done()
+
+			httpRespChan := make(chan *http.Response)
+			errChan := make(chan error)
+			go c.PollJobStatus(ctx, jobID, pollInterval, httpRespChan, errChan)
+
+			// Handle error.
+			if err := <-errChan; err != nil {
+				jobRespChan <- JobHttpResp{JobID: jobID, Err: err}
+				return
+			}
+
+			jobRespChan <- JobHttpResp{JobID: jobID, HttpResp: <-httpRespChan}
+		}(jobID)
+	}
+
+	wg.Wait()
+	close(jobRespChan)
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/net/html/charset"
+)
+
+// WithRawCharset disables the UTF-8 normalization of html content.
+func WithRawCharset() ClientOption {
+	return func(c *Client) {
+		c.rawCharset = true
+	}
+}
+
+// NormalizeCharset returns whether html content should be normalized to UTF-8.
+func (c *Client) NormalizeCharset() bool {
+	return !c.rawCharset
+}
+
+// ToUTF8 converts html content to UTF-8 according to the charset it declares,
+// e.g. GBK, Shift-JIS or Windows-1251, and returns the detected charset name.
+// The original bytes of non UTF-8 sites arrive as runes up to U+00FF,
+// content with any rune above is already decoded and returned as is.
+func ToUTF8(content string) (string, string) {
+	raw := make([]byte, 0, len(content))
+	for _, r := range content {
+		if r > 0xff {
+			return content, "utf-8"
+		}
+		raw = append(raw, byte(r))
+	}
+
+	enc, name, _ := charset.DetermineEncoding(raw, "text/html")
+	switch name {
+	case "utf-8":
+		// Content declared as UTF-8 but decoded byte by byte.
+		if utf8.Valid(raw) {
+			return string(raw), name
+		}
+		return content, name
+	case "windows-1252":
+		// Nothing declared, or a Latin charset already matching the runes.
+		return content, name
+	}
+
+	decoded, err := enc.NewDecoder().Bytes(raw)
+	if err != nil {
+		return content, name
+	}
+
+	return string(decoded), name
+}
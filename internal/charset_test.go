@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// latin1 returns the bytes as runes, the way non UTF-8 content arrives.
+func latin1(b []byte) string {
+	runes := make([]rune, len(b))
+	for i, c := range b {
+		runes[i] = rune(c)
+	}
+	return string(runes)
+}
+
+func TestToUTF8(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		want        string
+		wantCharset string
+	}{
+		{
+			name:        "Windows-1251",
+			content:     latin1(append([]byte(`<meta charset="windows-1251"><p>`), 0xcf, 0xf0, 0xe8, 0xe2, 0xe5, 0xf2)),
+			want:        `<meta charset="windows-1251"><p>Привет`,
+			wantCharset: "windows-1251",
+		},
+		{
+			name:        "UTF-8 decoded byte by byte",
+			content:     latin1([]byte(`<meta charset="utf-8"><p>Привет`)),
+			want:        `<meta charset="utf-8"><p>Привет`,
+			wantCharset: "utf-8",
+		},
+		{
+			name:        "Already decoded",
+			content:     `<p>Привет`,
+			want:        `<p>Привет`,
+			wantCharset: "utf-8",
+		},
+		{
+			name:        "Undeclared",
+			content:     `<p>café`,
+			want:        `<p>café`,
+			wantCharset: "windows-1252",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, charset := ToUTF8(tt.content)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantCharset, charset)
+		})
+	}
+}
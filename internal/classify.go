@@ -0,0 +1,26 @@
+package internal
+
+import "github.com/revvim/oxylabs-sdk-go/oxylabs"
+
+// WithClassifier classifies the content of resps with the classifier,
+// instead of oxylabs.DefaultClassifier.
+func WithClassifier(classifier oxylabs.Classifier) ClientOption {
+	return func(c *Client) {
+		c.classifier = &classifier
+	}
+}
+
+// Classify returns the classification of the raw or parsed content returned
+// with the status code, per the classifier of the client.
+func (c *Client) Classify(statusCode int, content []byte, parsed bool) oxylabs.Classification {
+	classifier := oxylabs.DefaultClassifier()
+	if c.classifier != nil {
+		classifier = *c.classifier
+	}
+
+	if parsed {
+		return classifier.ClassifyParsed(statusCode, content)
+	}
+
+	return classifier.Classify(statusCode, content)
+}
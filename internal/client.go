@@ -1,6 +1,10 @@
 package internal
 
-import "net/http"
+import (
+	"net/http"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
 
 type ApiCredentials struct {
 	Username string
@@ -11,4 +15,30 @@ type Client struct {
 	BaseUrl        string
 	ApiCredentials *ApiCredentials
 	HttpClient     *http.Client
+
+	stats        stats
+	auditor      auditor
+	submitted    submitted
+	lifecycle    lifecycle
+	credentials  credentials
+	defaults     clientDefaults
+	pagesGuard   oxylabs.PagesGuard
+	loadShedding loadShedding
+	concurrency  *concurrencyGuard
+	rateLimit    *rateLimit
+	translation  translation
+
+	schemaVersions schemaVersions
+
+	resubmitPolicy oxylabs.ResubmitPolicy
+	retryPolicy    oxylabs.RetryPolicy
+	jobStore       oxylabs.JobStore
+	archiveHook    oxylabs.ArchiveHook
+	classifier     *oxylabs.Classifier
+
+	rawCharset       bool
+	strictLanguage   bool
+	fireAndForget    bool
+	maxResponseBytes int64
+	spillDir         string
 }
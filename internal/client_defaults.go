@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// clientDefaults are the defaults of the client overriding the package defaults.
+type clientDefaults struct {
+	domain       oxylabs.Domain
+	userAgent    oxylabs.UserAgent
+	pages        int
+	geoLocation  string
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// WithDefaultDomain sets the domain of the reqs not setting one, instead of DefaultDomain.
+func WithDefaultDomain(domain oxylabs.Domain) ClientOption {
+	return func(c *Client) {
+		c.defaults.domain = domain
+	}
+}
+
+// WithDefaultUserAgent sets the user agent of the reqs not setting one, instead of DefaultUserAgent.
+func WithDefaultUserAgent(userAgent oxylabs.UserAgent) ClientOption {
+	return func(c *Client) {
+		c.defaults.userAgent = userAgent
+	}
+}
+
+// WithDefaultPages sets the pages of the reqs not setting them, instead of DefaultPages.
+func WithDefaultPages(pages int) ClientOption {
+	return func(c *Client) {
+		c.defaults.pages = pages
+	}
+}
+
+// WithDefaultGeoLocation sets the geo location of the reqs not setting one.
+func WithDefaultGeoLocation(geoLocation string) ClientOption {
+	return func(c *Client) {
+		c.defaults.geoLocation = geoLocation
+	}
+}
+
+// WithDefaultTimeout sets the timeout of the scrapes made without a ctx,
+// and of the polling of async jobs whose ctx has no deadline, instead of DefaultTimeout.
+func WithDefaultTimeout(timeout time.Duration) ClientOption {
+	return func(c *Client) {
+		c.defaults.timeout = timeout
+	}
+}
+
+// WithDefaultPollInterval sets the poll interval of the async reqs not setting one,
+// instead of DefaultPollInterval.
+func WithDefaultPollInterval(pollInterval time.Duration) ClientOption {
+	return func(c *Client) {
+		c.defaults.pollInterval = pollInterval
+	}
+}
+
+// WithClientDefaults returns a copy of the last non-nil opts with the unset
+// Domain, UserAgent, Pages, GeoLocation and PollInterval fields set to the client defaults,
+// so that the package defaults are applied only to the fields the client has
+// no default for. The opts of the caller are left untouched.
+func WithClientDefaults[T any](c *Client, opts []*T) *T {
+	opt := new(T)
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		*opt = *opts[len(opts)-1]
+	}
+
+	v := reflect.ValueOf(opt).Elem()
+	if v.Kind() != reflect.Struct {
+		return opt
+	}
+	setDefault(v, "Domain", c.defaults.domain)
+	setDefault(v, "UserAgent", c.defaults.userAgent)
+	setDefault(v, "Pages", c.defaults.pages)
+	setDefault(v, "GeoLocation", c.defaults.geoLocation)
+	setDefault(v, "PollInterval", c.defaults.pollInterval)
+
+	return opt
+}
+
+// setDefault sets the named field of v to value if the field is unset.
+func setDefault(v reflect.Value, name string, value interface{}) {
+	field := v.FieldByName(name)
+	defaultValue := reflect.ValueOf(value)
+	if !field.IsValid() || !field.IsZero() || defaultValue.IsZero() || field.Type() != defaultValue.Type() {
+		return
+	}
+
+	field.Set(defaultValue)
+}
+
+// PollInterval returns the poll interval, or the default one of the client if it is unset.
+func (c *Client) PollInterval(pollInterval time.Duration) time.Duration {
+	switch {
+	case pollInterval != 0:
+		return pollInterval
+	case c.defaults.pollInterval != 0:
+		return c.defaults.pollInterval
+	default:
+		return DefaultPollInterval
+	}
+}
+
+// Timeout returns the default timeout of the client, DefaultTimeout if unset.
+// The timeout and the ctx deadlines are enforced client-side only: the API has
+// no per-job timeout parameter to derive from them, so jobs of reqs the caller
+// gave up on are not stopped server-side.
+func (c *Client) Timeout() time.Duration {
+	if c.defaults.timeout != 0 {
+		return c.defaults.timeout
+	}
+
+	return DefaultTimeout
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type defaultsOpts struct {
+	Domain       oxylabs.Domain
+	UserAgent    oxylabs.UserAgent
+	Pages        int
+	PollInterval time.Duration
+}
+
+func TestWithClientDefaults(t *testing.T) {
+	c := &Client{}
+	WithDefaultDomain(oxylabs.DOMAIN_DE)(c)
+	WithDefaultPages(3)(c)
+	WithDefaultPollInterval(time.Second)(c)
+
+	caller := &defaultsOpts{Pages: 1}
+	opt := WithClientDefaults(c, []*defaultsOpts{caller})
+
+	assert.Equal(t, oxylabs.DOMAIN_DE, opt.Domain)
+	assert.Equal(t, 1, opt.Pages)
+	assert.Equal(t, oxylabs.UserAgent(""), opt.UserAgent)
+	assert.Equal(t, time.Second, opt.PollInterval)
+	assert.Equal(t, &defaultsOpts{Pages: 1}, caller)
+
+	assert.Equal(t, oxylabs.DOMAIN_DE, WithClientDefaults[defaultsOpts](c, nil).Domain)
+	assert.Equal(t, time.Second, c.PollInterval(0))
+	assert.Equal(t, DefaultPollInterval, (&Client{}).PollInterval(0))
+}
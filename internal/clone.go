@@ -0,0 +1,56 @@
+package internal
+
+// With returns a shallow clone of the client with opts applied on top of its
+// configuration. The clone shares the http client, the credentials, the
+// concurrency guard and the rate limiter of the credentials of the client, so
+// clones configured per tenant reuse the same connection pool and limits, while
+// keeping their own stats.
+func (c *Client) With(opts ...ClientOption) *Client {
+	clone := &Client{
+		BaseUrl:        c.BaseUrl,
+		ApiCredentials: c.ApiCredentials,
+		HttpClient:     c.HttpClient,
+
+		defaults:     c.defaults,
+		pagesGuard:   c.pagesGuard,
+		loadShedding: c.loadShedding,
+		concurrency:  c.concurrency,
+		rateLimit:    c.rateLimit.clone(),
+		translation:  c.translation,
+
+		resubmitPolicy: c.resubmitPolicy,
+		retryPolicy:    c.retryPolicy,
+		jobStore:       c.jobStore,
+		archiveHook:    c.archiveHook,
+		classifier:     c.classifier,
+
+		rawCharset:       c.rawCharset,
+		strictLanguage:   c.strictLanguage,
+		fireAndForget:    c.fireAndForget,
+		maxResponseBytes: c.maxResponseBytes,
+		spillDir:         c.spillDir,
+	}
+
+	// Copy the configuration of the guarded fields.
+	c.auditor.mu.Lock()
+	clone.auditor.w = c.auditor.w
+	clone.auditor.fn = c.auditor.fn
+	clone.auditor.hashHeader = c.auditor.hashHeader
+	c.auditor.mu.Unlock()
+
+	c.schemaVersions.mu.Lock()
+	clone.schemaVersions.hook = c.schemaVersions.hook
+	c.schemaVersions.mu.Unlock()
+
+	c.credentials.mu.Lock()
+	clone.credentials.provider = c.credentials.provider
+	c.credentials.mu.Unlock()
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(clone)
+		}
+	}
+
+	return clone
+}
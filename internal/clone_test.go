@@ -0,0 +1,42 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type geoOpts struct {
+	Domain      oxylabs.Domain
+	GeoLocation string
+}
+
+func TestWith(t *testing.T) {
+	c := NewClient(SyncBaseUrl, "user", "pass", WithMaxConcurrency(1), WithDefaultDomain(oxylabs.DOMAIN_DE))
+	clone := c.With(WithDefaultGeoLocation("Berlin,Germany"), WithDefaultTimeout(time.Second))
+
+	// The clone shares the transport and the concurrency limit.
+	assert.Same(t, c.HttpClient, clone.HttpClient)
+	release, err := c.Acquire(context.Background())
+	assert.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = clone.Acquire(ctx)
+	assert.ErrorIs(t, err, oxylabs.ErrTimeout)
+	release()
+
+	// The clone overrides the defaults without changing the client's.
+	opts := WithClientDefaults(clone, []*geoOpts{})
+	assert.Equal(t, &geoOpts{Domain: oxylabs.DOMAIN_DE, GeoLocation: "Berlin,Germany"}, opts)
+	assert.Equal(t, time.Second, clone.Timeout())
+	assert.Equal(t, &geoOpts{Domain: oxylabs.DOMAIN_DE}, WithClientDefaults(c, []*geoOpts{}))
+	assert.Equal(t, DefaultTimeout, c.Timeout())
+
+	// The clone keeps its own stats.
+	clone.RecordRequest(oxylabs.GoogleSearch)
+	assert.Empty(t, c.Stats())
+	assert.Len(t, clone.Stats(), 1)
+}
@@ -0,0 +1,127 @@
+package internal
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// concurrencyGuard limits the outstanding reqs of the client,
+// queueing the others in FIFO order.
+type concurrencyGuard struct {
+	mu      sync.Mutex
+	limit   int
+	active  int
+	waiters list.List
+	stats   oxylabs.QueueStats
+}
+
+// WithMaxConcurrency limits the outstanding reqs of the client to n: the sync
+// scrapes until their resp, and the submissions of async jobs and batches.
+// Further reqs wait for a free slot in FIFO order, until their ctx is done.
+// A n of 0 means no limit.
+func WithMaxConcurrency(n int) ClientOption {
+	return func(c *Client) {
+		c.concurrency = &concurrencyGuard{limit: n}
+	}
+}
+
+// Acquire waits for a free slot of the concurrency guard of the client and
+// returns the func releasing it. It fails with oxylabs.ErrTimeout, or the
+// ctx error if canceled, if ctx is done first.
+func (c *Client) Acquire(ctx context.Context) (func(), error) {
+	g := c.concurrency
+	if g == nil || g.limit <= 0 {
+		return func() {}, nil
+	}
+
+	g.mu.Lock()
+	if g.active < g.limit && g.waiters.Len() == 0 {
+		g.active++
+		g.mu.Unlock()
+		return g.releaseOnce(), nil
+	}
+
+	// Queue up.
+	ready := make(chan struct{})
+	waiter := g.waiters.PushBack(ready)
+	g.mu.Unlock()
+
+	start := time.Now()
+	select {
+	case <-ready:
+		g.recordWait(time.Since(start))
+		return g.releaseOnce(), nil
+	case <-ctx.Done():
+	}
+
+	g.mu.Lock()
+	select {
+	case <-ready:
+		// The slot was handed over in the meantime, hand it over to the next one.
+		g.mu.Unlock()
+		g.release()
+	default:
+		g.waiters.Remove(waiter)
+		g.mu.Unlock()
+	}
+	g.recordWait(time.Since(start))
+
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return nil, oxylabs.ErrTimeout
+	}
+	return nil, ctx.Err()
+}
+
+// releaseOnce returns the func releasing the slot, at most once.
+func (g *concurrencyGuard) releaseOnce() func() {
+	var once sync.Once
+	return func() { once.Do(g.release) }
+}
+
+// release hands the slot over to the first queued req, if any.
+func (g *concurrencyGuard) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if front := g.waiters.Front(); front != nil {
+		g.waiters.Remove(front)
+		close(front.Value.(chan struct{}))
+		return
+	}
+	g.active--
+}
+
+// recordWait records the queue wait of a req.
+func (g *concurrencyGuard) recordWait(wait time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.stats.Waits++
+	g.stats.TotalWait += wait
+	if wait > g.stats.MaxWait {
+		g.stats.MaxWait = wait
+	}
+}
+
+// QueueStats returns the metrics of the concurrency guard of the client.
+func (c *Client) QueueStats() oxylabs.QueueStats {
+	g := c.concurrency
+	if g == nil {
+		return oxylabs.QueueStats{}
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	stats := g.stats
+	stats.Limit = g.limit
+	stats.Active = g.active
+	stats.Queued = g.waiters.Len()
+
+	return stats
+}
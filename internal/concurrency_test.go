@@ -0,0 +1,120 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAcquire(t *testing.T) {
+	c := NewClient(SyncBaseUrl, "user", "pass", WithMaxConcurrency(1))
+	ctx := context.Background()
+
+	release, err := c.Acquire(ctx)
+	assert.NoError(t, err)
+
+	// Queue up waiters, one at a time so that their order is known.
+	order := make(chan int, 3)
+	for i := 0; i < 3; i++ {
+		i := i
+		go func() {
+			release, err := c.Acquire(ctx)
+			if err != nil {
+				return
+			}
+			order <- i
+			release()
+		}()
+		assert.Eventually(t, func() bool { return c.QueueStats().Queued == i+1 }, time.Second, time.Millisecond)
+	}
+
+	// A waiter whose ctx is done leaves the queue.
+	timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+	defer cancel()
+	_, err = c.Acquire(timeoutCtx)
+	assert.True(t, errors.Is(err, oxylabs.ErrTimeout))
+	assert.Equal(t, 3, c.QueueStats().Queued)
+
+	release()
+	release() // Releasing twice is a no-op.
+	assert.Equal(t, []int{0, 1, 2}, []int{<-order, <-order, <-order}, "waiters are served in FIFO order")
+
+	assert.Eventually(t, func() bool { return c.QueueStats().Active == 0 }, time.Second, time.Millisecond)
+	stats := c.QueueStats()
+	assert.Equal(t, 1, stats.Limit)
+	assert.Equal(t, 0, stats.Queued)
+	assert.Equal(t, int64(4), stats.Waits)
+	assert.GreaterOrEqual(t, stats.MaxWait, 10*time.Millisecond)
+}
+
+func TestAcquire_NoLimit(t *testing.T) {
+	c := NewClient(SyncBaseUrl, "user", "pass")
+	for i := 0; i < 3; i++ {
+		_, err := c.Acquire(context.Background())
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, oxylabs.QueueStats{}, c.QueueStats())
+}
+
+func TestMaxConcurrencyGuardsReqs(t *testing.T) {
+	var (
+		mu                  sync.Mutex
+		inFlight, maxFlight int
+	)
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithMaxConcurrency(2))
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		mu.Lock()
+		inFlight++
+		maxFlight = max(maxFlight, inFlight)
+		mu.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mu.Lock()
+		inFlight--
+		mu.Unlock()
+
+		body := `{"id":"job1"}`
+		if strings.HasSuffix(req.URL.Path, "/batch") {
+			body = `{"queries":[{"id":"job1"},{"id":"job2"}]}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})}
+
+	ctx := context.Background()
+	payload := []byte(`{"source":"google_search","query":"shoes"}`)
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(3)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetJobID(ctx, payload)
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			_, err := c.GetJobIDs(ctx, []byte(`{"source":"google_search","query":["a","b"]}`))
+			assert.NoError(t, err)
+		}()
+		go func() {
+			defer wg.Done()
+			resp, err := c.Req(ctx, payload, "POST")
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 2, maxFlight)
+	assert.Equal(t, 0, c.QueueStats().Active)
+	assert.Greater(t, c.QueueStats().Waits, int64(0))
+}
@@ -0,0 +1,119 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// credentials caches the credentials of the provider of the client.
+type credentials struct {
+	mu         sync.Mutex
+	provider   oxylabs.CredentialsProvider
+	cached     *ApiCredentials
+	generation int
+}
+
+// WithCredentialsProvider gets the credentials of the client from provider
+// instead of the static username and password, so they can be rotated
+// without recreating the client. The credentials are refreshed on a 401.
+func WithCredentialsProvider(provider oxylabs.CredentialsProvider) ClientOption {
+	return func(c *Client) {
+		c.credentials.provider = provider
+	}
+}
+
+// authorize sets the credentials of the client on the req and returns their generation.
+func (c *Client) authorize(req *http.Request) (int, error) {
+	credentials, generation, err := c.currentCredentials(req.Context())
+	if err != nil {
+		return 0, err
+	}
+	req.SetBasicAuth(credentials.Username, credentials.Password)
+
+	return generation, nil
+}
+
+// currentCredentials returns the credentials of the client and their
+// generation, getting them from the provider if none are cached.
+func (c *Client) currentCredentials(ctx context.Context) (ApiCredentials, int, error) {
+	if c.credentials.provider == nil {
+		if c.ApiCredentials == nil {
+			return ApiCredentials{}, 0, nil
+		}
+		return *c.ApiCredentials, 0, nil
+	}
+
+	c.credentials.mu.Lock()
+	defer c.credentials.mu.Unlock()
+
+	if c.credentials.cached == nil {
+		username, password, err := c.credentials.provider(ctx)
+		if err != nil {
+			return ApiCredentials{}, 0, fmt.Errorf("error getting credentials: %v", err)
+		}
+		c.credentials.cached = &ApiCredentials{Username: username, Password: password}
+		c.credentials.generation++
+	}
+
+	return *c.credentials.cached, c.credentials.generation, nil
+}
+
+// username returns the username the reqs of the client are sent with.
+func (c *Client) username(ctx context.Context) (string, error) {
+	credentials, _, err := c.currentCredentials(ctx)
+
+	return credentials.Username, err
+}
+
+// invalidateCredentials drops the cached credentials of the generation,
+// unless they were refreshed already.
+func (c *Client) invalidateCredentials(generation int) {
+	c.credentials.mu.Lock()
+	defer c.credentials.mu.Unlock()
+
+	if c.credentials.generation == generation {
+		c.credentials.cached = nil
+	}
+}
+
+// DoAuthorized sends the req with the credentials of the client. If a credentials
+// provider is set and the credentials are rejected, they are refreshed and the
+// req is sent once more.
+func (c *Client) DoAuthorized(req *http.Request) (*http.Response, error) {
+	generation, err := c.authorize(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HttpClient.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || c.credentials.provider == nil {
+		return resp, err
+	}
+
+	// Refresh the credentials and retry.
+	retry := req.Clone(req.Context())
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return resp, nil
+		}
+		retry.Body = body
+	}
+	resp.Body.Close()
+	recordRetry(req.Context(), oxylabs.Retry{
+		Kind:    oxylabs.RetryTransport,
+		Attempt: 1,
+		Err:     &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status},
+	})
+
+	c.invalidateCredentials(generation)
+	if _, err = c.authorize(retry); err != nil {
+		return nil, err
+	}
+
+	return c.HttpClient.Do(retry)
+}
@@ -0,0 +1,45 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCredentialsProvider(t *testing.T) {
+	calls := 0
+	provider := func(ctx context.Context) (string, string, error) {
+		calls++
+		if calls == 1 {
+			return "user", "expired", nil
+		}
+		return "user", "rotated", nil
+	}
+
+	var bodies []string
+	c := NewClient(SyncBaseUrl, "", "", WithCredentialsProvider(provider))
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+
+		status := http.StatusOK
+		if _, password, _ := req.BasicAuth(); password != "rotated" {
+			status = http.StatusUnauthorized
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(""))}
+	})}
+
+	resp, err := c.Req(context.Background(), []byte(`{"source":"google"}`), "POST")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, []string{`{"source":"google"}`, `{"source":"google"}`}, bodies)
+
+	// The refreshed credentials are cached.
+	_, err = c.Req(context.Background(), []byte(`{"source":"google"}`), "POST")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
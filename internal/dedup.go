@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Dedup returns the items without the ones repeating the key of a previous item,
+// along with the number of dropped items. Items with an empty key are kept.
+func Dedup[T any](items []T, key func(T) string) ([]T, int) {
+	return DedupSeen(items, key, make(map[string]bool, len(items)))
+}
+
+// DedupSeen is Dedup dropping also the items whose key is in seen, e.g. the keys
+// of the items of previous pages, and adding the keys of the kept items to seen.
+func DedupSeen[T any](items []T, key func(T) string, seen map[string]bool) ([]T, int) {
+	kept := make([]T, 0, len(items))
+	for _, item := range items {
+		k := key(item)
+		if k != "" && seen[k] {
+			continue
+		}
+		seen[k] = true
+		kept = append(kept, item)
+	}
+
+	return kept, len(items) - len(kept)
+}
+
+// NormalizeUrl returns the url without fragment, trailing slash and with a
+// lowercase host, so the same result on different pages gets the same key.
+func NormalizeUrl(rawUrl string) string {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return rawUrl
+	}
+
+	parsedUrl.Fragment = ""
+	parsedUrl.Host = strings.ToLower(parsedUrl.Host)
+	parsedUrl.Path = strings.TrimSuffix(parsedUrl.Path, "/")
+
+	return parsedUrl.String()
+}
@@ -16,6 +16,9 @@ const (
 	DefaultLimit_SERP      int = 10
 	DefaultLimit_ECOMMERCE int = 48
 
+	MaxLimit_SERP            int = 100
+	MaxLimit_GOOGLE_SHOPPING int = 100
+
 	SyncBaseUrl  string = "https://realtime.oxylabs.io/v1/queries"
 	AsyncBaseUrl string = "https://data.oxylabs.io/v1/queries"
 )
@@ -35,7 +38,7 @@ func SetDefaultDomain(domain *oxylabs.Domain) {
 // SetDefaultStartPage sets the start_page parameter if it is not set.
 func SetDefaultStartPage(startPage *int) {
 	if *startPage == 0 {
-		*startPage = 1
+		*startPage = DefaultStartPage
 	}
 }
 
@@ -87,3 +90,25 @@ func SetDefaultContentEncoding(contentEncoding *string) {
 		*contentEncoding = "base64"
 	}
 }
+
+// SetLocaleDomain sets the domain parameter to the Google domain
+// of the geo location or locale if it is not set.
+func SetLocaleDomain(domain *oxylabs.Domain, geoLocation string, locale string) {
+	if *domain != "" {
+		return
+	}
+	if localeDomain, ok := oxylabs.GoogleDomainFor(geoLocation, locale); ok {
+		*domain = localeDomain
+	}
+}
+
+// SetGeoLocale sets the locale parameter to the locale
+// of the country of the geo location if it is not set.
+func SetGeoLocale[T ~string](locale *T, geoLocation string) {
+	if *locale != "" {
+		return
+	}
+	if geoLocale, ok := oxylabs.LocaleFor(geoLocation); ok {
+		*locale = T(geoLocale)
+	}
+}
@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// DefaultFaultReason is the fault reason of jobs faulted without any reason from the API.
+const DefaultFaultReason = "there was an error processing your query"
+
+// submittedJobTTL is how long a submitted job is kept if it is never polled to
+// completion, e.g. jobs of fire and forget reqs and unwaited job handles.
+// It matches the time the API keeps the results of a job.
+const submittedJobTTL = 24 * time.Hour
+
+// submittedPruneInterval is the min interval between prunes of expired jobs.
+const submittedPruneInterval = time.Minute
+
+// submitted keeps the payload hash and submission time of submitted jobs until
+// they complete, for the diagnostics of faulted jobs and the latency stats.
+type submitted struct {
+	mu     sync.Mutex
+	hashes map[string]string
+	times  map[string]time.Time
+	pruned time.Time
+}
+
+// rememberJob keeps the payload hash of the submitted job,
+// dropping the jobs submitted more than submittedJobTTL ago.
+func (c *Client) rememberJob(jobID string, hash string) {
+	c.submitted.mu.Lock()
+	defer c.submitted.mu.Unlock()
+
+	if c.submitted.hashes == nil {
+		c.submitted.hashes = make(map[string]string)
+		c.submitted.times = make(map[string]time.Time)
+	}
+
+	now := time.Now()
+	if now.Sub(c.submitted.pruned) >= submittedPruneInterval {
+		for id, submittedAt := range c.submitted.times {
+			if now.Sub(submittedAt) >= submittedJobTTL {
+				delete(c.submitted.hashes, id)
+				delete(c.submitted.times, id)
+			}
+		}
+		c.submitted.pruned = now
+	}
+
+	c.submitted.hashes[jobID] = hash
+	c.submitted.times[jobID] = now
+}
+
+// forgetJob drops the completed or abandoned job and returns its payload hash.
+func (c *Client) forgetJob(jobID string) string {
+	c.submitted.mu.Lock()
+	defer c.submitted.mu.Unlock()
+
+	hash := c.submitted.hashes[jobID]
+	delete(c.submitted.hashes, jobID)
+	delete(c.submitted.times, jobID)
+
+	return hash
+}
+
+// recordJobLatency records the latency of the completed job, from its submission.
+// It must be called before the job is forgotten.
+func (c *Client) recordJobLatency(jobID string, source oxylabs.Source) {
+	c.submitted.mu.Lock()
+	submittedAt, ok := c.submitted.times[jobID]
+	c.submitted.mu.Unlock()
+
+	if ok {
+		c.RecordAsyncLatency(source, time.Since(submittedAt))
+	}
+}
+
+// faultedJobError returns the error of the faulted job, with the fault reason from the API.
+func (c *Client) faultedJobError(job *Job) error {
+	c.RecordFault(job.Source)
+
+	reason := DefaultFaultReason
+	for i := len(job.Statuses) - 1; i >= 0; i-- {
+		if message := job.Statuses[i].reason(); message != "" {
+			reason = message
+			break
+		}
+	}
+
+	return &oxylabs.FaultedJobError{
+		JobID:       job.ID,
+		Source:      job.Source,
+		PayloadHash: c.forgetJob(job.ID),
+		Reason:      reason,
+	}
+}
+
+// JobStatus is an entry of the status history of a job.
+type JobStatus struct {
+	Status     string `json:"status"`
+	StatusCode int    `json:"status_code"`
+	Message    string `json:"message"`
+	Reason     string `json:"reason"`
+}
+
+// reason returns the fault reason of the status, if any.
+func (s JobStatus) reason() string {
+	if s.Reason != "" {
+		return s.Reason
+	}
+
+	return s.Message
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRememberJobExpires(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	c.rememberJob("job1", "hash1")
+	c.submitted.times["job1"] = time.Now().Add(-submittedJobTTL)
+	c.submitted.pruned = time.Time{}
+
+	c.rememberJob("job2", "hash2")
+	assert.NotContains(t, c.submitted.hashes, "job1")
+	assert.NotContains(t, c.submitted.times, "job1")
+	assert.Equal(t, "hash2", c.forgetJob("job2"))
+	assert.Empty(t, c.submitted.hashes)
+}
+
+func TestPollJobStatusForgetsAbandonedJob(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		body := `{"id":"job1","status":"pending"}`
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})}
+	c.rememberJob("job1", "hash1")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	httpRespChan := make(chan *http.Response)
+	errChan := make(chan error)
+	go c.PollJobStatus(ctx, "job1", time.Millisecond, httpRespChan, errChan)
+
+	assert.True(t, errors.Is(<-errChan, oxylabs.ErrTimeout))
+	<-httpRespChan
+	assert.Eventually(t, func() bool {
+		c.submitted.mu.Lock()
+		defer c.submitted.mu.Unlock()
+		return len(c.submitted.hashes) == 0 && len(c.submitted.times) == 0
+	}, time.Second, time.Millisecond)
+}
+
+func TestAwaitJobForgetsCancelledJob(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	c.rememberJob("job1", "hash1")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.AwaitJob(ctx, "job1", make(chan Job), time.Hour, time.Millisecond)
+	assert.True(t, errors.Is(err, oxylabs.ErrTimeout))
+	assert.Empty(t, c.submitted.hashes)
+	assert.Empty(t, c.submitted.times)
+}
+
+// blockingResults is a transport whose results reqs block until their ctx is done.
+type blockingResults struct{}
+
+func (blockingResults) RoundTrip(req *http.Request) (*http.Response, error) {
+	<-req.Context().Done()
+	return nil, req.Context().Err()
+}
+
+func TestAwaitJobNotifiedCancelled(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	c.HttpClient = &http.Client{Transport: blockingResults{}}
+
+	jobChan := make(chan Job, 1)
+	jobChan <- Job{ID: "job1", Status: "done", Source: oxylabs.GoogleSearch}
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		_, err := c.AwaitJob(ctx, "job1", jobChan, time.Hour, time.Millisecond)
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		assert.True(t, errors.Is(err, oxylabs.ErrTimeout))
+	case <-time.After(time.Second):
+		t.Fatal("the results of the notified job are retrieved past the ctx")
+	}
+}
@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Health probes the base url with a HEAD req and returns its reachability and latency.
+// An error is returned along with the health if the API is unreachable.
+func (c *Client) Health(ctx context.Context) (oxylabs.Health, error) {
+	health := oxylabs.Health{}
+
+	req, err := NewRequestWithContext(ctx, "HEAD", c.BaseUrl, nil)
+	if err != nil {
+		return health, err
+	}
+
+	start := time.Now()
+	resp, err := c.DoAuthorized(req)
+	health.Latency = time.Since(start)
+	if err != nil {
+		return health, fmt.Errorf("error performing req: %v", err)
+	}
+	resp.Body.Close()
+
+	health.Reachable = true
+	health.StatusCode = resp.StatusCode
+	health.Authenticated = resp.StatusCode != http.StatusUnauthorized && resp.StatusCode != http.StatusForbidden
+
+	return health, nil
+}
@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, password, _ := r.BasicAuth(); password != "pass" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	health, err := NewClient(server.URL, "user", "pass").Health(context.Background())
+	assert.NoError(t, err)
+	assert.True(t, health.Reachable)
+	assert.True(t, health.Authenticated)
+
+	health, err = NewClient(server.URL, "user", "wrong").Health(context.Background())
+	assert.NoError(t, err)
+	assert.False(t, health.Authenticated)
+
+	server.Close()
+	health, err = NewClient(server.URL, "user", "pass").Health(context.Background())
+	assert.Error(t, err)
+	assert.False(t, health.Reachable)
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlainText(t *testing.T) {
+	content := `<html><head><title>Shop</title><style>p {}</style></head><body>
+<nav><a href="/">Home</a></nav>
+<h1>Adidas   shoes</h1>
+<p>Best <b>price</b> in town.</p>
+<script>track();</script>
+<ul><li>One</li><li>Two</li></ul>
+<footer>Copyright</footer>
+</body></html>`
+
+	text, err := PlainText(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "Adidas shoes\nBest price in town.\nOne\nTwo", text)
+}
+
+func TestMarkdown(t *testing.T) {
+	content := `<html><body>
+<nav><a href="/">Home</a></nav>
+<h2>Adidas   shoes</h2>
+<p>Best <strong>price</strong> at <a href="https://example.com">our shop</a>.</p>
+<ul><li>One</li><li>Two</li></ul>
+<table><tr><th>Size</th><th>Price</th></tr><tr><td>42</td><td>59.99</td></tr></table>
+<pre>go get ./...
+</pre>
+</body></html>`
+
+	md, err := Markdown(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "## Adidas shoes\n\n"+
+		"Best **price** at [our shop](https://example.com).\n\n"+
+		"- One\n- Two\n\n"+
+		"| Size | Price |\n| --- | --- |\n| 42 | 59.99 |\n\n"+
+		"```\ngo get ./...\n```", md)
+}
+
+func TestMarkdownNestedLists(t *testing.T) {
+	content := `<ul>
+  <li>Shoes
+    <ul>
+      <li>Running</li>
+      <li>Trail
+        <ol><li>Waterproof</li></ol>
+      </li>
+    </ul>
+  </li>
+  <li>Shirts</li>
+</ul>`
+
+	md, err := Markdown(content)
+	assert.NoError(t, err)
+	assert.Equal(t, "- Shoes\n\n"+
+		"   - Running\n"+
+		"   - Trail\n\n"+
+		"      1. Waterproof\n"+
+		"- Shirts", md)
+}
@@ -0,0 +1,103 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// IdempotencyNotesPrefix prefixes the idempotency key of a req in the
+// client_notes of its submitted job, so that the jobs of keyed reqs can be
+// told from the others when reconciling a job store with the API.
+const IdempotencyNotesPrefix = "idempotency_key:"
+
+// WithJobStore stores the jobs of async reqs with an idempotency key in store,
+// so that retried submissions reuse the job instead of creating a duplicate.
+func WithJobStore(store oxylabs.JobStore) ClientOption {
+	return func(c *Client) {
+		c.jobStore = store
+	}
+}
+
+// SubmitJob submits the job of the req and returns its ID. If the req has an
+// idempotency key and the client a job store, the job already submitted for
+// the key is returned instead. The job carries the key in its client_notes,
+// unless the req sets them.
+func (c *Client) SubmitJob(
+	ctx context.Context,
+	req *oxylabs.Request,
+	jsonPayload []byte,
+) (string, error) {
+	key := req.IdempotencyKey
+	if key == "" || c.jobStore == nil {
+		return c.GetJobID(ctx, jsonPayload)
+	}
+
+	// Reuse the job of the key, if any.
+	if jobID, ok, err := c.jobStore.Load(ctx, key); err != nil {
+		return "", fmt.Errorf("error loading job of idempotency key: %v", err)
+	} else if ok {
+		return jobID, nil
+	}
+
+	reserved, err := c.jobStore.Reserve(ctx, key)
+	if err != nil {
+		return "", fmt.Errorf("error reserving idempotency key: %v", err)
+	}
+	if !reserved {
+		// Another submission stored its job in the meantime or is still in progress.
+		if jobID, ok, err := c.jobStore.Load(ctx, key); err == nil && ok {
+			return jobID, nil
+		}
+		return "", oxylabs.ErrSubmissionInProgress
+	}
+
+	jobID, err := c.GetJobID(ctx, withIdempotencyNotes(jsonPayload, key))
+	if err != nil {
+		_ = c.jobStore.Release(ctx, key)
+		return "", err
+	}
+	if err = c.jobStore.Store(ctx, key, jobID); err != nil {
+		return "", fmt.Errorf("error storing job of idempotency key: %v", err)
+	}
+
+	return jobID, nil
+}
+
+// storeResubmittedJob replaces the job of the req's idempotency key with its resubmitted job.
+func (c *Client) storeResubmittedJob(ctx context.Context, req *oxylabs.Request, jobID string) error {
+	if req.IdempotencyKey == "" || c.jobStore == nil {
+		return nil
+	}
+
+	return c.jobStore.Store(ctx, req.IdempotencyKey, jobID)
+}
+
+// IdempotencyKeyOfNotes returns the idempotency key carried by the client
+// notes of a job, and false if the job was submitted without one.
+func IdempotencyKeyOfNotes(notes string) (string, bool) {
+	return strings.CutPrefix(notes, IdempotencyNotesPrefix)
+}
+
+// withIdempotencyNotes returns the json payload with the idempotency key in
+// its client_notes, or the payload itself if they are already set.
+func withIdempotencyNotes(jsonPayload []byte, key string) []byte {
+	var payload map[string]json.RawMessage
+	if err := json.Unmarshal(jsonPayload, &payload); err != nil {
+		return jsonPayload
+	}
+	if _, ok := payload["client_notes"]; ok {
+		return jsonPayload
+	}
+
+	payload["client_notes"], _ = json.Marshal(IdempotencyNotesPrefix + key)
+	notedPayload, err := json.Marshal(payload)
+	if err != nil {
+		return jsonPayload
+	}
+
+	return notedPayload
+}
@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubmitJob(t *testing.T) {
+	store := oxylabs.NewMemoryJobStore()
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithJobStore(store))
+	c.HttpClient = &http.Client{Transport: fakeJobs(0)}
+	payload := []byte(`{"source":"google_search"}`)
+	ctx := context.Background()
+
+	req := &oxylabs.Request{Source: oxylabs.GoogleSearch, IdempotencyKey: "item-1"}
+	first, err := c.SubmitJob(ctx, req, payload)
+	assert.NoError(t, err)
+
+	// Retried submissions reuse the job.
+	retried, err := c.SubmitJob(ctx, req, payload)
+	assert.NoError(t, err)
+	assert.Equal(t, first, retried)
+
+	// Reqs without a key are always submitted.
+	other, err := c.SubmitJob(ctx, &oxylabs.Request{Source: oxylabs.GoogleSearch}, payload)
+	assert.NoError(t, err)
+	assert.NotEqual(t, first, other)
+
+	// A key reserved by a submission in progress is not submitted again.
+	reserved, _ := store.Reserve(ctx, "item-2")
+	assert.True(t, reserved)
+	_, err = c.SubmitJob(ctx, &oxylabs.Request{Source: oxylabs.GoogleSearch, IdempotencyKey: "item-2"}, payload)
+	assert.True(t, errors.Is(err, oxylabs.ErrSubmissionInProgress))
+}
+
+func TestWithIdempotencyNotes(t *testing.T) {
+	noted := withIdempotencyNotes([]byte(`{"source":"google_search","query":"shoes"}`), "item-1")
+	assert.JSONEq(t, `{"client_notes":"idempotency_key:item-1","query":"shoes","source":"google_search"}`, string(noted))
+
+	key, ok := IdempotencyKeyOfNotes("idempotency_key:item-1")
+	assert.True(t, ok)
+	assert.Equal(t, "item-1", key)
+	_, ok = IdempotencyKeyOfNotes("campaign 42")
+	assert.False(t, ok)
+
+	// Notes set by the user are kept.
+	payload := []byte(`{"client_notes":"campaign 42","source":"google_search"}`)
+	assert.Equal(t, payload, withIdempotencyNotes(payload, "item-1"))
+}
@@ -0,0 +1,79 @@
+//go:build go1.23
+
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"iter"
+)
+
+// ArrayItems iterates over the items of the json array found at path in content,
+// decoding a single item at a time. Nothing is yielded if there is no array at path.
+func ArrayItems(content json.RawMessage, path ...string) iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		dec := json.NewDecoder(bytes.NewReader(content))
+
+		// Descend to the array.
+		for _, key := range path {
+			found, err := seekKey(dec, key)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			if !found {
+				return
+			}
+		}
+
+		tok, err := dec.Token()
+		if err != nil {
+			yield(nil, fmt.Errorf("error decoding content: %v", err))
+			return
+		}
+		if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+			return
+		}
+
+		for dec.More() {
+			var item json.RawMessage
+			if err := dec.Decode(&item); err != nil {
+				yield(nil, fmt.Errorf("error decoding item: %v", err))
+				return
+			}
+			if !yield(item, nil) {
+				return
+			}
+		}
+	}
+}
+
+// seekKey moves dec to the value of key in the next json object.
+func seekKey(dec *json.Decoder, key string) (bool, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return false, fmt.Errorf("error decoding content: %v", err)
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return false, nil
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return false, fmt.Errorf("error decoding content: %v", err)
+		}
+		if tok == key {
+			return true, nil
+		}
+
+		// Skip the value.
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return false, fmt.Errorf("error decoding content: %v", err)
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,40 @@
+//go:build go1.23
+
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayItems(t *testing.T) {
+	content := json.RawMessage(`{"url":"u","results":{"paid":[{"pos":1}],"organic":[{"pos":1},{"pos":2},{"pos":3}]}}`)
+
+	tests := []struct {
+		name  string
+		path  []string
+		limit int
+		want  []string
+	}{
+		{name: "nested array", path: []string{"results", "organic"}, want: []string{`{"pos":1}`, `{"pos":2}`, `{"pos":3}`}},
+		{name: "early break", path: []string{"results", "organic"}, limit: 2, want: []string{`{"pos":1}`, `{"pos":2}`}},
+		{name: "missing key", path: []string{"results", "images"}},
+		{name: "not an array", path: []string{"url"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for item, err := range ArrayItems(content, tt.path...) {
+				assert.NoError(t, err)
+				got = append(got, string(item))
+				if tt.limit > 0 && len(got) == tt.limit {
+					break
+				}
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// JobHandle is a handle to a submitted async job.
+// The results body is kept once the job is done, so it can be decoded repeatedly.
+type JobHandle struct {
+	JobID string
+
+	c            *Client
+	pollInterval time.Duration
+
+	mu   sync.Mutex
+	body []byte
+}
+
+// NewJobHandle returns a handle to the submitted job with the given ID.
+func (c *Client) NewJobHandle(jobID string, pollInterval time.Duration) *JobHandle {
+	return &JobHandle{
+		JobID:        jobID,
+		c:            c,
+		pollInterval: pollInterval,
+	}
+}
+
+// CallbackRequest returns a copy of req with url as its callback_url.
+func CallbackRequest(req *oxylabs.Request, url string) *oxylabs.Request {
+	next := copyRequest(req)
+	next.Params["callback_url"] = url
+
+	return next
+}
+
+// Wait waits for the job to be done and returns the http resp of its results.
+func (h *JobHandle) Wait(ctx context.Context) (*http.Response, error) {
+	return h.wait(func() (*http.Response, error) {
+		httpRespChan := make(chan *http.Response)
+		errChan := make(chan error)
+
+		// Poll job status.
+		go h.c.PollJobStatus(ctx, h.JobID, h.pollInterval, httpRespChan, errChan)
+
+		// Handle error.
+		if err := <-errChan; err != nil {
+			return nil, err
+		}
+
+		return <-httpRespChan, nil
+	})
+}
+
+// WaitCallback waits for the callback of the completed job to arrive at the receiver
+// and returns the http resp of its results, falling back to polling after fallbackAfter.
+func (h *JobHandle) WaitCallback(
+	ctx context.Context,
+	receiver *callback.Receiver,
+	fallbackAfter time.Duration,
+) (*http.Response, error) {
+	return h.wait(func() (*http.Response, error) {
+		return h.c.AwaitCallback(ctx, h.JobID, receiver, fallbackAfter, h.pollInterval)
+	})
+}
+
+// wait returns the kept results body, or fetches and keeps it.
+// The lock is not held while fetching, so that Partial is not blocked
+// by a pending Wait.
+func (h *JobHandle) wait(fetch func() (*http.Response, error)) (*http.Response, error) {
+	h.mu.Lock()
+	body := h.body
+	h.mu.Unlock()
+
+	if body != nil {
+		return bodyResp(body), nil
+	}
+
+	httpResp, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+
+	body, err = io.ReadAll(httpResp.Body)
+	httpResp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, &oxylabs.StatusError{StatusCode: httpResp.StatusCode, Status: httpResp.Status, Body: body, JobID: h.JobID}
+	}
+
+	// Keep the body of the first of concurrent waits.
+	h.mu.Lock()
+	if h.body == nil {
+		h.body = body
+	}
+	body = h.body
+	h.mu.Unlock()
+
+	return bodyResp(body), nil
+}
+
+// Partial returns the http resp of the job's results available so far,
+// without waiting for the job to be done. Pages still being processed
+// are missing from the results.
+func (h *JobHandle) Partial(ctx context.Context) (*http.Response, error) {
+	h.mu.Lock()
+	body := h.body
+	h.mu.Unlock()
+
+	if body != nil {
+		return bodyResp(body), nil
+	}
+	defer h.c.track()()
+
+	reqUrl, err := callUrl(ctx, fmt.Sprintf("https://data.oxylabs.io/v1/queries/%s/results", h.JobID))
+	if err != nil {
+		return nil, err
+	}
+	req, _ := NewRequestWithContext(
+		ctx,
+		"GET",
+		reqUrl,
+		nil,
+	)
+	req.Header.Add("Content-type", "application/json")
+	httpResp, err := h.c.DoAuthorized(req)
+	if err != nil {
+		return nil, fmt.Errorf("error performing req: %v", err)
+	}
+	if err = h.c.limitBody(httpResp); err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	body, err = io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	switch httpResp.StatusCode {
+	case http.StatusOK:
+		return bodyResp(body), nil
+	case http.StatusNoContent, http.StatusNotFound:
+		// No page is available yet.
+		return bodyResp([]byte(`{"results":[]}`)), nil
+	default:
+		return nil, &oxylabs.StatusError{StatusCode: httpResp.StatusCode, Status: httpResp.Status, Body: body, JobID: h.JobID}
+	}
+}
+
+// bodyResp returns a successful http resp with the given body.
+func bodyResp(body []byte) *http.Response {
+	return &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}
+}
@@ -0,0 +1,26 @@
+package internal
+
+import "github.com/revvim/oxylabs-sdk-go/oxylabs"
+
+// WithStrictLanguage fails reqs whose results_language is inconsistent with their
+// domain and locale with a validation error, instead of warning about it.
+func WithStrictLanguage() ClientOption {
+	return func(c *Client) {
+		c.strictLanguage = true
+	}
+}
+
+// CheckLanguage checks the results_language of the req is consistent with its
+// domain and locale. The inconsistency is returned as a warning of the resp, or
+// as a validation error if the client is strict about the language.
+func (c *Client) CheckLanguage(req *oxylabs.Request) ([]oxylabs.Warning, error) {
+	err := req.CheckResultsLanguage()
+	if err == nil {
+		return nil, nil
+	}
+	if c.strictLanguage {
+		return nil, oxylabs.NewValidationError([]error{err})
+	}
+
+	return []oxylabs.Warning{{Message: err.Error()}}, nil
+}
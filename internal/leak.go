@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"bytes"
+	"runtime"
+	"strings"
+)
+
+// Goroutines returns the stacks of the running goroutines, keyed by goroutine id.
+// The goroutines of the test runner and of the runtime are left out.
+func Goroutines() map[string]string {
+	buf := make([]byte, 64<<10)
+	for {
+		n := runtime.Stack(buf, true)
+		if n < len(buf) {
+			buf = buf[:n]
+			break
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+
+	goroutines := make(map[string]string)
+	for _, stack := range bytes.Split(buf, []byte("\n\n")) {
+		header, _, _ := strings.Cut(string(stack), "\n")
+		id, ok := strings.CutPrefix(header, "goroutine ")
+		if !ok || ignoredGoroutine(string(stack)) {
+			continue
+		}
+		id, _, _ = strings.Cut(id, " ")
+		goroutines[id] = string(stack)
+	}
+
+	return goroutines
+}
+
+// LeakedGoroutines returns the stacks of the goroutines running now that were
+// not running in before, a snapshot taken with Goroutines.
+func LeakedGoroutines(before map[string]string) []string {
+	var leaked []string
+	for id, stack := range Goroutines() {
+		if _, ok := before[id]; !ok {
+			leaked = append(leaked, stack)
+		}
+	}
+
+	return leaked
+}
+
+// ignoredGoroutine returns whether the stack is of a goroutine not started by
+// the code under test, e.g. the goroutine taking the snapshot or a test runner.
+func ignoredGoroutine(stack string) bool {
+	for _, frame := range []string{
+		"internal.Goroutines(",
+		"testing.tRunner(",
+		"testing.(*T).Run(",
+		"testing.(*M).",
+		"runtime.goexit0(",
+		"os/signal.signal_recv(",
+	} {
+		if strings.Contains(stack, frame) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WithMaxResponseBytes rejects resps whose body exceeds n bytes with an
+// *oxylabs.ResponseTooLargeError instead of reading them into memory.
+// A n of 0 means no limit.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return func(c *Client) {
+		c.maxResponseBytes = n
+	}
+}
+
+// WithSpillDir streams the body of the resps exceeding the max resp bytes
+// to a file in dir, so it can still be inspected.
+func WithSpillDir(dir string) ClientOption {
+	return func(c *Client) {
+		c.spillDir = dir
+	}
+}
+
+// limitBody reads the resp body up to the max resp bytes of the client.
+// The body is closed and an error returned if it exceeds the limit.
+func (c *Client) limitBody(resp *http.Response) error {
+	if c.maxResponseBytes <= 0 {
+		return nil
+	}
+	if resp.ContentLength > c.maxResponseBytes && c.spillDir == "" {
+		resp.Body.Close()
+		return &oxylabs.ResponseTooLargeError{Limit: c.maxResponseBytes}
+	}
+
+	// Read one byte over the limit to detect larger bodies.
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBytes+1))
+	if err != nil {
+		resp.Body.Close()
+		return fmt.Errorf("error reading resp body: %v", err)
+	}
+	if int64(len(body)) <= c.maxResponseBytes {
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+		return nil
+	}
+	defer resp.Body.Close()
+
+	tooLarge := &oxylabs.ResponseTooLargeError{Limit: c.maxResponseBytes}
+	if c.spillDir == "" {
+		return tooLarge
+	}
+
+	// Spill the body to a file.
+	f, err := os.CreateTemp(c.spillDir, "oxylabs-resp-*.json")
+	if err != nil {
+		return fmt.Errorf("error creating spill file: %v", err)
+	}
+	defer f.Close()
+	if _, err = io.Copy(f, io.MultiReader(bytes.NewReader(body), resp.Body)); err != nil {
+		return fmt.Errorf("error writing spill file: %v", err)
+	}
+	tooLarge.SpillPath = f.Name()
+
+	return tooLarge
+}
@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimitBody(t *testing.T) {
+	spillDir := t.TempDir()
+
+	tests := []struct {
+		name     string
+		opts     []ClientOption
+		body     string
+		wantErr  bool
+		wantBody string
+		spilled  bool
+	}{
+		{name: "no limit", body: "0123456789", wantBody: "0123456789"},
+		{name: "within limit", opts: []ClientOption{WithMaxResponseBytes(10)}, body: "0123456789", wantBody: "0123456789"},
+		{name: "exceeds limit", opts: []ClientOption{WithMaxResponseBytes(5)}, body: "0123456789", wantErr: true},
+		{name: "spilled", opts: []ClientOption{WithMaxResponseBytes(5), WithSpillDir(spillDir)}, body: "0123456789", wantErr: true, spilled: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient(SyncBaseUrl, "user", "pass", tt.opts...)
+			resp := &http.Response{Body: io.NopCloser(strings.NewReader(tt.body)), ContentLength: -1}
+
+			err := c.limitBody(resp)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				body, _ := io.ReadAll(resp.Body)
+				assert.Equal(t, tt.wantBody, string(body))
+				return
+			}
+
+			assert.True(t, errors.Is(err, oxylabs.ErrResponseTooLarge))
+			var tooLarge *oxylabs.ResponseTooLargeError
+			assert.True(t, errors.As(err, &tooLarge))
+			if tt.spilled {
+				spilled, _ := os.ReadFile(tooLarge.SpillPath)
+				assert.Equal(t, tt.body, string(spilled))
+			} else {
+				assert.Empty(t, tooLarge.SpillPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,267 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// limiter is a token bucket pacing the jobs submitted with a set of credentials.
+// Its limit is the lowest of the limits of the clients sharing it.
+type limiter struct {
+	mu     sync.Mutex
+	limits map[*rateLimit]oxylabs.RateLimit
+	limit  oxylabs.RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// limiters is the registry of the limiters of the process, keyed by username,
+// so that the clients of the same credentials share their pace. A limiter is
+// dropped once no client shares it anymore.
+var limiters = struct {
+	mu         sync.Mutex
+	byUsername map[string]*limiter
+}{byUsername: make(map[string]*limiter)}
+
+// rateLimit is the share of a client in the limiter of the credentials it
+// submits jobs with. The limiter is resolved on submission, since the
+// credentials of a provider may change, and released on shutdown.
+type rateLimit struct {
+	limit oxylabs.RateLimit
+
+	mu       sync.Mutex
+	username string
+	limiter  *limiter
+	released bool
+}
+
+// WithRateLimit paces the jobs submitted by the client to the limit. The pace is
+// shared by all the clients of the process submitting with the same username,
+// so that together they don't exceed the quota of the credentials. Clients
+// without a username don't share their pace.
+func WithRateLimit(limit oxylabs.RateLimit) ClientOption {
+	return func(c *Client) {
+		if limit.RequestsPerSecond <= 0 {
+			c.rateLimit = nil
+			return
+		}
+		if limit.Burst <= 0 {
+			limit.Burst = 1
+		}
+
+		c.rateLimit = &rateLimit{limit: limit}
+	}
+}
+
+// clone returns a share of the same limit, released independently.
+func (r *rateLimit) clone() *rateLimit {
+	if r == nil {
+		return nil
+	}
+
+	return &rateLimit{limit: r.limit}
+}
+
+// limiterOf returns the limiter of the username, moving the share over from
+// the limiter of the previous username if the credentials changed.
+func (r *rateLimit) limiterOf(username string) *limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.released || (r.limiter != nil && r.username == username) {
+		return r.limiter
+	}
+	if r.limiter != nil {
+		releaseLimiter(r.username, r.limiter, r)
+	}
+	r.username = username
+	r.limiter = acquireLimiter(username, r)
+
+	return r.limiter
+}
+
+// release releases the share of its limiter.
+func (r *rateLimit) release() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.limiter != nil && !r.released {
+		releaseLimiter(r.username, r.limiter, r)
+	}
+	r.released = true
+}
+
+// acquireLimiter returns the limiter of the username with the share
+// registered, creating it if there is none.
+func acquireLimiter(username string, r *rateLimit) *limiter {
+	// The pace of unknown credentials isn't shared.
+	if username == "" {
+		l := &limiter{limits: make(map[*rateLimit]oxylabs.RateLimit)}
+		l.register(r)
+		return l
+	}
+
+	limiters.mu.Lock()
+	defer limiters.mu.Unlock()
+
+	l, ok := limiters.byUsername[username]
+	if !ok {
+		l = &limiter{limits: make(map[*rateLimit]oxylabs.RateLimit)}
+		limiters.byUsername[username] = l
+	}
+	l.register(r)
+
+	return l
+}
+
+// releaseLimiter unregisters the share from the limiter of the username,
+// dropping the limiter once it has no share left.
+func releaseLimiter(username string, l *limiter, r *rateLimit) {
+	limiters.mu.Lock()
+	defer limiters.mu.Unlock()
+
+	if l.unregister(r) == 0 && limiters.byUsername[username] == l {
+		delete(limiters.byUsername, username)
+	}
+}
+
+// register adds the limit of the share, starting with a full bucket.
+func (l *limiter) register(r *rateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if len(l.limits) == 0 {
+		l.tokens = float64(r.limit.Burst)
+	}
+	l.limits[r] = r.limit
+	l.updateLimit()
+}
+
+// unregister removes the limit of the share and returns the number of shares left.
+func (l *limiter) unregister(r *rateLimit) int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.limits, r)
+	if len(l.limits) > 0 {
+		l.updateLimit()
+	}
+
+	return len(l.limits)
+}
+
+// updateLimit sets the limit to the lowest of the limits of the shares,
+// so that no client exceeds its quota.
+func (l *limiter) updateLimit() {
+	first := true
+	for _, limit := range l.limits {
+		if first || limit.RequestsPerSecond < l.limit.RequestsPerSecond {
+			l.limit.RequestsPerSecond = limit.RequestsPerSecond
+		}
+		if first || limit.Burst < l.limit.Burst {
+			l.limit.Burst = limit.Burst
+		}
+		first = false
+	}
+	if burst := float64(l.limit.Burst); l.tokens > burst {
+		l.tokens = burst
+	}
+}
+
+// reserve takes n tokens at the time now and returns the time to wait before
+// the jobs can be submitted.
+func (l *limiter) reserve(now time.Time, n int) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	// Refill the bucket.
+	if !l.last.IsZero() {
+		l.tokens += now.Sub(l.last).Seconds() * l.limit.RequestsPerSecond
+	}
+	if burst := float64(l.limit.Burst); l.tokens > burst {
+		l.tokens = burst
+	}
+	l.last = now
+
+	l.tokens -= float64(n)
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.limit.RequestsPerSecond * float64(time.Second))
+}
+
+// refund gives back the n tokens reserved for jobs that were not submitted.
+func (l *limiter) refund(n int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens += float64(n)
+	if burst := float64(l.limit.Burst); l.tokens > burst {
+		l.tokens = burst
+	}
+}
+
+// pace waits until the jobs of the json payload can be submitted per the rate
+// limit of the client, if any, or returns oxylabs.ErrTimeout once ctx is done.
+// The limiter is the one of the credentials the jobs are submitted with.
+func (c *Client) pace(ctx context.Context, jsonPayload []byte) error {
+	if c.rateLimit == nil {
+		return nil
+	}
+
+	username, err := c.username(ctx)
+	if err != nil {
+		return err
+	}
+	l := c.rateLimit.limiterOf(username)
+	if l == nil {
+		return nil
+	}
+
+	n := payloadJobs(jsonPayload)
+	wait := l.reserve(time.Now(), n)
+	if wait == 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// The jobs are not submitted, so their tokens are left to the others.
+		l.refund(n)
+		return fmt.Errorf("%w: waiting for the rate limit", oxylabs.ErrTimeout)
+	}
+}
+
+// payloadJobs returns the number of jobs of the json payload, one per query or
+// url of batch payloads.
+func payloadJobs(jsonPayload []byte) int {
+	payload := &struct {
+		Query json.RawMessage `json:"query"`
+		Url   json.RawMessage `json:"url"`
+	}{}
+	_ = json.Unmarshal(jsonPayload, payload)
+
+	for _, values := range []json.RawMessage{payload.Query, payload.Url} {
+		var list []json.RawMessage
+		if json.Unmarshal(values, &list) == nil && len(list) > 0 {
+			return len(list)
+		}
+	}
+
+	return 1
+}
@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+// limiterOf returns the limiter the client submits with.
+func limiterOf(t *testing.T, c *Client) *limiter {
+	username, err := c.username(context.Background())
+	assert.NoError(t, err)
+
+	return c.rateLimit.limiterOf(username)
+}
+
+func TestWithRateLimitSharedByUsername(t *testing.T) {
+	limit := oxylabs.RateLimit{RequestsPerSecond: 10, Burst: 5}
+
+	first := NewClient("", "limited-user", "pass", WithRateLimit(limit))
+	second := NewClient("", "limited-user", "other", WithRateLimit(limit))
+	other := NewClient("", "other-user", "pass", WithRateLimit(limit))
+	defer first.Shutdown(context.Background())
+	defer second.Shutdown(context.Background())
+	defer other.Shutdown(context.Background())
+
+	assert.Same(t, limiterOf(t, first), limiterOf(t, second))
+	assert.NotSame(t, limiterOf(t, first), limiterOf(t, other))
+	assert.Same(t, limiterOf(t, first), limiterOf(t, first.With()))
+}
+
+func TestWithRateLimitWithoutUsername(t *testing.T) {
+	limit := oxylabs.RateLimit{RequestsPerSecond: 10, Burst: 5}
+
+	first := NewClient("", "", "pass", WithRateLimit(limit))
+	second := NewClient("", "", "pass", WithRateLimit(limit))
+
+	assert.NotSame(t, limiterOf(t, first), limiterOf(t, second))
+}
+
+func TestWithRateLimitProviderCredentials(t *testing.T) {
+	limit := oxylabs.RateLimit{RequestsPerSecond: 10, Burst: 5}
+	username := "provided-user"
+	provider := func(context.Context) (string, string, error) { return username, "pass", nil }
+
+	static := NewClient("", "provided-user", "pass", WithRateLimit(limit))
+	provided := NewClient("", "", "", WithCredentialsProvider(provider), WithRateLimit(limit))
+	defer static.Shutdown(context.Background())
+	defer provided.Shutdown(context.Background())
+	assert.Same(t, limiterOf(t, static), limiterOf(t, provided))
+
+	// Rotated credentials move the client to the limiter of the new username.
+	username = "rotated-user"
+	provided.invalidateCredentials(provided.credentials.generation)
+	assert.NotSame(t, limiterOf(t, static), limiterOf(t, provided))
+	assert.Len(t, limiterOf(t, static).limits, 1)
+}
+
+func TestSharedLimiterLimit(t *testing.T) {
+	strict := NewClient("", "shared-limit-user", "pass", WithRateLimit(oxylabs.RateLimit{RequestsPerSecond: 2, Burst: 1}))
+	loose := NewClient("", "shared-limit-user", "pass", WithRateLimit(oxylabs.RateLimit{RequestsPerSecond: 10, Burst: 5}))
+
+	l := limiterOf(t, loose)
+	assert.Equal(t, oxylabs.RateLimit{RequestsPerSecond: 10, Burst: 5}, l.limit)
+	assert.Same(t, l, limiterOf(t, strict))
+	assert.Equal(t, oxylabs.RateLimit{RequestsPerSecond: 2, Burst: 1}, l.limit)
+
+	// The limit is restored once the strict client is shut down.
+	assert.NoError(t, strict.Shutdown(context.Background()))
+	assert.Equal(t, oxylabs.RateLimit{RequestsPerSecond: 10, Burst: 5}, l.limit)
+
+	// The limiter is dropped once no client shares it.
+	assert.NoError(t, loose.Shutdown(context.Background()))
+	limiters.mu.Lock()
+	_, ok := limiters.byUsername["shared-limit-user"]
+	limiters.mu.Unlock()
+	assert.False(t, ok)
+}
+
+func TestPaceRefundsOnCancel(t *testing.T) {
+	c := NewClient("", "refund-user", "pass", WithRateLimit(oxylabs.RateLimit{RequestsPerSecond: 1, Burst: 1}))
+	defer c.Shutdown(context.Background())
+	payload := []byte(`{"query":"adidas"}`)
+
+	assert.NoError(t, c.pace(context.Background(), payload))
+
+	// A canceled wait gives its token back.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.pace(ctx, payload), oxylabs.ErrTimeout)
+
+	l := limiterOf(t, c)
+	l.mu.Lock()
+	assert.Greater(t, l.tokens, -0.5)
+	l.mu.Unlock()
+}
+
+func TestLimiterReserve(t *testing.T) {
+	l := &limiter{limit: oxylabs.RateLimit{RequestsPerSecond: 2, Burst: 2}, tokens: 2}
+	now := time.Unix(0, 0)
+
+	assert.Equal(t, time.Duration(0), l.reserve(now, 1))
+	assert.Equal(t, time.Duration(0), l.reserve(now, 1))
+	assert.Equal(t, 500*time.Millisecond, l.reserve(now, 1))
+
+	// The bucket refills at the rate, up to the burst.
+	assert.Equal(t, time.Duration(0), l.reserve(now.Add(10*time.Second), 2))
+}
+
+func TestPayloadJobs(t *testing.T) {
+	assert.Equal(t, 1, payloadJobs([]byte(`{"query":"adidas"}`)))
+	assert.Equal(t, 3, payloadJobs([]byte(`{"query":["a","b","c"]}`)))
+	assert.Equal(t, 2, payloadJobs([]byte(`{"url":["https://a","https://b"]}`)))
+}
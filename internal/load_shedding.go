@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// DefaultLoadSheddingSamples is the number of latencies of a source recorded
+// before reqs of the source are shed.
+const DefaultLoadSheddingSamples = 3
+
+// loadShedding configures the shedding of reqs whose deadline is too short.
+type loadShedding struct {
+	enabled    bool
+	minSamples int
+}
+
+// WithLoadShedding fails reqs with oxylabs.ErrDeadlineTooShort instead of submitting
+// them when the deadline of their ctx is closer than the expected latency of their
+// source, estimated from the latencies recorded by the client for at least
+// minSamples reqs of the source. Sync reqs and polled async jobs are estimated
+// from their own latencies. Async jobs with a callback_url, e.g. fire and forget
+// reqs, are never shed since their completion doesn't depend on the ctx.
+// Zero minSamples uses DefaultLoadSheddingSamples.
+func WithLoadShedding(minSamples int) ClientOption {
+	return func(c *Client) {
+		if minSamples <= 0 {
+			minSamples = DefaultLoadSheddingSamples
+		}
+		c.loadShedding = loadShedding{enabled: true, minSamples: minSamples}
+	}
+}
+
+// shedLoad returns oxylabs.ErrDeadlineTooShort if load shedding is enabled and
+// the deadline of ctx is closer than the expected latency of the sync req of
+// the source, or of its async job if async is true.
+func (c *Client) shedLoad(ctx context.Context, source oxylabs.Source, async bool) error {
+	if !c.loadShedding.enabled {
+		return nil
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+	latency, ok := c.stats.expectedLatency(latencyKey{source: source, async: async}, c.loadShedding.minSamples)
+	if !ok {
+		return nil
+	}
+
+	if remaining := time.Until(deadline); remaining < latency {
+		return fmt.Errorf(
+			"%w: %v left, %s reqs take %v",
+			oxylabs.ErrDeadlineTooShort,
+			remaining.Round(time.Millisecond),
+			source,
+			latency.Round(time.Millisecond),
+		)
+	}
+
+	return nil
+}
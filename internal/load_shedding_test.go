@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoadShedding(t *testing.T) {
+	sent := 0
+	c := NewClient(SyncBaseUrl, "user", "pass", WithLoadShedding(2))
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}
+	})}
+	payload := []byte(`{"source":"google_search"}`)
+
+	tests := []struct {
+		name     string
+		samples  int
+		deadline time.Duration
+		wantErr  bool
+	}{
+		{name: "no deadline", samples: 2},
+		{name: "too few samples", samples: 1, deadline: time.Second},
+		{name: "deadline long enough", samples: 2, deadline: time.Minute},
+		{name: "deadline too short", samples: 2, deadline: time.Second, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.stats = stats{}
+			for i := 0; i < tt.samples; i++ {
+				c.RecordLatency(oxylabs.GoogleSearch, 10*time.Second)
+			}
+
+			ctx := context.Background()
+			if tt.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.deadline)
+				defer cancel()
+			}
+
+			sent = 0
+			_, err := c.Req(ctx, payload, "POST")
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, oxylabs.ErrDeadlineTooShort))
+				assert.Equal(t, 0, sent, "req is not sent")
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, sent)
+			}
+		})
+	}
+}
+
+func TestRecordLatency(t *testing.T) {
+	c := NewClient(SyncBaseUrl, "user", "pass")
+	c.RecordLatency(oxylabs.GoogleSearch, 10*time.Second)
+	c.RecordLatency(oxylabs.GoogleSearch, 20*time.Second)
+
+	assert.Equal(t, 12*time.Second, c.Stats()[oxylabs.GoogleSearch].Latency)
+
+	c.RecordAsyncLatency(oxylabs.GoogleSearch, time.Minute)
+	assert.Equal(t, 12*time.Second, c.Stats()[oxylabs.GoogleSearch].Latency)
+	assert.Equal(t, time.Minute, c.Stats()[oxylabs.GoogleSearch].AsyncLatency)
+}
+
+func TestLoadSheddingAsync(t *testing.T) {
+	sent := 0
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithLoadShedding(1))
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		sent++
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"id":"job1"}`))}
+	})}
+
+	tests := []struct {
+		name         string
+		payload      string
+		syncLatency  time.Duration
+		asyncLatency time.Duration
+		wantErr      bool
+	}{
+		{name: "sync latency only", payload: `{"source":"google_search"}`, syncLatency: time.Minute},
+		{name: "polled job", payload: `{"source":"google_search"}`, asyncLatency: time.Minute, wantErr: true},
+		{
+			name:         "callback job",
+			payload:      `{"source":"google_search","callback_url":"https://example.com/hook"}`,
+			asyncLatency: time.Minute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c.stats = stats{}
+			if tt.syncLatency > 0 {
+				c.RecordLatency(oxylabs.GoogleSearch, tt.syncLatency)
+			}
+			if tt.asyncLatency > 0 {
+				c.RecordAsyncLatency(oxylabs.GoogleSearch, tt.asyncLatency)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+
+			sent = 0
+			_, err := c.GetJobID(ctx, []byte(tt.payload))
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, oxylabs.ErrDeadlineTooShort))
+				assert.Equal(t, 0, sent, "job is not submitted")
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, 1, sent)
+			}
+		})
+	}
+}
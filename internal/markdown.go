@@ -0,0 +1,230 @@
+package internal
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// Markdown converts html content to markdown, without boilerplate
+// like scripts, styles and navigation.
+func Markdown(content string) (string, error) {
+	doc, err := html.Parse(strings.NewReader(content))
+	if err != nil {
+		return "", fmt.Errorf("error parsing html: %v", err)
+	}
+
+	var b strings.Builder
+	renderMarkdown(&b, doc)
+
+	return cleanMarkdown(b.String()), nil
+}
+
+// renderMarkdown writes the markdown of the node and its children to b.
+func renderMarkdown(b *strings.Builder, n *html.Node) {
+	switch n.Type {
+	case html.TextNode:
+		// Drop the leading space of text starting a line, so that the
+		// indentation of lines is that of nested blocks only.
+		text := collapseSpace(n.Data)
+		if s := b.String(); s == "" || strings.HasSuffix(s, "\n") {
+			text = strings.TrimLeft(text, " ")
+		}
+		b.WriteString(text)
+		return
+	case html.CommentNode:
+		return
+	case html.ElementNode:
+		if boilerplate[n.DataAtom] {
+			return
+		}
+	}
+
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		level, _ := strconv.Atoi(n.Data[1:])
+		b.WriteString("\n\n" + strings.Repeat("#", level) + " ")
+		b.WriteString(strings.TrimSpace(inlineMarkdown(n)))
+		b.WriteString("\n\n")
+	case atom.P, atom.Div, atom.Section, atom.Article, atom.Main:
+		b.WriteString("\n\n")
+		renderChildren(b, n)
+		b.WriteString("\n\n")
+	case atom.Br:
+		b.WriteString("\n")
+	case atom.Hr:
+		b.WriteString("\n\n---\n\n")
+	case atom.A:
+		text := strings.TrimSpace(inlineMarkdown(n))
+		if href := attr(n, "href"); href != "" && text != "" {
+			b.WriteString("[" + text + "](" + href + ")")
+		} else {
+			b.WriteString(text)
+		}
+	case atom.Img:
+		if src := attr(n, "src"); src != "" {
+			b.WriteString("![" + attr(n, "alt") + "](" + src + ")")
+		}
+	case atom.Strong, atom.B:
+		wrapMarkdown(b, n, "**")
+	case atom.Em, atom.I:
+		wrapMarkdown(b, n, "*")
+	case atom.Code:
+		wrapMarkdown(b, n, "`")
+	case atom.Pre:
+		b.WriteString("\n\n```\n" + strings.Trim(textContent(n), "\n") + "\n```\n\n")
+	case atom.Ul, atom.Ol:
+		b.WriteString("\n\n")
+		i := 0
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom != atom.Li {
+				continue
+			}
+			i++
+			marker := "- "
+			if n.DataAtom == atom.Ol {
+				marker = strconv.Itoa(i) + ". "
+			}
+			item := strings.ReplaceAll(blockMarkdown(child), "\n", "\n   ")
+			b.WriteString(marker + item + "\n")
+		}
+		b.WriteString("\n")
+	case atom.Blockquote:
+		quote := strings.ReplaceAll(blockMarkdown(n), "\n", "\n> ")
+		b.WriteString("\n\n> " + quote + "\n\n")
+	case atom.Table:
+		b.WriteString("\n\n")
+		renderTable(b, n)
+		b.WriteString("\n")
+	default:
+		renderChildren(b, n)
+	}
+}
+
+// renderChildren writes the markdown of the children of the node to b.
+func renderChildren(b *strings.Builder, n *html.Node) {
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		renderMarkdown(b, child)
+	}
+}
+
+// renderTable writes the rows of the table to b, the first row being the header.
+func renderTable(b *strings.Builder, n *html.Node) {
+	var rows []*html.Node
+	var collect func(n *html.Node)
+	collect = func(n *html.Node) {
+		for child := n.FirstChild; child != nil; child = child.NextSibling {
+			if child.DataAtom == atom.Tr {
+				rows = append(rows, child)
+			} else {
+				collect(child)
+			}
+		}
+	}
+	collect(n)
+
+	for i, row := range rows {
+		var cells []string
+		for cell := row.FirstChild; cell != nil; cell = cell.NextSibling {
+			if cell.DataAtom == atom.Td || cell.DataAtom == atom.Th {
+				cells = append(cells, strings.TrimSpace(inlineMarkdown(cell)))
+			}
+		}
+		b.WriteString("| " + strings.Join(cells, " | ") + " |\n")
+		if i == 0 {
+			b.WriteString(strings.Repeat("| --- ", len(cells)) + "|\n")
+		}
+	}
+}
+
+// wrapMarkdown writes the inline markdown of the node to b between the marker.
+func wrapMarkdown(b *strings.Builder, n *html.Node, marker string) {
+	if text := strings.TrimSpace(inlineMarkdown(n)); text != "" {
+		b.WriteString(marker + text + marker)
+	}
+}
+
+// inlineMarkdown returns the markdown of the children of the node on a single line.
+func inlineMarkdown(n *html.Node) string {
+	var b strings.Builder
+	renderChildren(&b, n)
+	return collapseSpace(b.String())
+}
+
+// blockMarkdown returns the cleaned markdown of the children of the node.
+func blockMarkdown(n *html.Node) string {
+	var b strings.Builder
+	renderChildren(&b, n)
+	return cleanMarkdown(b.String())
+}
+
+// textContent returns the text of the node and its children as is.
+func textContent(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+
+	var b strings.Builder
+	for child := n.FirstChild; child != nil; child = child.NextSibling {
+		b.WriteString(textContent(child))
+	}
+	return b.String()
+}
+
+// attr returns the value of the attribute of the node.
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+// collapseSpace replaces runs of whitespace with a single space.
+func collapseSpace(s string) string {
+	var b strings.Builder
+	space := false
+	for _, r := range s {
+		if r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '\f' {
+			if !space {
+				b.WriteByte(' ')
+			}
+			space = true
+			continue
+		}
+		b.WriteRune(r)
+		space = false
+	}
+	return b.String()
+}
+
+// cleanMarkdown trims the trailing whitespace of the lines of the markdown
+// outside code blocks, keeping the indentation of nested lists, and collapses
+// runs of empty lines.
+func cleanMarkdown(md string) string {
+	var lines []string
+	fenced, empty := false, true
+	for _, line := range strings.Split(md, "\n") {
+		if strings.TrimSpace(line) == "```" {
+			fenced = !fenced
+		}
+		if !fenced {
+			line = strings.TrimRight(line, " \t")
+			if line == "" {
+				if !empty {
+					lines = append(lines, "")
+				}
+				empty = true
+				continue
+			}
+		}
+		lines = append(lines, line)
+		empty = false
+	}
+
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
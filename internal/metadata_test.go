@@ -0,0 +1,35 @@
+package internal
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequestMetadata(t *testing.T) {
+	var records []oxylabs.AuditRecord
+	var transportMetadata map[string]string
+	jobs := fakeJobs(0)
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithAuditFunc(func(record oxylabs.AuditRecord) {
+		records = append(records, record)
+	}))
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		transportMetadata = oxylabs.RequestMetadata(req.Context())
+		resp, _ := jobs.RoundTrip(req)
+		return resp
+	})}
+
+	ctx := oxylabs.WithRequestMetadata(context.Background(), map[string]string{"tenant": "acme"})
+	ctx = oxylabs.WithRequestMetadata(ctx, map[string]string{"campaign": "spring"})
+	_, err := c.GetJobID(ctx, []byte(`{"source":"google_search"}`))
+	assert.NoError(t, err)
+
+	want := map[string]string{"tenant": "acme", "campaign": "spring"}
+	assert.Len(t, records, 1)
+	assert.Equal(t, want, records[0].Metadata)
+	assert.Equal(t, want, transportMetadata)
+	assert.Nil(t, oxylabs.RequestMetadata(context.Background()))
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"net/http"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// ClientOption configures the client.
+type ClientOption func(*Client)
+
+// NewClient returns a client for the given base url and credentials
+// configured with the provided options.
+func NewClient(
+	baseUrl string,
+	username string,
+	password string,
+	opts ...ClientOption,
+) *Client {
+	c := &Client{
+		BaseUrl: baseUrl,
+		ApiCredentials: &ApiCredentials{
+			Username: username,
+			Password: password,
+		},
+		HttpClient: &http.Client{},
+	}
+
+	for _, opt := range opts {
+		if opt != nil {
+			opt(c)
+		}
+	}
+
+	return c
+}
+
+// WithHttpClient sends the reqs of the client with httpClient instead of a
+// default http client, e.g. to set a custom transport, proxy or timeouts.
+func WithHttpClient(httpClient *http.Client) ClientOption {
+	return func(c *Client) {
+		if httpClient != nil {
+			c.HttpClient = httpClient
+		}
+	}
+}
+
+// WithFireAndForget makes async scrapes with a callback_url return as soon as
+// the job is submitted, with the job ID only, instead of polling until completion.
+func WithFireAndForget() ClientOption {
+	return func(c *Client) {
+		c.fireAndForget = true
+	}
+}
+
+// FireAndForget returns whether the async req should return once submitted.
+func (c *Client) FireAndForget(req *oxylabs.Request) bool {
+	return req.CallbackUrl() != "" && (req.FireAndForget || c.fireAndForget)
+}
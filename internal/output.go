@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WithOutput sets the parse, render and markdown parameters of the req per the
+// output format, if set, returning a validation error if the format is not
+// supported by the source or conflicts with the parse or render parameters.
+func WithOutput(req *oxylabs.Request, output oxylabs.Output) (*oxylabs.Request, error) {
+	if output == "" {
+		return req, nil
+	}
+	if err := oxylabs.ValidateOutput(req.Source, output); err != nil {
+		return nil, err
+	}
+
+	parse := req.Parse() || req.CustomParser()
+	render, _ := req.Params["render"].(oxylabs.Render)
+
+	var errs []error
+	switch output {
+	case oxylabs.OutputRaw:
+		if parse {
+			errs = append(errs, fmt.Errorf("parse cannot be used with the %s output", output))
+		}
+	case oxylabs.OutputParsed:
+		if render == oxylabs.PNG {
+			errs = append(errs, fmt.Errorf("render %s cannot be used with the %s output", render, output))
+		}
+		req.Params["parse"] = true
+	case oxylabs.OutputScreenshot:
+		if parse {
+			errs = append(errs, fmt.Errorf("parse cannot be used with the %s output", output))
+		}
+		if render != "" && render != oxylabs.PNG {
+			errs = append(errs, fmt.Errorf("render %s cannot be used with the %s output", render, output))
+		}
+		req.Params["render"] = oxylabs.PNG
+	case oxylabs.OutputMarkdown:
+		if parse {
+			errs = append(errs, fmt.Errorf("parse cannot be used with the %s output", output))
+		}
+		if render == oxylabs.PNG {
+			errs = append(errs, fmt.Errorf("render %s cannot be used with the %s output", render, output))
+		}
+		req.Params["markdown"] = true
+	}
+	if len(errs) > 0 {
+		return nil, oxylabs.NewValidationError(errs)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,87 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithOutput(t *testing.T) {
+	tests := []struct {
+		name       string
+		source     oxylabs.Source
+		params     map[string]interface{}
+		output     oxylabs.Output
+		wantParams map[string]interface{}
+		wantErr    bool
+	}{
+		{
+			name:       "unset",
+			source:     oxylabs.GoogleSearch,
+			params:     map[string]interface{}{"parse": true},
+			wantParams: map[string]interface{}{"parse": true},
+		},
+		{
+			name:       "parsed",
+			source:     oxylabs.GoogleSearch,
+			params:     map[string]interface{}{"parse": false, "render": oxylabs.HTML},
+			output:     oxylabs.OutputParsed,
+			wantParams: map[string]interface{}{"parse": true, "render": oxylabs.HTML},
+		},
+		{
+			name:       "screenshot",
+			source:     oxylabs.AmazonProduct,
+			params:     map[string]interface{}{"parse": false, "render": oxylabs.Render("")},
+			output:     oxylabs.OutputScreenshot,
+			wantParams: map[string]interface{}{"parse": false, "render": oxylabs.PNG},
+		},
+		{
+			name:       "markdown",
+			source:     oxylabs.Universal,
+			params:     map[string]interface{}{"parse": false},
+			output:     oxylabs.OutputMarkdown,
+			wantParams: map[string]interface{}{"parse": false, "markdown": true},
+		},
+		{
+			name:    "unsupported by the source",
+			source:  oxylabs.GoogleSuggestions,
+			params:  map[string]interface{}{},
+			output:  oxylabs.OutputParsed,
+			wantErr: true,
+		},
+		{
+			name:    "conflicting parse",
+			source:  oxylabs.GoogleUrl,
+			params:  map[string]interface{}{"parse": true},
+			output:  oxylabs.OutputRaw,
+			wantErr: true,
+		},
+		{
+			name:    "conflicting render",
+			source:  oxylabs.GoogleUrl,
+			params:  map[string]interface{}{"render": oxylabs.HTML},
+			output:  oxylabs.OutputScreenshot,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			source:  oxylabs.GoogleUrl,
+			params:  map[string]interface{}{},
+			output:  oxylabs.Output("pdf"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := WithOutput(&oxylabs.Request{Source: tt.source, Params: tt.params}, tt.output)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantParams, req.Params)
+		})
+	}
+}
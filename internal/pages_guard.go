@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WithPagesGuard guards the sync reqs of the client per the guard.
+func WithPagesGuard(guard oxylabs.PagesGuard) ClientOption {
+	return func(c *Client) {
+		c.pagesGuard = guard
+	}
+}
+
+// GuardPages reports whether the sync req is to be split into single page
+// async jobs per the pages guard of the client. Guarded reqs not split are logged.
+func (c *Client) GuardPages(req *oxylabs.Request) bool {
+	guard := c.pagesGuard
+	if guard.MaxUnits <= 0 {
+		return false
+	}
+
+	pricing := oxylabs.DefaultPricing
+	if guard.Pricing != nil {
+		pricing = *guard.Pricing
+	}
+	estimate := pricing.Estimate(req)
+	if estimate.Units <= guard.MaxUnits {
+		return false
+	}
+	if guard.Split && req.Query != "" && estimate.Pages > 1 {
+		return true
+	}
+
+	logger := guard.Logger
+	if logger == nil {
+		logger = log.Default()
+	}
+	logger.Printf(
+		"oxylabs: sync req of %s with %d pages (render: %t) is estimated at %.0f units, over the %.0f units guarded; consider an async client",
+		estimate.Source, estimate.Pages, estimate.Render, estimate.Units, guard.MaxUnits,
+	)
+
+	return false
+}
+
+// SplitPages submits an async job per page of the query based req and
+// retrieves their http resps, in page order. Faulted jobs are resubmitted per
+// the resubmit policy of the client, with payloads built by marshal.
+func (c *Client) SplitPages(
+	ctx context.Context,
+	req *oxylabs.Request,
+	marshal func(*oxylabs.Request) ([]byte, error),
+) ([]*http.Response, error) {
+	startPage, _ := req.Params["start_page"].(int)
+	if startPage < 1 {
+		startPage = DefaultStartPage
+	}
+	pages := oxylabs.EstimateCost(req).Pages
+
+	httpResps := make([]*http.Response, pages)
+	errs := make([]error, pages)
+	var wg sync.WaitGroup
+	for i := 0; i < pages; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+
+			page := startPage + i
+			httpResp, err := c.awaitPage(ctx, req, page, marshal)
+			if err != nil {
+				errs[i] = fmt.Errorf("error scraping page %d: %w", page, err)
+				return
+			}
+			httpResps[i] = httpResp
+		}(i)
+	}
+	wg.Wait()
+
+	if err := errors.Join(errs...); err != nil {
+		for _, httpResp := range httpResps {
+			if httpResp != nil {
+				httpResp.Body.Close()
+			}
+		}
+		return nil, err
+	}
+
+	return httpResps, nil
+}
+
+// jobsUrl returns the url async jobs are submitted to,
+// the jobs of sync clients being submitted to AsyncBaseUrl.
+func (c *Client) jobsUrl() string {
+	if c.BaseUrl == SyncBaseUrl {
+		return AsyncBaseUrl
+	}
+
+	return c.BaseUrl
+}
+
+// awaitPage submits the async job of the page of req and retrieves its http resp.
+func (c *Client) awaitPage(
+	ctx context.Context,
+	req *oxylabs.Request,
+	page int,
+	marshal func(*oxylabs.Request) ([]byte, error),
+) (*http.Response, error) {
+	pageReq, ok := PageRequest(req, page)
+	if !ok {
+		return nil, fmt.Errorf("url based requests cannot be split into pages")
+	}
+	if pageReq.IdempotencyKey != "" {
+		pageReq.IdempotencyKey = fmt.Sprintf("%s#page-%d", pageReq.IdempotencyKey, page)
+	}
+
+	jsonPayload, err := marshal(pageReq)
+	if err != nil {
+		return nil, err
+	}
+	jobID, err := c.SubmitJob(ctx, pageReq, jsonPayload)
+	if err != nil {
+		return nil, err
+	}
+	httpResp, _, err := c.AwaitJobResubmitting(ctx, pageReq, jobID, marshal)
+
+	return httpResp, err
+}
@@ -0,0 +1,98 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuardPages(t *testing.T) {
+	var logged bytes.Buffer
+	logger := log.New(&logged, "", 0)
+
+	tests := []struct {
+		name      string
+		guard     oxylabs.PagesGuard
+		req       *oxylabs.Request
+		wantSplit bool
+		wantLog   bool
+	}{
+		{
+			name:  "no guard",
+			guard: oxylabs.PagesGuard{},
+			req:   &oxylabs.Request{Source: oxylabs.GoogleSearch, Query: "q", Params: map[string]interface{}{"pages": 100, "render": oxylabs.HTML}},
+		},
+		{
+			name:  "under the guard",
+			guard: oxylabs.PagesGuard{MaxUnits: 10, Split: true, Logger: logger},
+			req:   &oxylabs.Request{Source: oxylabs.GoogleSearch, Query: "q", Params: map[string]interface{}{"pages": 5}},
+		},
+		{
+			name:    "logged",
+			guard:   oxylabs.PagesGuard{MaxUnits: 10, Logger: logger},
+			req:     &oxylabs.Request{Source: oxylabs.GoogleSearch, Query: "q", Params: map[string]interface{}{"pages": 5, "render": oxylabs.HTML}},
+			wantLog: true,
+		},
+		{
+			name:      "split",
+			guard:     oxylabs.PagesGuard{MaxUnits: 10, Split: true, Logger: logger},
+			req:       &oxylabs.Request{Source: oxylabs.GoogleSearch, Query: "q", Params: map[string]interface{}{"pages": 5, "render": oxylabs.HTML}},
+			wantSplit: true,
+		},
+		{
+			name:    "url based reqs are not split",
+			guard:   oxylabs.PagesGuard{MaxUnits: 1, Split: true, Logger: logger},
+			req:     &oxylabs.Request{Source: oxylabs.GoogleUrl, Url: "https://www.google.com/search?q=q", Params: map[string]interface{}{"render": oxylabs.HTML}},
+			wantLog: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logged.Reset()
+			c := NewClient(SyncBaseUrl, "user", "pass", WithPagesGuard(tt.guard))
+
+			assert.Equal(t, tt.wantSplit, c.GuardPages(tt.req))
+			assert.Equal(t, tt.wantLog, logged.Len() > 0)
+		})
+	}
+}
+
+func TestSplitPages(t *testing.T) {
+	var mu sync.Mutex
+	var submitted []string
+	jobs := fakeJobs(0)
+	c := NewClient(SyncBaseUrl, "user", "pass")
+	c.HttpClient = &http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		if req.Method == "POST" {
+			mu.Lock()
+			submitted = append(submitted, req.URL.String())
+			mu.Unlock()
+		}
+		resp, _ := jobs.RoundTrip(req)
+		return resp
+	})}
+
+	req := &oxylabs.Request{
+		Source:       oxylabs.GoogleSearch,
+		Query:        "q",
+		Params:       map[string]interface{}{"start_page": 2, "pages": 3},
+		PollInterval: 1,
+	}
+	marshal := func(req *oxylabs.Request) ([]byte, error) {
+		assert.Equal(t, 1, req.Params["pages"])
+		return []byte(`{"source":"google_search"}`), nil
+	}
+
+	httpResps, err := c.SplitPages(context.Background(), req, marshal)
+	assert.NoError(t, err)
+	assert.Len(t, httpResps, 3)
+	assert.Equal(t, []string{AsyncBaseUrl, AsyncBaseUrl, AsyncBaseUrl}, submitted)
+	assert.Equal(t, 3, req.Params["pages"], "original request is left untouched")
+}
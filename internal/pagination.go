@@ -0,0 +1,94 @@
+package internal
+
+import (
+	"encoding/json"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// NextPageHint extracts the next page hint from the parsed content of a page.
+func NextPageHint(content []byte) (oxylabs.PageHint, bool) {
+	var raw map[string]interface{}
+	if err := json.Unmarshal(content, &raw); err != nil {
+		return oxylabs.PageHint{}, false
+	}
+
+	hint := oxylabs.PageHint{}
+	pagination, _ := raw["pagination"].(map[string]interface{})
+
+	// Next page url.
+	for _, fields := range []map[string]interface{}{raw, pagination} {
+		for _, key := range []string{"next_page_url", "next_page", "next"} {
+			if url, ok := fields[key].(string); ok && url != "" && hint.Url == "" {
+				hint.Url = url
+			}
+		}
+	}
+
+	// Next page number.
+	if next, ok := pagination["next_page"].(float64); ok {
+		hint.Page = int(next)
+	} else if next, ok := raw["next_page"].(float64); ok {
+		hint.Page = int(next)
+	} else {
+		page, _ := raw["page"].(float64)
+		if current, ok := pagination["current_page"].(float64); ok {
+			page = current
+		}
+
+		last, _ := raw["last_visible_page"].(float64)
+		if total, ok := pagination["total_pages"].(float64); ok {
+			last = total
+		}
+
+		if page > 0 && page < last {
+			hint.Page = int(page) + 1
+		}
+	}
+
+	return hint, hint.Url != "" || hint.Page > 0
+}
+
+// NextPageRequest returns a copy of req for the next page the hint points to.
+// Url based requests follow the next page url, query based requests the next page number.
+func NextPageRequest(req *oxylabs.Request, hint oxylabs.PageHint) (*oxylabs.Request, bool) {
+	switch {
+	case req.Url != "" && hint.Url != "":
+		next := copyRequest(req)
+		next.Url = hint.Url
+		return next, true
+	case req.Query != "" && hint.Page > 0:
+		return pageRequest(req, hint.Page), true
+	}
+
+	return nil, false
+}
+
+// PageRequest returns a copy of the query based req scraping the single page.
+func PageRequest(req *oxylabs.Request, page int) (*oxylabs.Request, bool) {
+	if req.Url != "" || req.Query == "" || page < 1 {
+		return nil, false
+	}
+
+	return pageRequest(req, page), true
+}
+
+// pageRequest returns a copy of req scraping the single page.
+func pageRequest(req *oxylabs.Request, page int) *oxylabs.Request {
+	next := copyRequest(req)
+	next.Params["start_page"] = page
+	next.Params["pages"] = 1
+
+	return next
+}
+
+// copyRequest returns a copy of req with its own params.
+func copyRequest(req *oxylabs.Request) *oxylabs.Request {
+	next := *req
+	next.Params = make(map[string]interface{}, len(req.Params)+2)
+	for k, v := range req.Params {
+		next.Params[k] = v
+	}
+
+	return &next
+}
@@ -0,0 +1,190 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextPageHint(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    oxylabs.PageHint
+		wantOk  bool
+	}{
+		{
+			name:    "next page url",
+			content: `{"next_page_url": "https://example.com/?page=2"}`,
+			want:    oxylabs.PageHint{Url: "https://example.com/?page=2"},
+			wantOk:  true,
+		},
+		{
+			name:    "next page url string",
+			content: `{"next_page": "https://example.com/?page=2"}`,
+			want:    oxylabs.PageHint{Url: "https://example.com/?page=2"},
+			wantOk:  true,
+		},
+		{
+			name:    "next",
+			content: `{"next": "https://example.com/?page=2"}`,
+			want:    oxylabs.PageHint{Url: "https://example.com/?page=2"},
+			wantOk:  true,
+		},
+		{
+			name:    "pagination next page url",
+			content: `{"pagination": {"next_page_url": "https://example.com/?page=2"}}`,
+			want:    oxylabs.PageHint{Url: "https://example.com/?page=2"},
+			wantOk:  true,
+		},
+		{
+			name:    "top level url first",
+			content: `{"next_page_url": "https://example.com/a", "pagination": {"next": "https://example.com/b"}}`,
+			want:    oxylabs.PageHint{Url: "https://example.com/a"},
+			wantOk:  true,
+		},
+		{
+			name:    "next page number",
+			content: `{"next_page": 3}`,
+			want:    oxylabs.PageHint{Page: 3},
+			wantOk:  true,
+		},
+		{
+			name:    "pagination next page number",
+			content: `{"next_page": 5, "pagination": {"next_page": 3}}`,
+			want:    oxylabs.PageHint{Page: 3},
+			wantOk:  true,
+		},
+		{
+			name:    "page and last visible page",
+			content: `{"page": 2, "last_visible_page": 10}`,
+			want:    oxylabs.PageHint{Page: 3},
+			wantOk:  true,
+		},
+		{
+			name:    "current page and total pages",
+			content: `{"pagination": {"current_page": 2, "total_pages": 10}}`,
+			want:    oxylabs.PageHint{Page: 3},
+			wantOk:  true,
+		},
+		{
+			name:    "last page",
+			content: `{"page": 10, "last_visible_page": 10}`,
+		},
+		{
+			name:    "pagination last page",
+			content: `{"pagination": {"current_page": 10, "total_pages": 10}}`,
+		},
+		{
+			name:    "empty next page url",
+			content: `{"next_page_url": ""}`,
+		},
+		{
+			name:    "no hint",
+			content: `{"results": {}}`,
+		},
+		{
+			name:    "html",
+			content: `<html></html>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hint, ok := NextPageHint([]byte(tt.content))
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, hint)
+		})
+	}
+}
+
+func TestNextPageRequest(t *testing.T) {
+	queryReq := &oxylabs.Request{Source: oxylabs.GoogleSearch, Query: "q", Params: map[string]interface{}{"pages": 3, "domain": "com"}}
+	urlReq := &oxylabs.Request{Source: oxylabs.Universal, Url: "https://example.com/?page=1", Params: map[string]interface{}{"render": oxylabs.HTML}}
+
+	tests := []struct {
+		name       string
+		req        *oxylabs.Request
+		hint       oxylabs.PageHint
+		wantOk     bool
+		wantUrl    string
+		wantParams map[string]interface{}
+	}{
+		{
+			name:       "query request next page",
+			req:        queryReq,
+			hint:       oxylabs.PageHint{Page: 2},
+			wantOk:     true,
+			wantParams: map[string]interface{}{"start_page": 2, "pages": 1, "domain": "com"},
+		},
+		{
+			name:       "query request ignores the url",
+			req:        queryReq,
+			hint:       oxylabs.PageHint{Url: "https://example.com/?page=2", Page: 2},
+			wantOk:     true,
+			wantParams: map[string]interface{}{"start_page": 2, "pages": 1, "domain": "com"},
+		},
+		{
+			name: "query request without page",
+			req:  queryReq,
+			hint: oxylabs.PageHint{Url: "https://example.com/?page=2"},
+		},
+		{
+			name:       "url request next url",
+			req:        urlReq,
+			hint:       oxylabs.PageHint{Url: "https://example.com/?page=2", Page: 2},
+			wantOk:     true,
+			wantUrl:    "https://example.com/?page=2",
+			wantParams: map[string]interface{}{"render": oxylabs.HTML},
+		},
+		{
+			name: "url request without url",
+			req:  urlReq,
+			hint: oxylabs.PageHint{Page: 2},
+		},
+		{
+			name: "last page",
+			req:  queryReq,
+			hint: oxylabs.PageHint{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next, ok := NextPageRequest(tt.req, tt.hint)
+			assert.Equal(t, tt.wantOk, ok)
+			if !ok {
+				assert.Nil(t, next)
+				return
+			}
+			assert.Equal(t, tt.req.Source, next.Source)
+			assert.Equal(t, tt.req.Query, next.Query)
+			if tt.wantUrl != "" {
+				assert.Equal(t, tt.wantUrl, next.Url)
+			}
+			assert.Equal(t, tt.wantParams, next.Params)
+		})
+	}
+
+	// The params of the req are not modified.
+	assert.Equal(t, map[string]interface{}{"pages": 3, "domain": "com"}, queryReq.Params)
+	assert.Equal(t, "https://example.com/?page=1", urlReq.Url)
+}
+
+func TestPageRequest(t *testing.T) {
+	req := &oxylabs.Request{Source: oxylabs.GoogleSearch, Query: "q", Params: map[string]interface{}{"pages": 3}}
+
+	_, ok := PageRequest(req, 0)
+	assert.False(t, ok)
+
+	page, ok := PageRequest(req, 1)
+	assert.True(t, ok)
+	assert.Equal(t, 1, page.Params["start_page"])
+	assert.Equal(t, 1, page.Params["pages"])
+	assert.Equal(t, 3, req.Params["pages"])
+
+	_, ok = PageRequest(&oxylabs.Request{Source: oxylabs.GoogleSearch, Url: "https://example.com", Params: map[string]interface{}{}}, 1)
+	assert.False(t, ok)
+
+}
@@ -0,0 +1,13 @@
+package internal
+
+import "github.com/revvim/oxylabs-sdk-go/oxylabs"
+
+// CheckQuery returns a warning of the resp if the query of the req is a url,
+// which is likely meant to be scraped with a url source instead.
+func (c *Client) CheckQuery(req *oxylabs.Request) []oxylabs.Warning {
+	if err := req.CheckQuery(); err != nil {
+		return []oxylabs.Warning{{Message: err.Error()}}
+	}
+
+	return nil
+}
@@ -3,10 +3,14 @@ package internal
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net"
 	"net/http"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 // Req to the API.
@@ -18,24 +22,67 @@ func (c *Client) Req(
 	jsonPayload []byte,
 	method string,
 ) (*http.Response, error) {
+	end, err := c.begin()
+	if err != nil {
+		return nil, err
+	}
+	defer end()
+
+	// Wait for a free slot of the client.
+	release, err := c.Acquire(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	// Prepare req.
+	reqUrl, err := callUrl(ctx, c.BaseUrl)
+	if err != nil {
+		return nil, err
+	}
 	req, err := NewRequestWithContext(
 		ctx,
 		method,
-		c.BaseUrl,
+		reqUrl,
 		bytes.NewBuffer(jsonPayload),
 	)
 	if err != nil {
 		return nil, err
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.SetBasicAuth(c.ApiCredentials.Username, c.ApiCredentials.Password)
+	c.setPayloadHash(req, jsonPayload)
 
 	// Get resp.
-	resp, err := c.HttpClient.Do(req)
-	if e, ok := err.(net.Error); ok && e.Timeout() {
-		return nil, fmt.Errorf("timeout error: %v", err)
+	source := payloadSource(jsonPayload)
+	if err = c.shedLoad(ctx, source, false); err != nil {
+		return nil, err
+	}
+	if err = c.pace(ctx, jsonPayload); err != nil {
+		return nil, err
+	}
+	c.audit(ctx, jsonPayload)
+	c.RecordRequest(source)
+	start := time.Now()
+	resp, err := c.doRetrying(req, source)
+	if e, ok := err.(net.Error); (ok && e.Timeout()) || errors.Is(err, context.DeadlineExceeded) {
+		c.RecordFault(source)
+		return nil, fmt.Errorf("%w: %v", oxylabs.ErrTimeout, err)
 	} else if err != nil {
+		c.RecordFault(source)
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		c.RecordFault(source)
+	} else {
+		c.RecordSuccess(source)
+		c.RecordLatency(source, time.Since(start))
+	}
+
+	if err = c.limitBody(resp); err != nil {
+		return nil, err
+	}
+	if err = c.archive(ctx, resp, oxylabs.ArchiveRecord{Source: source, PayloadHash: PayloadHash(jsonPayload)}); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WithResubmitPolicy resubmits the faulted jobs of async reqs per the policy.
+func WithResubmitPolicy(policy oxylabs.ResubmitPolicy) ClientOption {
+	return func(c *Client) {
+		c.resubmitPolicy = policy
+	}
+}
+
+// AwaitJobResubmitting polls the status of the submitted job of req and retrieves its http resp.
+// Faulted jobs are resubmitted per the resubmit policy of the client, with payloads
+// built by marshal. The attempts made are returned along with the resp or error.
+func (c *Client) AwaitJobResubmitting(
+	ctx context.Context,
+	req *oxylabs.Request,
+	jobID string,
+	marshal func(*oxylabs.Request) ([]byte, error),
+) (*http.Response, []oxylabs.Attempt, error) {
+	var attempts []oxylabs.Attempt
+	for attempt := 1; ; attempt++ {
+		httpRespChan := make(chan *http.Response)
+		errChan := make(chan error)
+		go c.PollJobStatus(ctx, jobID, req.PollInterval, httpRespChan, errChan)
+
+		// Handle error.
+		err := <-errChan
+		attempts = append(attempts, oxylabs.Attempt{JobID: jobID, Request: req, Err: err})
+		if err == nil {
+			return <-httpRespChan, attempts, nil
+		}
+		if attempt > c.resubmitPolicy.MaxResubmits || !errors.Is(err, oxylabs.ErrJobFaulted) {
+			return nil, attempts, resubmitError(attempts)
+		}
+
+		// Resubmit the job.
+		recordRetry(ctx, oxylabs.Retry{Kind: oxylabs.RetryResubmit, Attempt: attempt, Err: err})
+		req = req.Clone()
+		if c.resubmitPolicy.Mutate != nil {
+			c.resubmitPolicy.Mutate(req, attempt)
+		}
+		jsonPayload, err := marshal(req)
+		if err == nil {
+			jobID, err = c.GetJobID(ctx, jsonPayload)
+		}
+		if err == nil {
+			err = c.storeResubmittedJob(ctx, req, jobID)
+		}
+		if err != nil {
+			attempts = append(attempts, oxylabs.Attempt{Request: req, Err: err})
+			return nil, attempts, resubmitError(attempts)
+		}
+	}
+}
+
+// resubmitError returns the error of the last attempt,
+// wrapped with the attempt history if the job was resubmitted.
+func resubmitError(attempts []oxylabs.Attempt) error {
+	if len(attempts) == 1 {
+		return attempts[0].Err
+	}
+
+	return &oxylabs.ResubmitError{Attempts: attempts}
+}
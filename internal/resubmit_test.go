@@ -0,0 +1,96 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+// fakeJobs returns a transport of an API whose first faulted jobs fault,
+// job1 being already submitted.
+func fakeJobs(faulted int) http.RoundTripper {
+	var mu sync.Mutex
+	submitted := 1
+
+	return roundTripFunc(func(req *http.Request) *http.Response {
+		mu.Lock()
+		defer mu.Unlock()
+
+		body := ""
+		switch {
+		case req.Method == "POST":
+			submitted++
+			body = fmt.Sprintf(`{"id":"job%d"}`, submitted)
+		case strings.HasSuffix(req.URL.Path, "/results"):
+			body = `{"results":[]}`
+		default:
+			id := req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:]
+			status := "done"
+			if id <= fmt.Sprintf("job%d", faulted) {
+				status = "faulted"
+			}
+			body = fmt.Sprintf(`{"id":"%s","status":"%s"}`, id, status)
+		}
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})
+}
+
+func TestAwaitJobResubmitting(t *testing.T) {
+	marshal := func(req *oxylabs.Request) ([]byte, error) {
+		return []byte(`{"source":"google_search"}`), nil
+	}
+
+	tests := []struct {
+		name         string
+		faulted      int
+		maxResubmits int
+		wantAttempts int
+		wantErr      bool
+	}{
+		{name: "no fault", faulted: 0, maxResubmits: 2, wantAttempts: 1},
+		{name: "resubmitted", faulted: 2, maxResubmits: 2, wantAttempts: 3},
+		{name: "resubmits exhausted", faulted: 3, maxResubmits: 2, wantAttempts: 3, wantErr: true},
+		{name: "no policy", faulted: 1, maxResubmits: 0, wantAttempts: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mutated := 0
+			c := NewClient(AsyncBaseUrl, "user", "pass", WithResubmitPolicy(oxylabs.ResubmitPolicy{
+				MaxResubmits: tt.maxResubmits,
+				Mutate: func(req *oxylabs.Request, attempt int) {
+					mutated++
+					req.Params["render"] = oxylabs.HTML
+				},
+			}))
+			c.HttpClient = &http.Client{Transport: fakeJobs(tt.faulted)}
+
+			req := &oxylabs.Request{Source: oxylabs.GoogleSearch, Params: map[string]interface{}{}, PollInterval: 1}
+			_, attempts, err := c.AwaitJobResubmitting(context.Background(), req, "job1", marshal)
+
+			assert.Len(t, attempts, tt.wantAttempts)
+			assert.Equal(t, tt.wantAttempts-1, mutated)
+			assert.Empty(t, req.Params, "original request is left untouched")
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, oxylabs.ErrJobFaulted))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
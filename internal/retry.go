@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// WithRetryPolicy retries the reqs answered with a 429 or 5xx status code, or
+// failing with a network error, with exponential backoff per the policy.
+// Job submissions are not retried, so that no job is submitted twice.
+func WithRetryPolicy(policy oxylabs.RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// doRetrying sends the req with the credentials of the client, retrying it per
+// the retry policy of the client. The retries are counted in the stats of the
+// source, if known, and recorded in the retry history of the ctx of the req.
+// The last resp or error is returned once the attempts are exhausted, or once
+// the next delay would exceed the deadline of the ctx.
+func (c *Client) doRetrying(req *http.Request, source oxylabs.Source) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 1; ; attempt++ {
+		resp, err := c.DoAuthorized(req)
+		if attempt >= c.retryPolicy.MaxAttempts || !retryable(resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+
+		delay := c.retryPolicy.Delay(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+
+		// Rewind the body of the req.
+		retry := req.Clone(ctx)
+		if req.GetBody != nil {
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			retry.Body = body
+		}
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+			err = &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status}
+		}
+		recordRetry(ctx, oxylabs.Retry{
+			Kind:    oxylabs.RetryTransport,
+			Attempt: attempt,
+			Err:     err,
+			Delay:   delay,
+		})
+		if source != "" {
+			c.RecordRetry(source)
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-c.aborted():
+			timer.Stop()
+			return nil, oxylabs.ErrClientClosed
+		case <-timer.C:
+		}
+		req = retry
+	}
+}
+
+// retryable returns whether the resp or error of a req is transient.
+func retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+}
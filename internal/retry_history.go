@@ -0,0 +1,74 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+type retryHistoryKey struct{}
+
+// RetryHistory records the retries of the reqs made with a context.
+type RetryHistory struct {
+	source      oxylabs.Source
+	geoLocation string
+
+	mu      sync.Mutex
+	retries oxylabs.RetryHistory
+}
+
+// WithRetryHistory returns a copy of ctx recording the retries of the reqs made
+// with it, attributed to the source and geo location of req.
+func WithRetryHistory(ctx context.Context, req *oxylabs.Request) (context.Context, *RetryHistory) {
+	h := &RetryHistory{}
+	if req != nil {
+		h.source = req.Source
+		h.geoLocation, _ = req.Params["geo_location"].(string)
+	}
+
+	return context.WithValue(ctx, retryHistoryKey{}, h), h
+}
+
+// History returns the recorded retries, nil if none.
+func (h *RetryHistory) History() oxylabs.RetryHistory {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if len(h.retries) == 0 {
+		return nil
+	}
+
+	return append(oxylabs.RetryHistory(nil), h.retries...)
+}
+
+// Wrap wraps err with the recorded retries, if any.
+func (h *RetryHistory) Wrap(err error) error {
+	history := h.History()
+	if err == nil || history == nil {
+		return err
+	}
+
+	return &oxylabs.RetryError{History: history, Err: err}
+}
+
+// recordRetry records the retry in the retry history of ctx, if any.
+func recordRetry(ctx context.Context, retry oxylabs.Retry) {
+	h, ok := ctx.Value(retryHistoryKey{}).(*RetryHistory)
+	if !ok {
+		return
+	}
+	retry.Time = time.Now()
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if retry.Source == "" {
+		retry.Source = h.source
+	}
+	if retry.GeoLocation == "" {
+		retry.GeoLocation = h.geoLocation
+	}
+	h.retries = append(h.retries, retry)
+}
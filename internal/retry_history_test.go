@@ -0,0 +1,55 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryHistory(t *testing.T) {
+	marshal := func(req *oxylabs.Request) ([]byte, error) {
+		return []byte(`{"source":"google_search"}`), nil
+	}
+	c := NewClient(AsyncBaseUrl, "user", "pass", WithResubmitPolicy(oxylabs.ResubmitPolicy{MaxResubmits: 1}))
+
+	req := &oxylabs.Request{
+		Source:       oxylabs.GoogleSearch,
+		Params:       map[string]interface{}{"geo_location": "Germany"},
+		PollInterval: 1,
+	}
+
+	// Resubmitted job.
+	c.HttpClient = &http.Client{Transport: fakeJobs(1)}
+	ctx, history := WithRetryHistory(context.Background(), req)
+	_, _, err := c.AwaitJobResubmitting(ctx, req, "job1", marshal)
+	assert.NoError(t, history.Wrap(err))
+
+	retries := history.History()
+	assert.Len(t, retries, 1)
+	assert.Equal(t, oxylabs.RetryResubmit, retries[0].Kind)
+	assert.Equal(t, 1, retries[0].Attempt)
+	assert.Equal(t, oxylabs.GoogleSearch, retries[0].Source)
+	assert.Equal(t, "Germany", retries[0].GeoLocation)
+	assert.False(t, retries[0].Time.IsZero())
+	assert.True(t, errors.Is(retries[0].Err, oxylabs.ErrJobFaulted))
+
+	// Resubmits exhausted.
+	c.HttpClient = &http.Client{Transport: fakeJobs(2)}
+	ctx, history = WithRetryHistory(context.Background(), req)
+	_, _, err = c.AwaitJobResubmitting(ctx, req, "job1", marshal)
+	err = history.Wrap(err)
+	assert.True(t, errors.Is(err, oxylabs.ErrJobFaulted))
+	assert.Len(t, oxylabs.RetryHistoryOf(err), 1)
+
+	// No retries.
+	c.HttpClient = &http.Client{Transport: fakeJobs(0)}
+	ctx, history = WithRetryHistory(context.Background(), req)
+	_, _, err = c.AwaitJobResubmitting(ctx, req, "job1", marshal)
+	assert.NoError(t, err)
+	assert.Nil(t, history.History())
+	assert.Nil(t, oxylabs.RetryHistoryOf(history.Wrap(errors.New("failed"))))
+}
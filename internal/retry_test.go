@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+// failingApi returns a transport answering the first reqs with the status codes,
+// and the others with 200, along with the bodies of the reqs received.
+func failingApi(statusCodes ...int) (http.RoundTripper, *[]string) {
+	var bodies []string
+	return roundTripFunc(func(req *http.Request) *http.Response {
+		body, _ := io.ReadAll(req.Body)
+		bodies = append(bodies, string(body))
+
+		status := http.StatusOK
+		if len(bodies) <= len(statusCodes) {
+			status = statusCodes[len(bodies)-1]
+		}
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader("{}"))}
+	}), &bodies
+}
+
+func TestRetryPolicy(t *testing.T) {
+	payload := []byte(`{"source":"google_search"}`)
+	policy := oxylabs.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	tests := []struct {
+		name        string
+		statusCodes []int
+		wantStatus  int
+		wantRetries int
+	}{
+		{name: "transient failures", statusCodes: []int{503, 429}, wantStatus: 200, wantRetries: 2},
+		{name: "attempts exhausted", statusCodes: []int{500, 502, 504}, wantStatus: 504, wantRetries: 2},
+		{name: "not retryable", statusCodes: []int{400}, wantStatus: 400, wantRetries: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport, bodies := failingApi(tt.statusCodes...)
+			c := NewClient(SyncBaseUrl, "user", "pass", WithRetryPolicy(policy), WithHttpClient(&http.Client{Transport: transport}))
+
+			ctx, history := WithRetryHistory(context.Background(), &oxylabs.Request{Source: oxylabs.GoogleSearch})
+			resp, err := c.Req(ctx, payload, "POST")
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Len(t, *bodies, tt.wantRetries+1)
+			for _, body := range *bodies {
+				assert.Equal(t, string(payload), body, "the payload is resent")
+			}
+			assert.Equal(t, int64(tt.wantRetries), c.Stats()[oxylabs.GoogleSearch].Retries)
+			assert.Len(t, history.History(), tt.wantRetries)
+		})
+	}
+}
+
+func TestRetryPolicyDeadline(t *testing.T) {
+	transport, bodies := failingApi(503)
+	c := NewClient(SyncBaseUrl, "user", "pass",
+		WithRetryPolicy(oxylabs.RetryPolicy{MaxAttempts: 3, BaseDelay: time.Hour}),
+		WithHttpClient(&http.Client{Transport: transport}),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := c.Req(ctx, []byte(`{"source":"google_search"}`), "POST")
+	assert.NoError(t, err)
+	assert.Equal(t, 503, resp.StatusCode, "the retry would exceed the deadline")
+	assert.Len(t, *bodies, 1)
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	policy := oxylabs.RetryPolicy{BaseDelay: time.Second, MaxDelay: 3 * time.Second}
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 3*time.Second, policy.Delay(3))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(1)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	}
+}
@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// schemaVersions are the schema versions of the parsed results seen per source.
+type schemaVersions struct {
+	mu   sync.Mutex
+	seen map[oxylabs.Source]map[string]bool
+	hook func(oxylabs.SchemaVersion)
+}
+
+// WithSchemaVersionHook calls hook the first time the client sees each schema
+// version of the parsed results of a source, including the first one seen.
+func WithSchemaVersionHook(hook func(oxylabs.SchemaVersion)) ClientOption {
+	return func(c *Client) {
+		c.schemaVersions.hook = hook
+	}
+}
+
+// SchemaVersion returns the schema or parser version of the parsed content
+// of a page, or an empty string if the content has none.
+func SchemaVersion(content json.RawMessage) string {
+	if len(content) == 0 || content[0] != '{' {
+		return ""
+	}
+
+	parsed := struct {
+		SchemaVersion interface{} `json:"schema_version"`
+		ParserVersion interface{} `json:"parser_version"`
+		Version       interface{} `json:"_version"`
+	}{}
+	_ = json.Unmarshal(content, &parsed)
+
+	for _, version := range []interface{}{parsed.SchemaVersion, parsed.ParserVersion, parsed.Version} {
+		if version != nil && version != "" {
+			return fmt.Sprint(version)
+		}
+	}
+
+	return ""
+}
+
+// RecordSchemaVersion records the schema version of the parsed results of the
+// source, calling the schema version hook if the version was not seen before.
+func (c *Client) RecordSchemaVersion(version oxylabs.SchemaVersion) {
+	if version.Version == "" {
+		return
+	}
+
+	c.schemaVersions.mu.Lock()
+	if c.schemaVersions.seen == nil {
+		c.schemaVersions.seen = make(map[oxylabs.Source]map[string]bool)
+	}
+	if c.schemaVersions.seen[version.Source] == nil {
+		c.schemaVersions.seen[version.Source] = make(map[string]bool)
+	}
+	seen := c.schemaVersions.seen[version.Source][version.Version]
+	c.schemaVersions.seen[version.Source][version.Version] = true
+	hook := c.schemaVersions.hook
+	c.schemaVersions.mu.Unlock()
+
+	if !seen && hook != nil {
+		hook(version)
+	}
+}
@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchemaVersion(t *testing.T) {
+	assert.Equal(t, "", SchemaVersion(nil))
+	assert.Equal(t, "", SchemaVersion(json.RawMessage(`"<html></html>"`)))
+	assert.Equal(t, "", SchemaVersion(json.RawMessage(`{"url":"https://www.google.com"}`)))
+	assert.Equal(t, "2.1", SchemaVersion(json.RawMessage(`{"schema_version":"2.1"}`)))
+	assert.Equal(t, "3", SchemaVersion(json.RawMessage(`{"parser_version":3}`)))
+}
+
+func TestRecordSchemaVersion(t *testing.T) {
+	var seen []oxylabs.SchemaVersion
+	c := NewClient(SyncBaseUrl, "user", "pass", WithSchemaVersionHook(func(version oxylabs.SchemaVersion) {
+		seen = append(seen, version)
+	}))
+
+	c.RecordSchemaVersion(oxylabs.SchemaVersion{Source: oxylabs.GoogleSearch, Version: "1"})
+	c.RecordSchemaVersion(oxylabs.SchemaVersion{Source: oxylabs.GoogleSearch, Version: "1"})
+	c.RecordSchemaVersion(oxylabs.SchemaVersion{Source: oxylabs.GoogleSearch, Version: ""})
+	c.RecordSchemaVersion(oxylabs.SchemaVersion{Source: oxylabs.GoogleSearch, Version: "2"})
+	c.RecordSchemaVersion(oxylabs.SchemaVersion{Source: oxylabs.BingSearch, Version: "1"})
+
+	assert.Equal(t, []oxylabs.SchemaVersion{
+		{Source: oxylabs.GoogleSearch, Version: "1"},
+		{Source: oxylabs.GoogleSearch, Version: "2"},
+		{Source: oxylabs.BingSearch, Version: "1"},
+	}, seen)
+}
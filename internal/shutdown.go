@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// lifecycle tracks the in-flight work of the client for a graceful shutdown.
+type lifecycle struct {
+	mu       sync.Mutex
+	closed   bool
+	inflight int
+	idle     chan struct{}
+	abort    chan struct{}
+}
+
+// aborted returns a channel closed once in-flight polls must stop.
+func (c *Client) aborted() <-chan struct{} {
+	c.lifecycle.mu.Lock()
+	defer c.lifecycle.mu.Unlock()
+
+	if c.lifecycle.abort == nil {
+		c.lifecycle.abort = make(chan struct{})
+	}
+
+	return c.lifecycle.abort
+}
+
+// begin registers new work and returns the func to call once it is done.
+// It fails with oxylabs.ErrClientClosed once the client is shut down.
+func (c *Client) begin() (func(), error) {
+	c.lifecycle.mu.Lock()
+	defer c.lifecycle.mu.Unlock()
+
+	if c.lifecycle.closed {
+		return nil, oxylabs.ErrClientClosed
+	}
+	c.lifecycle.inflight++
+
+	return c.end, nil
+}
+
+// track registers work on an already submitted job. Unlike begin it is
+// accepted during shutdown, so that submitted jobs are still polled.
+func (c *Client) track() func() {
+	c.lifecycle.mu.Lock()
+	defer c.lifecycle.mu.Unlock()
+
+	c.lifecycle.inflight++
+
+	return c.end
+}
+
+// end marks the work as done.
+func (c *Client) end() {
+	c.lifecycle.mu.Lock()
+	defer c.lifecycle.mu.Unlock()
+
+	c.lifecycle.inflight--
+	if c.lifecycle.inflight == 0 && c.lifecycle.idle != nil {
+		close(c.lifecycle.idle)
+		c.lifecycle.idle = nil
+	}
+}
+
+// Shutdown stops accepting new reqs and waits for the in-flight ones,
+// including polls of submitted jobs, to complete. If ctx is done first,
+// the in-flight polls are signalled to stop with oxylabs.ErrClientClosed and
+// the ctx error is returned at once, without waiting for in-flight sync reqs,
+// which end with their own ctx. The client leaves the shared rate limit
+// of its credentials.
+func (c *Client) Shutdown(ctx context.Context) error {
+	// Leave the pace of the credentials to the other clients.
+	defer c.rateLimit.release()
+
+	c.lifecycle.mu.Lock()
+	c.lifecycle.closed = true
+	if c.lifecycle.inflight == 0 {
+		c.lifecycle.mu.Unlock()
+		return nil
+	}
+	if c.lifecycle.idle == nil {
+		c.lifecycle.idle = make(chan struct{})
+	}
+	idle := c.lifecycle.idle
+	c.lifecycle.mu.Unlock()
+
+	select {
+	case <-idle:
+		return nil
+	case <-ctx.Done():
+	}
+
+	// Stop the in-flight polls.
+	c.lifecycle.mu.Lock()
+	if c.lifecycle.abort == nil {
+		c.lifecycle.abort = make(chan struct{})
+	}
+	select {
+	case <-c.lifecycle.abort:
+	default:
+		close(c.lifecycle.abort)
+	}
+	c.lifecycle.mu.Unlock()
+
+	return ctx.Err()
+}
@@ -0,0 +1,63 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+
+	// Submitted jobs are still tracked during shutdown.
+	end := c.track()
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		end()
+	}()
+	assert.NoError(t, c.Shutdown(context.Background()))
+
+	_, err := c.begin()
+	assert.True(t, errors.Is(err, oxylabs.ErrClientClosed))
+}
+
+func TestShutdownDeadline(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+
+	end := c.track()
+	go func() {
+		<-c.aborted()
+		end()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.ErrorIs(t, c.Shutdown(ctx), context.DeadlineExceeded)
+}
+
+func TestShutdownDeadlineWithSyncReq(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+
+	// A sync req ignores the abort of polls and is still in flight.
+	end, err := c.begin()
+	assert.NoError(t, err)
+	defer end()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	done := make(chan error)
+	go func() {
+		done <- c.Shutdown(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("Shutdown did not return at the deadline")
+	}
+}
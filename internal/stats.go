@@ -0,0 +1,157 @@
+package internal
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// latencySmoothing is the weight of the latest latency in the moving average.
+const latencySmoothing = 0.2
+
+// stats tracks request counters per source.
+type stats struct {
+	mu      sync.Mutex
+	sources map[oxylabs.Source]*oxylabs.SourceStats
+	samples map[latencyKey]int
+}
+
+// latencyKey identifies the latencies of the sync reqs or async jobs of a source.
+type latencyKey struct {
+	source oxylabs.Source
+	async  bool
+}
+
+// record applies fn to the counters of the given source.
+func (s *stats) record(source oxylabs.Source, fn func(*oxylabs.SourceStats)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.sources == nil {
+		s.sources = make(map[oxylabs.Source]*oxylabs.SourceStats)
+	}
+	if s.sources[source] == nil {
+		s.sources[source] = &oxylabs.SourceStats{}
+	}
+	fn(s.sources[source])
+}
+
+// snapshot returns a copy of the counters of every source.
+func (s *stats) snapshot() map[oxylabs.Source]oxylabs.SourceStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	snapshot := make(map[oxylabs.Source]oxylabs.SourceStats, len(s.sources))
+	for source, sourceStats := range s.sources {
+		snapshot[source] = *sourceStats
+	}
+
+	return snapshot
+}
+
+// Stats returns the request counters per source of the client.
+func (c *Client) Stats() map[oxylabs.Source]oxylabs.SourceStats {
+	return c.stats.snapshot()
+}
+
+// RecordRequest increments the request counter of the source.
+func (c *Client) RecordRequest(source oxylabs.Source) {
+	c.stats.record(source, func(s *oxylabs.SourceStats) { s.Requests++ })
+}
+
+// recordRequests adds n to the request counter of the source.
+func (c *Client) recordRequests(source oxylabs.Source, n int) {
+	c.stats.record(source, func(s *oxylabs.SourceStats) { s.Requests += int64(n) })
+}
+
+// RecordSuccess increments the success counter of the source.
+func (c *Client) RecordSuccess(source oxylabs.Source) {
+	c.stats.record(source, func(s *oxylabs.SourceStats) { s.Successes++ })
+}
+
+// RecordFault increments the fault counter of the source.
+func (c *Client) RecordFault(source oxylabs.Source) {
+	c.stats.record(source, func(s *oxylabs.SourceStats) { s.Faults++ })
+}
+
+// recordFaults adds n to the fault counter of the source.
+func (c *Client) recordFaults(source oxylabs.Source, n int) {
+	c.stats.record(source, func(s *oxylabs.SourceStats) { s.Faults += int64(n) })
+}
+
+// RecordRetry increments the retry counter of the source.
+func (c *Client) RecordRetry(source oxylabs.Source) {
+	c.stats.record(source, func(s *oxylabs.SourceStats) { s.Retries++ })
+}
+
+// RecordLatency updates the moving average of the latency of the sync reqs of the source.
+func (c *Client) RecordLatency(source oxylabs.Source, latency time.Duration) {
+	c.stats.recordLatency(latencyKey{source: source}, latency)
+}
+
+// RecordAsyncLatency updates the moving average of the latency of the async
+// jobs of the source, from submission to completion.
+func (c *Client) RecordAsyncLatency(source oxylabs.Source, latency time.Duration) {
+	c.stats.recordLatency(latencyKey{source: source, async: true}, latency)
+}
+
+// recordLatency updates the moving average of the latencies of the key.
+func (s *stats) recordLatency(key latencyKey, latency time.Duration) {
+	s.record(key.source, func(stats *oxylabs.SourceStats) {
+		average := &stats.Latency
+		if key.async {
+			average = &stats.AsyncLatency
+		}
+		if *average == 0 {
+			*average = latency
+			return
+		}
+		*average += time.Duration(latencySmoothing * float64(latency-*average))
+	})
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.samples == nil {
+		s.samples = make(map[latencyKey]int)
+	}
+	s.samples[key]++
+}
+
+// expectedLatency returns the moving average of the latencies of the key,
+// false if fewer than minSamples latencies were recorded.
+func (s *stats) expectedLatency(key latencyKey, minSamples int) (time.Duration, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.samples[key] < minSamples || s.sources[key.source] == nil {
+		return 0, false
+	}
+	if key.async {
+		return s.sources[key.source].AsyncLatency, true
+	}
+
+	return s.sources[key.source].Latency, true
+}
+
+// payloadSource returns the source of the json payload.
+func payloadSource(jsonPayload []byte) oxylabs.Source {
+	payload := &struct {
+		Source oxylabs.Source `json:"source"`
+	}{}
+	_ = json.Unmarshal(jsonPayload, payload)
+
+	return payload.Source
+}
+
+// payloadCallbackUrl returns the callback_url of the json payload, if any.
+func payloadCallbackUrl(jsonPayload []byte) string {
+	payload := &struct {
+		CallbackUrl string `json:"callback_url"`
+	}{}
+	_ = json.Unmarshal(jsonPayload, payload)
+
+	return payload.CallbackUrl
+}
@@ -0,0 +1,72 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatsSubmissions(t *testing.T) {
+	tests := []struct {
+		name         string
+		payload      string
+		statusCode   int
+		wantRequests int64
+		wantFaults   int64
+	}{
+		{name: "job", payload: `{"source":"google_search","query":"shoes"}`, statusCode: 200, wantRequests: 1},
+		{name: "job not submitted", payload: `{"source":"google_search","query":"shoes"}`, statusCode: 500, wantRequests: 1, wantFaults: 1},
+		{name: "batch", payload: `{"source":"google_search","query":["shoes","boots","socks"]}`, statusCode: 200, wantRequests: 3},
+		{name: "batch not submitted", payload: `{"source":"google_search","query":["shoes","boots","socks"]}`, statusCode: 500, wantRequests: 3, wantFaults: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := roundTripFunc(func(req *http.Request) *http.Response {
+				body := `{"id":"job1"}`
+				if strings.HasSuffix(req.URL.Path, "/batch") {
+					body = `{"queries":[{"id":"job1"},{"id":"job2"},{"id":"job3"}]}`
+				}
+				return &http.Response{StatusCode: tt.statusCode, Body: io.NopCloser(strings.NewReader(body))}
+			})
+			c := NewClient(AsyncBaseUrl, "user", "pass", WithHttpClient(&http.Client{Transport: transport}))
+
+			var err error
+			if strings.Contains(tt.payload, "[") {
+				_, err = c.GetJobIDs(context.Background(), []byte(tt.payload))
+			} else {
+				_, err = c.GetJobID(context.Background(), []byte(tt.payload))
+			}
+			assert.Equal(t, tt.wantFaults > 0, err != nil)
+
+			stats := c.Stats()[oxylabs.GoogleSearch]
+			assert.Equal(t, tt.wantRequests, stats.Requests)
+			assert.Equal(t, tt.wantFaults, stats.Faults)
+			assert.Zero(t, stats.Successes)
+		})
+	}
+}
+
+func TestStatsJobs(t *testing.T) {
+	c := NewClient(AsyncBaseUrl, "user", "pass")
+	for i := 1; i <= 3; i++ {
+		c.RecordRequest(oxylabs.GoogleSearch)
+	}
+	c.RecordSuccess(oxylabs.GoogleSearch)
+	for i := 2; i <= 3; i++ {
+		err := c.faultedJobError(&Job{ID: fmt.Sprintf("job%d", i), Source: oxylabs.GoogleSearch})
+		assert.Error(t, err)
+	}
+	c.RecordRequest(oxylabs.BingSearch)
+
+	assert.Equal(t, map[oxylabs.Source]oxylabs.SourceStats{
+		oxylabs.GoogleSearch: {Requests: 3, Successes: 1, Faults: 2},
+		oxylabs.BingSearch:   {Requests: 1},
+	}, c.Stats())
+}
@@ -0,0 +1,93 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// maxSummaryValue is the max length of a value printed in a summary.
+const maxSummaryValue = 64
+
+// Summary returns a compact summary of the set fields of the opts struct for logging.
+// Long values are truncated, callback urls are reduced to their host and
+// pointers other than optional booleans, maps and slices are only reported
+// as set, so that neither credentials nor large payloads are printed.
+func Summary(opts interface{}) string {
+	v := reflect.ValueOf(opts)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return "<nil>"
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return fmt.Sprint(opts)
+	}
+
+	t := v.Type()
+	fields := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		value := v.Field(i)
+		if !field.IsExported() || value.IsZero() {
+			continue
+		}
+		fields = append(fields, fmt.Sprintf("%s: %s", field.Name, summaryValue(field.Name, value)))
+	}
+
+	return fmt.Sprintf("%s{%s}", t.Name(), strings.Join(fields, ", "))
+}
+
+// summaryValue returns the printable value of a summary field.
+func summaryValue(name string, value reflect.Value) string {
+	if d, ok := value.Interface().(time.Duration); ok {
+		return d.String()
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		s := value.String()
+		if strings.HasSuffix(name, "Url") {
+			return RedactUrl(s)
+		}
+		return Truncate(s, maxSummaryValue)
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprint(value.Interface())
+	case reflect.Slice, reflect.Array:
+		return fmt.Sprintf("[%d]", value.Len())
+	case reflect.Pointer:
+		// Optional booleans are printed as their value.
+		if value.Elem().Kind() == reflect.Bool {
+			return fmt.Sprint(value.Elem().Interface())
+		}
+		return "set"
+	default:
+		return "set"
+	}
+}
+
+// RedactUrl returns the scheme and host of the url only, dropping
+// any credentials, path and query.
+func RedactUrl(rawUrl string) string {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || parsedUrl.Host == "" {
+		return "<redacted>"
+	}
+
+	return fmt.Sprintf("%s://%s/...", parsedUrl.Scheme, parsedUrl.Host)
+}
+
+// Truncate returns s cut to max runes.
+func Truncate(s string, max int) string {
+	runes := []rune(s)
+	if len(runes) <= max {
+		return s
+	}
+
+	return string(runes[:max]) + "..."
+}
@@ -0,0 +1,60 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// translation configures the translation of parsed results.
+type translation struct {
+	translator     oxylabs.Translator
+	targetLanguage string
+}
+
+// WithTranslator translates the titles and snippets of parsed results into the
+// target language with the translator before they are returned.
+func WithTranslator(translator oxylabs.Translator, targetLanguage string) ClientOption {
+	return func(c *Client) {
+		c.translation = translation{translator: translator, targetLanguage: targetLanguage}
+	}
+}
+
+// Translate translates the texts in place with the translator of the client, if any.
+// Each distinct text is translated once. The texts are left untouched on error.
+func (c *Client) Translate(ctx context.Context, texts []*string) error {
+	if c.translation.translator == nil {
+		return nil
+	}
+
+	var (
+		distinct []string
+		index    = make(map[string]int)
+	)
+	for _, text := range texts {
+		if _, ok := index[*text]; !ok && *text != "" {
+			index[*text] = len(distinct)
+			distinct = append(distinct, *text)
+		}
+	}
+	if len(distinct) == 0 {
+		return nil
+	}
+
+	translated, err := c.translation.translator.Translate(ctx, distinct, c.translation.targetLanguage)
+	if err != nil {
+		return fmt.Errorf("error translating results: %v", err)
+	}
+	if len(translated) != len(distinct) {
+		return fmt.Errorf("error translating results: got %d translations of %d texts", len(translated), len(distinct))
+	}
+
+	for _, text := range texts {
+		if i, ok := index[*text]; ok {
+			*text = translated[i]
+		}
+	}
+
+	return nil
+}
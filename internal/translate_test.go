@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type translatorFunc func(ctx context.Context, texts []string, targetLanguage string) ([]string, error)
+
+func (f translatorFunc) Translate(ctx context.Context, texts []string, targetLanguage string) ([]string, error) {
+	return f(ctx, texts, targetLanguage)
+}
+
+func TestTranslate(t *testing.T) {
+	var translated [][]string
+	c := NewClient(SyncBaseUrl, "user", "pass", WithTranslator(translatorFunc(
+		func(_ context.Context, texts []string, targetLanguage string) ([]string, error) {
+			translated = append(translated, texts)
+			out := make([]string, len(texts))
+			for i, text := range texts {
+				out[i] = targetLanguage + ":" + strings.ToUpper(text)
+			}
+			return out, nil
+		},
+	), "en"))
+
+	title, snippet, repeated, empty := "schuhe", "günstige schuhe", "schuhe", ""
+	err := c.Translate(context.Background(), []*string{&title, &snippet, &repeated, &empty})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"schuhe", "günstige schuhe"}}, translated, "each distinct text is translated once")
+	assert.Equal(t, []string{"en:SCHUHE", "en:GÜNSTIGE SCHUHE", "en:SCHUHE", ""}, []string{title, snippet, repeated, empty})
+
+	// Texts are left untouched on error.
+	c.translation.translator = translatorFunc(func(context.Context, []string, string) ([]string, error) {
+		return nil, errors.New("quota exceeded")
+	})
+	title = "schuhe"
+	err = c.Translate(context.Background(), []*string{&title})
+	assert.ErrorContains(t, err, "error translating results: quota exceeded")
+	assert.Equal(t, "schuhe", title)
+
+	// Without translator.
+	assert.NoError(t, NewClient(SyncBaseUrl, "user", "pass").Translate(context.Background(), []*string{&title}))
+}
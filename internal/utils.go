@@ -5,6 +5,8 @@ import (
 	"net/url"
 	"runtime"
 	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 var (
@@ -30,6 +32,18 @@ func InList[T comparable](val T, list []T) bool {
 func ValidateUrl(
 	inputUrl string,
 	host string,
+) error {
+	if err := validateUrl(inputUrl, host); err != nil {
+		return oxylabs.NewValidationError([]error{err})
+	}
+
+	return nil
+}
+
+// validateUrl returns the first problem of the URL.
+func validateUrl(
+	inputUrl string,
+	host string,
 ) error {
 	// Check if the URL is empty.
 	if inputUrl == "" {
@@ -0,0 +1,102 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// ParseWarnings returns the warnings and errors reported in the parsed content
+// of a page, along with a warning for a parse status other than success.
+func ParseWarnings(page int, content json.RawMessage) []oxylabs.Warning {
+	if len(content) == 0 || content[0] != '{' {
+		return nil
+	}
+
+	parsed := struct {
+		Warnings        interface{} `json:"_warnings"`
+		Errors          interface{} `json:"_errors"`
+		ParseStatusCode int         `json:"parse_status_code"`
+	}{}
+	if err := json.Unmarshal(content, &parsed); err != nil {
+		return nil
+	}
+
+	var warnings []oxylabs.Warning
+	for _, issues := range []interface{}{parsed.Warnings, parsed.Errors} {
+		for _, message := range issueMessages(issues) {
+			warnings = append(warnings, oxylabs.Warning{
+				Page:            page,
+				ParseStatusCode: parsed.ParseStatusCode,
+				Message:         message,
+			})
+		}
+	}
+
+	if len(warnings) == 0 && parsed.ParseStatusCode != 0 && parsed.ParseStatusCode != oxylabs.ParseStatusSuccess {
+		warnings = append(warnings, oxylabs.Warning{
+			Page:            page,
+			ParseStatusCode: parsed.ParseStatusCode,
+			Message:         fmt.Sprintf("parse status %d", parsed.ParseStatusCode),
+		})
+	}
+
+	return warnings
+}
+
+// issueMessages returns the messages of the reported parse issues,
+// given either as strings or as objects with a message and a path.
+func issueMessages(issues interface{}) []string {
+	switch issues := issues.(type) {
+	case nil:
+		return nil
+	case string:
+		if issues == "" {
+			return nil
+		}
+		return []string{issues}
+	case []interface{}:
+		var messages []string
+		for _, issue := range issues {
+			messages = append(messages, issueMessages(issue)...)
+		}
+		return messages
+	case map[string]interface{}:
+		message := ""
+		for _, key := range []string{"_msg", "_message", "message", "msg"} {
+			if m, ok := issues[key].(string); ok && m != "" {
+				message = m
+				break
+			}
+		}
+		for _, key := range []string{"_path", "path"} {
+			if path, ok := issues[key].(string); ok && path != "" {
+				message = fmt.Sprintf("%s: %s", path, message)
+				break
+			}
+		}
+		if message == "" {
+			b, _ := json.Marshal(issues)
+			message = string(b)
+		}
+		return []string{message}
+	default:
+		return []string{fmt.Sprint(issues)}
+	}
+}
+
+// ParseStatusCode returns the parse status code of the parsed content of a page,
+// or 0 if the content is not parsed.
+func ParseStatusCode(content json.RawMessage) int {
+	if len(content) == 0 || content[0] != '{' {
+		return 0
+	}
+
+	parsed := struct {
+		ParseStatusCode int `json:"parse_status_code"`
+	}{}
+	_ = json.Unmarshal(content, &parsed)
+
+	return parsed.ParseStatusCode
+}
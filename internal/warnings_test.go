@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWarnings(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []oxylabs.Warning
+	}{
+		{name: "raw content", content: `"<html></html>"`},
+		{name: "fully parsed", content: `{"parse_status_code":12000,"_warnings":[]}`},
+		{
+			name:    "string warnings",
+			content: `{"parse_status_code":12004,"_warnings":["price missing"]}`,
+			want:    []oxylabs.Warning{{Page: 2, ParseStatusCode: 12004, Message: "price missing"}},
+		},
+		{
+			name:    "object errors",
+			content: `{"parse_status_code":12004,"_errors":[{"_path":"results.paid","_msg":"failed to parse"}]}`,
+			want:    []oxylabs.Warning{{Page: 2, ParseStatusCode: 12004, Message: "results.paid: failed to parse"}},
+		},
+		{
+			name:    "status only",
+			content: `{"parse_status_code":12005}`,
+			want:    []oxylabs.Warning{{Page: 2, ParseStatusCode: 12005, Message: "parse status 12005"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseWarnings(2, json.RawMessage(tt.content)))
+		})
+	}
+}
+
+func TestParseStatusCode(t *testing.T) {
+	assert.Equal(t, 0, ParseStatusCode(json.RawMessage(`"<html></html>"`)))
+	assert.Equal(t, 0, ParseStatusCode(nil))
+	assert.Equal(t, 0, ParseStatusCode(json.RawMessage(`{"results":{}}`)))
+	assert.Equal(t, 12004, ParseStatusCode(json.RawMessage(`{"parse_status_code":12004}`)))
+}
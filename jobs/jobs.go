@@ -0,0 +1,161 @@
+package jobs
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Definition is a declarative scrape job definition.
+// Options are sent as payload parameters as is, e.g. geo_location or parse.
+// Schedule is the interval between runs as a Go duration (e.g. "1h30m"),
+// a job without schedule runs once.
+type Definition struct {
+	Name     string                 `json:"name" yaml:"name"`
+	Source   oxylabs.Source         `json:"source" yaml:"source"`
+	Query    string                 `json:"query,omitempty" yaml:"query,omitempty"`
+	Url      string                 `json:"url,omitempty" yaml:"url,omitempty"`
+	Options  map[string]interface{} `json:"options,omitempty" yaml:"options,omitempty"`
+	Schedule string                 `json:"schedule,omitempty" yaml:"schedule,omitempty"`
+	Output   Output                 `json:"output,omitempty" yaml:"output,omitempty"`
+}
+
+// Output configures where the results of a job are written.
+// Path may contain the {name} and {timestamp} placeholders.
+// No output is written if Path is empty.
+type Output struct {
+	Path string `json:"path,omitempty" yaml:"path,omitempty"`
+}
+
+// file is the layout of a job definitions file.
+type file struct {
+	Jobs []Definition `json:"jobs" yaml:"jobs"`
+}
+
+// Format is the encoding of job definitions.
+type Format string
+
+const (
+	JSON Format = "json"
+	YAML Format = "yaml"
+)
+
+// Load decodes and validates the job definitions from r.
+func Load(r io.Reader, format Format) ([]Definition, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading job definitions: %v", err)
+	}
+
+	f := &file{}
+	switch format {
+	case JSON:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.UseNumber()
+		err = decoder.Decode(f)
+	case YAML:
+		err = yaml.Unmarshal(data, f)
+	default:
+		return nil, fmt.Errorf("invalid format: %s", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error decoding job definitions: %v", err)
+	}
+
+	// Convert the decoded options into the types of the request constructors,
+	// e.g. pages into an int, and validate the definitions.
+	var errs []error
+	for i := range f.Jobs {
+		if err := oxylabs.NormalizeParams(f.Jobs[i].Options); err != nil {
+			errs = append(errs, fmt.Errorf("job %d: %w", i, err))
+			continue
+		}
+		if err := f.Jobs[i].Validate(); err != nil {
+			errs = append(errs, fmt.Errorf("job %d: %w", i, err))
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	return f.Jobs, nil
+}
+
+// LoadFile loads the job definitions from the file at path.
+// The format is inferred from the .json, .yaml or .yml extension.
+func LoadFile(path string) ([]Definition, error) {
+	format := JSON
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+	case ".yaml", ".yml":
+		format = YAML
+	default:
+		return nil, fmt.Errorf("unsupported job definitions file: %s", path)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening job definitions: %v", err)
+	}
+	defer f.Close()
+
+	return Load(f, format)
+}
+
+// Validate checks the validity of the job definition.
+func (d *Definition) Validate() error {
+	if d.Name == "" {
+		return fmt.Errorf("name must be set")
+	}
+
+	if d.Source == "" {
+		return fmt.Errorf("source must be set")
+	}
+
+	if d.Query == "" && d.Url == "" {
+		return fmt.Errorf("either query or url must be set")
+	}
+
+	if d.Schedule != "" {
+		interval, err := time.ParseDuration(d.Schedule)
+		if err != nil {
+			return fmt.Errorf("invalid schedule: %v", err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("schedule must be greater than 0")
+		}
+	}
+
+	// Check validity of the resulting payload, e.g. parsing instructions.
+	if _, err := d.Request().Payload(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Interval returns the interval between runs of the job, or 0 if the job runs once.
+func (d *Definition) Interval() time.Duration {
+	interval, _ := time.ParseDuration(d.Schedule)
+	return interval
+}
+
+// Request returns the request of the job definition.
+func (d *Definition) Request() *oxylabs.Request {
+	return &oxylabs.Request{
+		Source: d.Source,
+		Query:  d.Query,
+		Url:    d.Url,
+		Params: d.Options,
+	}
+}
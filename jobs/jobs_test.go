@@ -0,0 +1,134 @@
+package jobs
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		format  Format
+		wantErr bool
+	}{
+		{
+			name:   "Valid YAML",
+			format: YAML,
+			data: `
+jobs:
+  - name: shoes
+    source: google_search
+    query: adidas
+    schedule: 1h
+    options:
+      parse: true
+      geo_location: Berlin
+    output:
+      path: out/{name}-{timestamp}.json
+`,
+		},
+		{
+			name:   "Valid JSON",
+			format: JSON,
+			data:   `{"jobs": [{"name": "shoes", "source": "google_search", "query": "adidas", "schedule": "1h"}]}`,
+		},
+		{
+			name:    "Missing query and url",
+			format:  YAML,
+			data:    "jobs:\n  - name: shoes\n    source: google_search\n",
+			wantErr: true,
+		},
+		{
+			name:    "Invalid schedule",
+			format:  JSON,
+			data:    `{"jobs": [{"name": "shoes", "source": "google_search", "query": "adidas", "schedule": "hourly"}]}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defs, err := Load(strings.NewReader(tt.data), tt.format)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Len(t, defs, 1)
+			assert.Equal(t, "adidas", defs[0].Query)
+			assert.Equal(t, time.Hour, defs[0].Interval())
+		})
+	}
+}
+
+func TestLoadOptions(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		format Format
+	}{
+		{
+			name:   "JSON",
+			format: JSON,
+			data: `{"jobs": [{"name": "shoes", "source": "universal", "url": "https://example.com", "options": {
+				"pages": 2, "start_page": 3, "parse": true,
+				"parsing_instructions": {"title": {"_fns": [{"_fn": "xpath_one", "_args": ["//h1/text()"]}]},
+				"nth": {"_fns": [{"_fn": "select_nth", "_args": 1}]}}}}]}`,
+		},
+		{
+			name:   "YAML",
+			format: YAML,
+			data: `
+jobs:
+  - name: shoes
+    source: universal
+    url: https://example.com
+    options:
+      pages: 2
+      start_page: 3
+      parse: true
+      parsing_instructions:
+        title:
+          _fns:
+            - _fn: xpath_one
+              _args: ["//h1/text()"]
+        nth:
+          _fns:
+            - _fn: select_nth
+              _args: 1
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defs, err := Load(strings.NewReader(tt.data), tt.format)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			options := defs[0].Options
+			assert.Equal(t, 2, options["pages"])
+			assert.Equal(t, 3, options["start_page"])
+			assert.Equal(t, 2, oxylabs.EstimateCost(defs[0].Request()).Pages)
+
+			instructions, _ := options["parsing_instructions"].(map[string]interface{})
+			title, _ := instructions["title"].(map[string]interface{})
+			assert.Equal(t, []oxylabs.Fn{{Name: oxylabs.XpathOne, Args: []string{"//h1/text()"}}}, title["_fns"])
+			nth, _ := instructions["nth"].(map[string]interface{})
+			assert.Equal(t, []oxylabs.Fn{{Name: oxylabs.SelectNth, Args: 1}}, nth["_fns"])
+		})
+	}
+
+	data := `{"jobs": [{"name": "shoes", "source": "universal", "url": "https://example.com",
+		"options": {"parsing_instructions": {"title": {"_fns": [{"_fn": "select_nth", "_args": "1"}]}}}}]}`
+	_, err := Load(strings.NewReader(data), JSON)
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+}
@@ -0,0 +1,220 @@
+package jobs
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Doer sends requests, e.g. *serp.SerpClient or *ecommerce.EcommerceClient.
+type Doer[R any] interface {
+	Do(ctx context.Context, req *oxylabs.Request) (R, error)
+}
+
+// CallbackDoer sends requests with a callback receiver as callback_url and
+// resolves them once their callback arrives, e.g. *serp.SerpClientAsync or
+// *ecommerce.EcommerceClientAsync.
+type CallbackDoer[R any] interface {
+	DoCallback(ctx context.Context, req *oxylabs.Request, receiver *callback.Receiver) (R, error)
+}
+
+// DefaultHistory is the default number of runs kept per job.
+const DefaultHistory = 10
+
+// Run is the result of a single run of a job.
+type Run[R any] struct {
+	Job  string
+	At   time.Time
+	Resp R
+	Err  error
+}
+
+// Runner executes job definitions via the SDK.
+// OnResult, if set, is called after every run of a job.
+// History is the number of runs kept per job, DefaultHistory if 0.
+type Runner[R any] struct {
+	Client   Doer[R]
+	Jobs     []Definition
+	OnResult func(def Definition, resp R, err error)
+	History  int
+
+	mu             sync.Mutex
+	runs           map[string][]Run[R]
+	receiver       *callback.Receiver
+	callbackClient CallbackDoer[R]
+}
+
+// BindCallback sends every job run via client with the receiver as callback_url,
+// instead of via Client. The jobs are submitted without polling, and their runs
+// are resolved once Oxylabs notifies the receiver.
+func (r *Runner[R]) BindCallback(receiver *callback.Receiver, client CallbackDoer[R]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.receiver = receiver
+	r.callbackClient = client
+}
+
+// Results returns the kept runs of the job with the given name, oldest first.
+func (r *Runner[R]) Results(name string) []Run[R] {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Run[R]{}, r.runs[name]...)
+}
+
+// Latest returns the most recent run of the job with the given name.
+func (r *Runner[R]) Latest(name string) (Run[R], bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	runs := r.runs[name]
+	if len(runs) == 0 {
+		return Run[R]{}, false
+	}
+
+	return runs[len(runs)-1], true
+}
+
+// record keeps the run, dropping the oldest ones beyond the history size.
+func (r *Runner[R]) record(run Run[R]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	history := r.History
+	if history <= 0 {
+		history = DefaultHistory
+	}
+
+	if r.runs == nil {
+		r.runs = make(map[string][]Run[R])
+	}
+
+	runs := append(r.runs[run.Job], run)
+	if len(runs) > history {
+		runs = runs[len(runs)-history:]
+	}
+	r.runs[run.Job] = runs
+}
+
+// do sends the request of the job, via the callback client if bound.
+func (r *Runner[R]) do(ctx context.Context, def Definition) (R, error) {
+	r.mu.Lock()
+	receiver, callbackClient := r.receiver, r.callbackClient
+	r.mu.Unlock()
+
+	if receiver != nil && callbackClient != nil {
+		return callbackClient.DoCallback(ctx, def.Request(), receiver)
+	}
+
+	return r.Client.Do(ctx, def.Request())
+}
+
+// Run executes the job once, writes its output if configured
+// and keeps the result for retrieval with Results.
+func (r *Runner[R]) Run(ctx context.Context, def Definition) (R, error) {
+	at := time.Now()
+	resp, err := r.do(ctx, def)
+	if err == nil && def.Output.Path != "" {
+		err = writeOutput(def, resp, at)
+	}
+
+	r.record(Run[R]{Job: def.Name, At: at, Resp: resp, Err: err})
+
+	if r.OnResult != nil {
+		r.OnResult(def, resp, err)
+	}
+
+	return resp, err
+}
+
+// RunOnce executes every job once, concurrently, and returns the joined errors.
+func (r *Runner[R]) RunOnce(ctx context.Context) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+
+	for _, def := range r.Jobs {
+		wg.Add(1)
+		go func(def Definition) {
+			defer wg.Done()
+
+			if _, err := r.Run(ctx, def); err != nil {
+				mu.Lock()
+				errs = append(errs, fmt.Errorf("job %s: %w", def.Name, err))
+				mu.Unlock()
+			}
+		}(def)
+	}
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+// Start executes every job and reruns scheduled jobs on their interval
+// until ctx is done. Errors are reported to OnResult only.
+func (r *Runner[R]) Start(ctx context.Context) {
+	var wg sync.WaitGroup
+
+	for _, def := range r.Jobs {
+		wg.Add(1)
+		go func(def Definition) {
+			defer wg.Done()
+
+			_, _ = r.Run(ctx, def)
+
+			interval := def.Interval()
+			if interval == 0 {
+				return
+			}
+
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					_, _ = r.Run(ctx, def)
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(def)
+	}
+
+	wg.Wait()
+}
+
+// writeOutput writes the resp of the job as JSON to its output path.
+func writeOutput(def Definition, resp interface{}, at time.Time) error {
+	path := strings.NewReplacer(
+		"{name}", def.Name,
+		"{timestamp}", strconv.FormatInt(at.Unix(), 10),
+	).Replace(def.Output.Path)
+
+	data, err := json.MarshalIndent(resp, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshalling output: %v", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating output dir: %v", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("error writing output: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package jobs
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/revvim/oxylabs-sdk-go/serp"
+)
+
+type fakeDoer struct{}
+
+func (fakeDoer) Do(ctx context.Context, req *oxylabs.Request) (map[string]interface{}, error) {
+	return req.Payload()
+}
+
+func TestRunnerResults(t *testing.T) {
+	def := Definition{Name: "shoes", Source: "google_search", Query: "adidas"}
+	runner := &Runner[map[string]interface{}]{Client: fakeDoer{}, History: 2}
+
+	for i := 0; i < 3; i++ {
+		_, err := runner.Run(context.Background(), def)
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, runner.Results("shoes"), 2)
+	assert.Empty(t, runner.Results("other"))
+
+	latest, ok := runner.Latest("shoes")
+	assert.True(t, ok)
+	assert.Equal(t, "adidas", latest.Resp["query"])
+	assert.Nil(t, def.Options)
+}
+
+func TestRunnerBindCallback(t *testing.T) {
+	sim := oxylabstest.NewSimulator()
+	receiver := callback.NewReceiver("https://example.com/callback")
+	c := serp.InitAsync("user", "pass", serp.WithHttpClient(sim.HttpClient()), serp.WithDefaultPollInterval(time.Hour))
+	runner := &Runner[*serp.Resp]{}
+	runner.BindCallback(receiver, c)
+
+	// Notify the receiver once the job is submitted.
+	go func() {
+		for len(sim.Jobs()) == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		body := `{"id":"` + sim.Jobs()[0].ID + `","status":"done","source":"google_search"}`
+		receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	}()
+
+	def := Definition{Name: "shoes", Source: "google_search", Query: "adidas"}
+	resp, err := runner.Run(context.Background(), def)
+	if !assert.NoError(t, err) || !assert.Len(t, resp.Results, 1) {
+		return
+	}
+	assert.Contains(t, resp.Results[0].Content, "adidas")
+
+	jobs := sim.Jobs()
+	assert.Equal(t, receiver.Url, jobs[0].Payload["callback_url"])
+	assert.Zero(t, jobs[0].Polls, "the run is resolved by the callback")
+	assert.Nil(t, def.Options, "the definition is not modified")
+}
+
+func TestRunnerBindCallbackCanceled(t *testing.T) {
+	sim := oxylabstest.NewSimulator()
+	receiver := callback.NewReceiver("https://example.com/callback")
+	c := serp.InitAsync("user", "pass", serp.WithHttpClient(sim.HttpClient()))
+	runner := &Runner[*serp.Resp]{}
+	runner.BindCallback(receiver, c)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := runner.Run(ctx, Definition{Name: "shoes", Source: "google_search", Query: "adidas"})
+	assert.ErrorIs(t, err, oxylabs.ErrTimeout)
+
+	// The job is forgotten, so its late callback is not kept.
+	jobID := sim.Jobs()[0].ID
+	body := `{"id":"` + jobID + `","status":"done","source":"google_search"}`
+	receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	select {
+	case <-receiver.Await(jobID):
+		assert.Fail(t, "late callback kept")
+	default:
+	}
+	if runs := runner.Results("shoes"); assert.Len(t, runs, 1) {
+		assert.Error(t, runs[0].Err)
+	}
+}
@@ -0,0 +1,351 @@
+package monitor
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/bulk"
+	"github.com/revvim/oxylabs-sdk-go/ecommerce"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// DefaultCadence is the default time between checks of a product.
+const DefaultCadence = time.Hour
+
+// DefaultTick is the default time between the schedulings of Start.
+const DefaultTick = time.Second
+
+// Doer sends requests, e.g. *ecommerce.EcommerceClient.
+type Doer interface {
+	Do(ctx context.Context, req *oxylabs.Request) (*ecommerce.Resp, error)
+}
+
+// Product is a product registered for monitoring.
+// Either Query (e.g. an ASIN) or Url must be set, Options are sent as
+// payload parameters as is. Cadence is the time between checks, DefaultCadence if 0.
+type Product struct {
+	ID      string
+	Source  oxylabs.Source
+	Query   string
+	Url     string
+	Options map[string]interface{}
+	Cadence time.Duration
+}
+
+// Observation is the state of a product at a point in time.
+type Observation struct {
+	ProductID string                  `json:"product_id"`
+	At        time.Time               `json:"at"`
+	Product   ecommerce.ProductResult `json:"product"`
+}
+
+// Event is emitted when a check observes a change of a product
+// exceeding the thresholds, or of its availability.
+type Event struct {
+	ProductID           string
+	Old                 Observation
+	New                 Observation
+	PriceDelta          float64
+	PriceChangePct      float64
+	AvailabilityChanged bool
+}
+
+// Thresholds filter the price changes emitted as events.
+// A price change is emitted if it exceeds either threshold, any change if both are 0.
+type Thresholds struct {
+	MinPriceDelta     float64
+	MinPriceChangePct float64
+}
+
+// Monitor checks registered products via the SDK, persists the observations
+// through Storage and calls OnEvent with every change exceeding Thresholds.
+// OnError, if set, is called with the errors of scheduled checks.
+// Tick is the time between the schedulings of Start, DefaultTick if 0.
+type Monitor struct {
+	Client     Doer
+	Storage    Storage
+	Thresholds Thresholds
+	OnEvent    func(Event)
+	OnError    func(productID string, err error)
+	Tick       time.Duration
+
+	mu       sync.Mutex
+	products map[string]Product
+}
+
+// New returns a monitor checking products with the client and persisting them to storage.
+func New(client Doer, storage Storage) *Monitor {
+	return &Monitor{
+		Client:   client,
+		Storage:  storage,
+		products: make(map[string]Product),
+	}
+}
+
+// Register registers the product for monitoring, replacing any product with the same ID.
+func (m *Monitor) Register(p Product) error {
+	if p.ID == "" {
+		return fmt.Errorf("product id must be set")
+	}
+
+	if p.Source == "" {
+		return fmt.Errorf("source must be set")
+	}
+
+	if p.Query == "" && p.Url == "" {
+		return fmt.Errorf("either query or url must be set")
+	}
+
+	if p.Cadence < 0 {
+		return fmt.Errorf("cadence must be greater than 0")
+	}
+
+	// Set defaults.
+	if p.Cadence == 0 {
+		p.Cadence = DefaultCadence
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.products == nil {
+		m.products = make(map[string]Product)
+	}
+	m.products[p.ID] = p
+
+	return nil
+}
+
+// Unregister stops monitoring the product.
+func (m *Monitor) Unregister(productID string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.products, productID)
+}
+
+// Check scrapes the product once, persists the observation and returns it,
+// along with the emitted event if any.
+func (m *Monitor) Check(ctx context.Context, productID string) (*Observation, *Event, error) {
+	m.mu.Lock()
+	p, ok := m.products[productID]
+	m.mu.Unlock()
+	if !ok {
+		return nil, nil, fmt.Errorf("product %s is not registered", productID)
+	}
+
+	// Scrape.
+	params := map[string]interface{}{"parse": true}
+	for k, v := range p.Options {
+		params[k] = v
+	}
+	resp, err := m.Client.Do(ctx, &oxylabs.Request{
+		Source: p.Source,
+		Query:  p.Query,
+		Url:    p.Url,
+		Params: params,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	products, err := resp.Products()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(products) == 0 {
+		return nil, nil, fmt.Errorf("no product found for %s", productID)
+	}
+
+	// Compare with the previous observation and persist.
+	observation := Observation{ProductID: productID, At: time.Now(), Product: products[0]}
+	previous, err := m.Storage.Latest(ctx, productID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.Storage.Save(ctx, observation); err != nil {
+		return nil, nil, err
+	}
+
+	if previous == nil {
+		return &observation, nil, nil
+	}
+
+	event := m.compare(*previous, observation)
+	if event != nil && m.OnEvent != nil {
+		m.OnEvent(*event)
+	}
+
+	return &observation, event, nil
+}
+
+// Run checks every registered product once, concurrently per opts, and returns
+// the report of the checks by product ID. With Sampling set in opts, only the
+// products whose ID is part of the sample of the run are checked, so that very
+// large catalogs can be monitored within a fixed credit budget. With Politeness
+// set, the checks are spaced out per domain of the product urls, unless opts
+// set DomainOf. DomainOf and KeyOf of opts are called with the product IDs.
+func (m *Monitor) Run(ctx context.Context, opts ...*bulk.Opts) *bulk.BulkResult[string, *Observation] {
+	// Prepare options.
+	opt := bulk.Opts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = *opts[len(opts)-1]
+	}
+
+	products := m.snapshot()
+	productIDs := make([]string, 0, len(products))
+	for productID := range products {
+		productIDs = append(productIDs, productID)
+	}
+	sort.Strings(productIDs)
+
+	// Set defaults.
+	if opt.DomainOf == nil {
+		opt.DomainOf = func(input interface{}) string {
+			productID, _ := input.(string)
+			return bulk.DomainOf(products[productID].Url)
+		}
+	}
+
+	// The products are sampled by ID.
+	return bulk.Execute(ctx, productIDs, func(ctx context.Context, productID string) (*Observation, error) {
+		observation, _, err := m.Check(ctx, productID)
+		return observation, err
+	}, &opt)
+}
+
+// Start checks every registered product on its cadence until ctx is done.
+// The registered products are read every Tick, so that products registered
+// or unregistered after Start are scheduled accordingly, and a product is
+// checked on the first tick once its cadence elapsed since its previous check.
+// The checks of a product don't overlap: a product still being checked is
+// checked again on the first tick once its check returns.
+func (m *Monitor) Start(ctx context.Context) {
+	tick := m.Tick
+	if tick <= 0 {
+		tick = DefaultTick
+	}
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		checking = make(map[string]bool)
+		checked  = make(map[string]time.Time)
+	)
+	defer wg.Wait()
+
+	for {
+		now := time.Now()
+		products := m.snapshot()
+
+		mu.Lock()
+		for productID := range checked {
+			if _, ok := products[productID]; !ok {
+				delete(checked, productID)
+			}
+		}
+		for _, p := range products {
+			if at, ok := checked[p.ID]; checking[p.ID] || (ok && now.Sub(at) < p.Cadence) {
+				continue
+			}
+			checking[p.ID] = true
+			checked[p.ID] = now
+
+			wg.Add(1)
+			go func(productID string) {
+				defer wg.Done()
+
+				if _, _, err := m.Check(ctx, productID); err != nil && m.OnError != nil && ctx.Err() == nil {
+					m.OnError(productID, err)
+				}
+
+				mu.Lock()
+				delete(checking, productID)
+				mu.Unlock()
+			}(p.ID)
+		}
+		mu.Unlock()
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// snapshot returns a copy of the registered products by ID.
+func (m *Monitor) snapshot() map[string]Product {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	products := make(map[string]Product, len(m.products))
+	for productID, p := range m.products {
+		products[productID] = p
+	}
+
+	return products
+}
+
+// compare returns the event of the change between the observations, or nil
+// if the change doesn't exceed the thresholds.
+func (m *Monitor) compare(previous, current Observation) *Event {
+	event := &Event{
+		ProductID:           current.ProductID,
+		Old:                 previous,
+		New:                 current,
+		PriceDelta:          current.Product.Price.Amount - previous.Product.Price.Amount,
+		AvailabilityChanged: current.Product.Availability != previous.Product.Availability,
+	}
+	if previous.Product.Price.Amount != 0 {
+		event.PriceChangePct = event.PriceDelta / previous.Product.Price.Amount * 100
+	}
+
+	if event.AvailabilityChanged || m.exceeds(event) {
+		return event
+	}
+
+	return nil
+}
+
+// exceeds returns whether the price change of the event exceeds the thresholds.
+func (m *Monitor) exceeds(event *Event) bool {
+	if event.PriceDelta == 0 {
+		return false
+	}
+
+	t := m.Thresholds
+	if t.MinPriceDelta == 0 && t.MinPriceChangePct == 0 {
+		return true
+	}
+
+	return (t.MinPriceDelta > 0 && math.Abs(event.PriceDelta) >= t.MinPriceDelta) ||
+		(t.MinPriceChangePct > 0 && math.Abs(event.PriceChangePct) >= t.MinPriceChangePct)
+}
+
+// PriceHistory returns up to limit most recent observations of the product,
+// newest first, as price points ready for time-series storage.
+func (m *Monitor) PriceHistory(ctx context.Context, productID string, limit int) ([]ecommerce.PricePoint, error) {
+	m.mu.Lock()
+	p := m.products[productID]
+	m.mu.Unlock()
+
+	history, err := m.Storage.History(ctx, productID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]ecommerce.PricePoint, 0, len(history))
+	for _, o := range history {
+		points = append(points, o.Product.PricePoint(o.At, p.Source, o.ProductID))
+	}
+
+	return points, nil
+}
@@ -0,0 +1,220 @@
+package monitor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/bulk"
+	"github.com/revvim/oxylabs-sdk-go/ecommerce"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+type fakeDoer struct {
+	prices []float64
+}
+
+func (d *fakeDoer) Do(ctx context.Context, req *oxylabs.Request) (*ecommerce.Resp, error) {
+	price := d.prices[0]
+	d.prices = d.prices[1:]
+
+	content := fmt.Sprintf(`{"title": "Shoe", "price": %v, "currency": "USD", "asin": "%s"}`, price, req.Query)
+	return &ecommerce.Resp{
+		Results: []ecommerce.Results{{ContentRaw: json.RawMessage(content)}},
+	}, nil
+}
+
+// countingDoer returns a product of constant price, counting the checks
+// per query and the max checks in flight.
+type countingDoer struct {
+	mu          sync.Mutex
+	checks      map[string]int
+	inFlight    int
+	maxInFlight int
+	delay       time.Duration
+}
+
+func (d *countingDoer) Do(ctx context.Context, req *oxylabs.Request) (*ecommerce.Resp, error) {
+	d.mu.Lock()
+	if d.checks == nil {
+		d.checks = make(map[string]int)
+	}
+	d.checks[req.Query+req.Url]++
+	d.inFlight++
+	if d.inFlight > d.maxInFlight {
+		d.maxInFlight = d.inFlight
+	}
+	d.mu.Unlock()
+
+	time.Sleep(d.delay)
+
+	d.mu.Lock()
+	d.inFlight--
+	d.mu.Unlock()
+
+	return &ecommerce.Resp{
+		Results: []ecommerce.Results{{ContentRaw: json.RawMessage(`{"title": "Shoe", "price": 100, "currency": "USD"}`)}},
+	}, nil
+}
+
+func (d *countingDoer) count(key string) int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.checks[key]
+}
+
+func TestMonitorCheck(t *testing.T) {
+	m := New(&fakeDoer{prices: []float64{100, 101, 90}}, NewMemoryStorage())
+	m.Thresholds = Thresholds{MinPriceChangePct: 5}
+	assert.NoError(t, m.Register(Product{ID: "shoe", Source: oxylabs.AmazonProduct, Query: "B0"}))
+
+	var events []Event
+	m.OnEvent = func(e Event) { events = append(events, e) }
+
+	for i := 0; i < 3; i++ {
+		_, _, err := m.Check(context.Background(), "shoe")
+		assert.NoError(t, err)
+	}
+
+	assert.Len(t, events, 1)
+	assert.Equal(t, -11.0, events[0].PriceDelta)
+	assert.Equal(t, 101.0, events[0].Old.Product.Price.Amount)
+
+	history, err := m.Storage.History(context.Background(), "shoe", 10)
+	assert.NoError(t, err)
+	assert.Len(t, history, 3)
+	assert.Equal(t, 90.0, history[0].Product.Price.Amount)
+
+	points, err := m.PriceHistory(context.Background(), "shoe", 10)
+	assert.NoError(t, err)
+	assert.Len(t, points, 3)
+	assert.Equal(t, oxylabs.AmazonProduct, points[0].Source)
+	assert.Equal(t, "shoe", points[0].ProductKey)
+	assert.Equal(t, 90.0, points[0].Price)
+	assert.Equal(t, "USD", points[0].Currency)
+}
+
+func TestSQLStorageQuery(t *testing.T) {
+	s, err := NewSQLStorage(nil, Postgres, "")
+	assert.NoError(t, err)
+	assert.Equal(t,
+		"INSERT INTO observations (a, b) VALUES ($1, $2)",
+		s.query("INSERT INTO %s (a, b) VALUES (?, ?)"),
+	)
+}
+
+func TestMonitorRunSampling(t *testing.T) {
+	m := New(&fakeDoer{prices: make([]float64, 10)}, NewMemoryStorage())
+	for i := 0; i < 10; i++ {
+		assert.NoError(t, m.Register(Product{ID: fmt.Sprintf("product-%d", i), Source: oxylabs.AmazonProduct, Query: "B0"}))
+	}
+
+	checked := make(map[string]int)
+	for run := 0; run < 2; run++ {
+		result := m.Run(context.Background(), &bulk.Opts{Concurrency: 1, Sampling: &bulk.Sampling{Rate: 0.5, Run: run}})
+		assert.Empty(t, result.Errors)
+		assert.Equal(t, 10, result.Stats.Total+result.Stats.Skipped)
+		for _, s := range result.Successes {
+			checked[s.Input]++
+		}
+	}
+
+	assert.Len(t, checked, 10)
+}
+
+func TestSQLStorageSerializer(t *testing.T) {
+	s, err := NewSQLStorage(nil, SQLite, "")
+	assert.NoError(t, err)
+	assert.True(t, s.text())
+
+	product := ecommerce.ProductResult{
+		Title:        "Pegasus 40",
+		Price:        ecommerce.Money{Amount: 99.5, Currency: "USD"},
+		Availability: "in_stock",
+		Identifiers:  map[string]string{"asin": "B0"},
+	}
+	for _, serializer := range []oxylabs.Serializer{oxylabs.GobSerializer{}, oxylabs.MsgpackSerializer{}} {
+		s, err := NewSQLStorage(nil, Postgres, "", &SQLStorageOpts{Serializer: serializer})
+		assert.NoError(t, err)
+		assert.False(t, s.text())
+
+		data, err := s.serializer.Marshal(product)
+		assert.NoError(t, err)
+		var decoded ecommerce.ProductResult
+		assert.NoError(t, s.serializer.Unmarshal(data, &decoded))
+		assert.Equal(t, product, decoded)
+	}
+}
+
+func TestMemoryStorageHistoryLimit(t *testing.T) {
+	s := NewMemoryStorage()
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		assert.NoError(t, s.Save(ctx, Observation{ProductID: "shoe", Product: ecommerce.ProductResult{Price: ecommerce.Money{Amount: float64(i)}}}))
+	}
+
+	tests := []struct {
+		limit int
+		want  int
+	}{
+		{limit: -1, want: 0},
+		{limit: 0, want: 0},
+		{limit: 2, want: 2},
+		{limit: 10, want: 3},
+	}
+	for _, tt := range tests {
+		history, err := s.History(ctx, "shoe", tt.limit)
+		assert.NoError(t, err)
+		assert.Len(t, history, tt.want)
+	}
+}
+
+func TestMonitorRunPoliteness(t *testing.T) {
+	doer := &countingDoer{delay: 10 * time.Millisecond}
+	m := New(doer, NewMemoryStorage())
+	for i := 0; i < 4; i++ {
+		productUrl := fmt.Sprintf("https://www.shop.com/p/%d", i)
+		assert.NoError(t, m.Register(Product{ID: fmt.Sprintf("product-%d", i), Source: oxylabs.Universal, Url: productUrl}))
+	}
+
+	result := m.Run(context.Background(), &bulk.Opts{Concurrency: 4, Politeness: &bulk.Politeness{Concurrency: 1}})
+	assert.Empty(t, result.Errors)
+	assert.Len(t, result.Successes, 4)
+	assert.Equal(t, 1, doer.maxInFlight, "the products of the same domain are checked one at a time")
+}
+
+func TestMonitorStartRegister(t *testing.T) {
+	doer := &countingDoer{}
+	m := New(doer, NewMemoryStorage())
+	m.Tick = 5 * time.Millisecond
+	assert.NoError(t, m.Register(Product{ID: "shoe", Source: oxylabs.AmazonProduct, Query: "B0", Cadence: time.Hour}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		m.Start(ctx)
+		close(done)
+	}()
+
+	// Products registered after Start are scheduled on the next tick.
+	assert.Eventually(t, func() bool { return doer.count("B0") == 1 }, time.Second, time.Millisecond)
+	assert.NoError(t, m.Register(Product{ID: "boot", Source: oxylabs.AmazonProduct, Query: "B1", Cadence: 20 * time.Millisecond}))
+	assert.Eventually(t, func() bool { return doer.count("B1") >= 2 }, time.Second, time.Millisecond)
+
+	// Unregistered products are no longer checked.
+	m.Unregister("boot")
+	time.Sleep(2 * m.Tick)
+	checks := doer.count("B1")
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, checks, doer.count("B1"))
+	assert.Equal(t, 1, doer.count("B0"), "the product is checked once per cadence")
+
+	cancel()
+	<-done
+}
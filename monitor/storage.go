@@ -0,0 +1,243 @@
+package monitor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Storage persists the observations of monitored products.
+type Storage interface {
+	// Save persists the observation.
+	Save(ctx context.Context, o Observation) error
+	// Latest returns the most recent observation of the product, or nil if there is none.
+	Latest(ctx context.Context, productID string) (*Observation, error)
+	// History returns up to limit most recent observations of the product, newest first.
+	History(ctx context.Context, productID string, limit int) ([]Observation, error)
+}
+
+// MemoryStorage keeps observations in memory.
+type MemoryStorage struct {
+	mu           sync.Mutex
+	observations map[string][]Observation
+}
+
+// NewMemoryStorage returns an empty in-memory storage.
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{observations: make(map[string][]Observation)}
+}
+
+// Save persists the observation.
+func (s *MemoryStorage) Save(ctx context.Context, o Observation) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.observations[o.ProductID] = append(s.observations[o.ProductID], o)
+	return nil
+}
+
+// Latest returns the most recent observation of the product, or nil if there is none.
+func (s *MemoryStorage) Latest(ctx context.Context, productID string) (*Observation, error) {
+	history, err := s.History(ctx, productID, 1)
+	if err != nil || len(history) == 0 {
+		return nil, err
+	}
+
+	return &history[0], nil
+}
+
+// History returns up to limit most recent observations of the product, newest first.
+func (s *MemoryStorage) History(ctx context.Context, productID string, limit int) ([]Observation, error) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	observations := s.observations[productID]
+	history := make([]Observation, 0, limit)
+	for i := len(observations) - 1; i >= 0 && len(history) < limit; i-- {
+		history = append(history, observations[i])
+	}
+
+	return history, nil
+}
+
+// Dialect is the SQL dialect of a database.
+type Dialect string
+
+const (
+	SQLite   Dialect = "sqlite"
+	Postgres Dialect = "postgres"
+)
+
+// SQLStorage persists observations in a SQLite or Postgres database
+// opened with any database/sql driver.
+type SQLStorage struct {
+	db         *sql.DB
+	dialect    Dialect
+	table      string
+	serializer oxylabs.Serializer
+}
+
+// SQLStorageOpts configures a SQLStorage. Serializer encodes the products
+// of the observations, oxylabs.JSONSerializer if nil. Products encoded by
+// other serializers are stored in a binary column.
+type SQLStorageOpts struct {
+	Serializer oxylabs.Serializer
+}
+
+// NewSQLStorage returns a storage persisting observations in the table of db.
+// The table is "observations" if empty.
+func NewSQLStorage(db *sql.DB, dialect Dialect, table string, opts ...*SQLStorageOpts) (*SQLStorage, error) {
+	if dialect != SQLite && dialect != Postgres {
+		return nil, fmt.Errorf("invalid dialect: %s", dialect)
+	}
+
+	// Prepare options.
+	opt := &SQLStorageOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	if table == "" {
+		table = "observations"
+	}
+
+	return &SQLStorage{
+		db:         db,
+		dialect:    dialect,
+		table:      table,
+		serializer: oxylabs.SerializerOrDefault(opt.Serializer),
+	}, nil
+}
+
+// Migrate creates the observations table and index if they don't exist.
+func (s *SQLStorage) Migrate(ctx context.Context) error {
+	id, product := "INTEGER PRIMARY KEY AUTOINCREMENT", "BLOB"
+	if s.dialect == Postgres {
+		id, product = "BIGSERIAL PRIMARY KEY", "BYTEA"
+	}
+	if s.text() {
+		product = "TEXT"
+	}
+
+	stmts := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	id %s,
+	product_id TEXT NOT NULL,
+	observed_at TIMESTAMP NOT NULL,
+	product %s NOT NULL
+)`, s.table, id, product),
+		fmt.Sprintf(
+			`CREATE INDEX IF NOT EXISTS %s_product_id_idx ON %s (product_id, observed_at)`,
+			s.table, s.table,
+		),
+	}
+	for _, stmt := range stmts {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("error migrating observations table: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// Save persists the observation.
+func (s *SQLStorage) Save(ctx context.Context, o Observation) error {
+	data, err := s.serializer.Marshal(o.Product)
+	if err != nil {
+		return fmt.Errorf("error marshalling product: %v", err)
+	}
+	var product interface{} = data
+	if s.text() {
+		product = string(data)
+	}
+
+	_, err = s.db.ExecContext(ctx, s.query(
+		"INSERT INTO %s (product_id, observed_at, product) VALUES (?, ?, ?)",
+	), o.ProductID, o.At.UTC(), product)
+	if err != nil {
+		return fmt.Errorf("error saving observation: %v", err)
+	}
+
+	return nil
+}
+
+// Latest returns the most recent observation of the product, or nil if there is none.
+func (s *SQLStorage) Latest(ctx context.Context, productID string) (*Observation, error) {
+	history, err := s.History(ctx, productID, 1)
+	if err != nil || len(history) == 0 {
+		return nil, err
+	}
+
+	return &history[0], nil
+}
+
+// History returns up to limit most recent observations of the product, newest first.
+func (s *SQLStorage) History(ctx context.Context, productID string, limit int) ([]Observation, error) {
+	if limit < 0 {
+		limit = 0
+	}
+
+	rows, err := s.db.QueryContext(ctx, s.query(
+		"SELECT product_id, observed_at, product FROM %s WHERE product_id = ? ORDER BY observed_at DESC, id DESC LIMIT ?",
+	), productID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("error querying observations: %v", err)
+	}
+	defer rows.Close()
+
+	var history []Observation
+	for rows.Next() {
+		var (
+			o       Observation
+			product []byte
+		)
+		if err := rows.Scan(&o.ProductID, &o.At, &product); err != nil {
+			return nil, fmt.Errorf("error scanning observation: %v", err)
+		}
+		if err := s.serializer.Unmarshal(product, &o.Product); err != nil {
+			return nil, fmt.Errorf("error unmarshalling product: %v", err)
+		}
+		history = append(history, o)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error querying observations: %v", err)
+	}
+
+	return history, nil
+}
+
+// text returns whether the products are stored as text, i.e. encoded as JSON.
+func (s *SQLStorage) text() bool {
+	_, ok := s.serializer.(oxylabs.JSONSerializer)
+	return ok
+}
+
+// query returns the query for the table, with placeholders of the dialect.
+func (s *SQLStorage) query(query string) string {
+	query = fmt.Sprintf(query, s.table)
+	if s.dialect != Postgres {
+		return query
+	}
+
+	var b strings.Builder
+	n := 0
+	for _, r := range query {
+		if r == '?' {
+			n++
+			fmt.Fprintf(&b, "$%d", n)
+			continue
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,261 @@
+package monitor
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/ecommerce"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+var testDriver = &memDriver{tables: make(map[string]*memTable)}
+
+func init() {
+	sql.Register("monitortest", testDriver)
+}
+
+// memDriver is an in-memory database/sql driver serving the statements of
+// SQLStorage, with a table per dsn.
+type memDriver struct {
+	mu     sync.Mutex
+	tables map[string]*memTable
+}
+
+func (d *memDriver) Open(dsn string) (driver.Conn, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.tables[dsn] == nil {
+		d.tables[dsn] = &memTable{}
+	}
+
+	return &memConn{table: d.tables[dsn]}, nil
+}
+
+// memTable holds the rows of the observations table and the executed queries.
+type memTable struct {
+	mu      sync.Mutex
+	rows    []memRow
+	queries []string
+}
+
+type memRow struct {
+	id         int64
+	productID  string
+	observedAt time.Time
+	product    driver.Value
+}
+
+type memConn struct {
+	table *memTable
+}
+
+func (c *memConn) Prepare(query string) (driver.Stmt, error) {
+	return &memStmt{table: c.table, query: query}, nil
+}
+
+func (c *memConn) Close() error { return nil }
+
+func (c *memConn) Begin() (driver.Tx, error) {
+	return nil, fmt.Errorf("transactions are not supported")
+}
+
+type memStmt struct {
+	table *memTable
+	query string
+}
+
+func (s *memStmt) Close() error { return nil }
+
+func (s *memStmt) NumInput() int { return -1 }
+
+func (s *memStmt) Exec(args []driver.Value) (driver.Result, error) {
+	t := s.table
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.queries = append(t.queries, s.query)
+	switch {
+	case strings.HasPrefix(s.query, "CREATE"):
+		return driver.RowsAffected(0), nil
+	case strings.HasPrefix(s.query, "INSERT"):
+		t.rows = append(t.rows, memRow{
+			id:         int64(len(t.rows) + 1),
+			productID:  args[0].(string),
+			observedAt: args[1].(time.Time),
+			product:    args[2],
+		})
+		return driver.RowsAffected(1), nil
+	}
+
+	return nil, fmt.Errorf("unsupported statement: %s", s.query)
+}
+
+func (s *memStmt) Query(args []driver.Value) (driver.Rows, error) {
+	t := s.table
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.queries = append(t.queries, s.query)
+	if !strings.HasPrefix(s.query, "SELECT") {
+		return nil, fmt.Errorf("unsupported query: %s", s.query)
+	}
+
+	var rows []memRow
+	for _, row := range t.rows {
+		if row.productID == args[0].(string) {
+			rows = append(rows, row)
+		}
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if !rows[i].observedAt.Equal(rows[j].observedAt) {
+			return rows[i].observedAt.After(rows[j].observedAt)
+		}
+		return rows[i].id > rows[j].id
+	})
+	if limit := int(args[1].(int64)); len(rows) > limit {
+		rows = rows[:limit]
+	}
+
+	return &memRows{rows: rows}, nil
+}
+
+type memRows struct {
+	rows []memRow
+}
+
+func (r *memRows) Columns() []string {
+	return []string{"product_id", "observed_at", "product"}
+}
+
+func (r *memRows) Close() error { return nil }
+
+func (r *memRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	row := r.rows[0]
+	r.rows = r.rows[1:]
+
+	dest[0], dest[1], dest[2] = row.productID, row.observedAt, row.product
+	return nil
+}
+
+// openDB opens a database of the in-memory driver with an empty table.
+func openDB(t *testing.T) (*sql.DB, *memTable) {
+	testDriver.mu.Lock()
+	dsn := fmt.Sprintf("%s-%d", t.Name(), len(testDriver.tables))
+	table := &memTable{}
+	testDriver.tables[dsn] = table
+	testDriver.mu.Unlock()
+
+	db, err := sql.Open("monitortest", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db, table
+}
+
+func TestSQLStorage(t *testing.T) {
+	tests := []struct {
+		name    string
+		dialect Dialect
+		opts    *SQLStorageOpts
+		insert  string
+	}{
+		{
+			name:    "sqlite json",
+			dialect: SQLite,
+			insert:  "INSERT INTO prices (product_id, observed_at, product) VALUES (?, ?, ?)",
+		},
+		{
+			name:    "postgres gob",
+			dialect: Postgres,
+			opts:    &SQLStorageOpts{Serializer: oxylabs.GobSerializer{}},
+			insert:  "INSERT INTO prices (product_id, observed_at, product) VALUES ($1, $2, $3)",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, table := openDB(t)
+
+			ctx := context.Background()
+			s, err := NewSQLStorage(db, tt.dialect, "prices", tt.opts)
+			if !assert.NoError(t, err) {
+				return
+			}
+			if !assert.NoError(t, s.Migrate(ctx)) {
+				return
+			}
+
+			latest, err := s.Latest(ctx, "shoe")
+			assert.NoError(t, err)
+			assert.Nil(t, latest)
+
+			at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+			for i := 0; i < 3; i++ {
+				assert.NoError(t, s.Save(ctx, Observation{
+					ProductID: "shoe",
+					At:        at.Add(time.Duration(i) * time.Hour),
+					Product:   ecommerce.ProductResult{Title: "Shoe", Price: ecommerce.Money{Amount: float64(100 + i), Currency: "USD"}},
+				}))
+			}
+			assert.NoError(t, s.Save(ctx, Observation{ProductID: "boot", At: at}))
+
+			latest, err = s.Latest(ctx, "shoe")
+			if assert.NoError(t, err) && assert.NotNil(t, latest) {
+				assert.Equal(t, 102.0, latest.Product.Price.Amount)
+				assert.Equal(t, "USD", latest.Product.Price.Currency)
+				assert.True(t, at.Add(2*time.Hour).Equal(latest.At))
+			}
+
+			history, err := s.History(ctx, "shoe", 2)
+			if assert.NoError(t, err) && assert.Len(t, history, 2) {
+				assert.Equal(t, 102.0, history[0].Product.Price.Amount)
+				assert.Equal(t, 101.0, history[1].Product.Price.Amount)
+			}
+
+			assert.Contains(t, table.queries, tt.insert)
+		})
+	}
+}
+
+func TestSQLStorageMonitor(t *testing.T) {
+	db, _ := openDB(t)
+
+	ctx := context.Background()
+	s, err := NewSQLStorage(db, Postgres, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	if !assert.NoError(t, s.Migrate(ctx)) {
+		return
+	}
+
+	m := New(&fakeDoer{prices: []float64{100, 90}}, s)
+	assert.NoError(t, m.Register(Product{ID: "shoe", Source: oxylabs.AmazonProduct, Query: "B0"}))
+
+	var events []Event
+	m.OnEvent = func(e Event) { events = append(events, e) }
+	for i := 0; i < 2; i++ {
+		_, _, err := m.Check(ctx, "shoe")
+		assert.NoError(t, err)
+	}
+
+	if assert.Len(t, events, 1) {
+		assert.Equal(t, 100.0, events[0].Old.Product.Price.Amount)
+		assert.Equal(t, -10.0, events[0].PriceDelta)
+	}
+}
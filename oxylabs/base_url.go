@@ -0,0 +1,24 @@
+package oxylabs
+
+import "context"
+
+type baseUrlKey struct{}
+
+// WithBaseUrl returns a copy of ctx directing the reqs of the scrapes made with
+// it at the base url instead of the API, e.g. a recording proxy for shadow
+// testing, without constructing a second client. The scheme and host of every
+// req url are replaced with the ones of the base url, and its path, if any,
+// is prepended to the req path.
+func WithBaseUrl(ctx context.Context, baseUrl string) context.Context {
+	return context.WithValue(ctx, baseUrlKey{}, baseUrl)
+}
+
+// BaseUrl returns the base url carried by ctx, empty if none.
+func BaseUrl(ctx context.Context) string {
+	if ctx == nil {
+		return ""
+	}
+	baseUrl, _ := ctx.Value(baseUrlKey{}).(string)
+
+	return baseUrl
+}
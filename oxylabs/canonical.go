@@ -0,0 +1,49 @@
+package oxylabs
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+)
+
+// MarshalPayload marshals the payload in its canonical form: object keys in
+// sorted order, context parameters sorted by key, numbers as given and no
+// html escaping. Equal payloads thus always marshal to the same bytes and
+// payload hash, whatever the types the parameters were set with, e.g. Domain
+// or string, and the order the context modifiers were applied in.
+func MarshalPayload(payload map[string]interface{}) ([]byte, error) {
+	// Normalize the values into their json types.
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	var normalized map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&normalized); err != nil {
+		return nil, err
+	}
+
+	// Sort the context parameters by key.
+	if params, ok := normalized["context"].([]interface{}); ok {
+		sort.SliceStable(params, func(i, j int) bool {
+			return contextKey(params[i]) < contextKey(params[j])
+		})
+	}
+
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(normalized); err != nil {
+		return nil, err
+	}
+
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// contextKey returns the key of the context parameter.
+func contextKey(param interface{}) string {
+	fields, _ := param.(map[string]interface{})
+	key, _ := fields["key"].(string)
+	return key
+}
@@ -0,0 +1,35 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMarshalPayload(t *testing.T) {
+	a, err := MarshalPayload(map[string]interface{}{
+		"source": GoogleSearch,
+		"domain": DOMAIN_DE,
+		"query":  "a&b",
+		"context": []map[string]interface{}{
+			{"key": "tbm", "value": "shop"},
+			{"key": "nfpr", "value": true},
+		},
+	})
+	assert.NoError(t, err)
+
+	b, err := MarshalPayload(map[string]interface{}{
+		"query":  "a&b",
+		"domain": "de",
+		"source": "google_search",
+		"context": []map[string]interface{}{
+			{"value": true, "key": "nfpr"},
+			{"value": "shop", "key": "tbm"},
+		},
+	})
+	assert.NoError(t, err)
+
+	want := `{"context":[{"key":"nfpr","value":true},{"key":"tbm","value":"shop"}],"domain":"de","query":"a&b","source":"google_search"}`
+	assert.Equal(t, want, string(a))
+	assert.Equal(t, want, string(b))
+}
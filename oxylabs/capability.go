@@ -0,0 +1,185 @@
+package oxylabs
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Capabilities are the payload and context parameters supported by a source.
+type Capabilities struct {
+	Params  []string
+	Context []string
+}
+
+// commonParams are the payload parameters supported by every source.
+var commonParams = []string{
+	"source", "query", "url", "callback_url", "user_agent_type",
+	"parse", "parsing_instructions", "context",
+}
+
+// renderParams are the payload parameters of the sources supporting render.
+var renderParams = []string{"render"}
+
+// sourceCapabilities are the capabilities per source, besides the common params.
+var sourceCapabilities = map[Source]Capabilities{
+	GoogleUrl: {Params: append([]string{"geo_location", "markdown"}, renderParams...)},
+	GoogleSearch: {
+		Params:  append([]string{"domain", "geo_location", "locale", "start_page", "pages", "limit", "limit_per_page"}, renderParams...),
+		Context: []string{"results_language", "filter", "nfpr", "safe_search", "fpstate", "tbm", "tbs", "limit_per_page"},
+	},
+	GoogleAds: {
+		Params:  append([]string{"domain", "geo_location", "locale", "start_page", "pages"}, renderParams...),
+		Context: []string{"results_language", "nfpr", "tbm", "tbs"},
+	},
+	GoogleImages: {
+		Params:  append([]string{"domain", "geo_location", "locale", "start_page", "pages"}, renderParams...),
+		Context: []string{"results_language", "nfpr"},
+	},
+	GoogleSuggestions: {Params: append([]string{"geo_location", "locale"}, renderParams...)},
+	GoogleHotels: {
+		Params:  append([]string{"domain", "geo_location", "locale", "start_page", "pages", "limit"}, renderParams...),
+		Context: []string{"results_language", "nfpr", "hotel_occupancy", "hotel_dates"},
+	},
+	GoogleTravelHotels: {
+		Params:  append([]string{"domain", "geo_location", "locale", "start_page"}, renderParams...),
+		Context: []string{"hotel_occupancy", "hotel_classes", "hotel_dates"},
+	},
+	GoogleTrendsExplore: {
+		Params:  []string{"geo_location"},
+		Context: []string{"search_type", "date_from", "date_to", "category_id"},
+	},
+
+	BingUrl:    {Params: append([]string{"geo_location", "markdown"}, renderParams...)},
+	BingSearch: {Params: append([]string{"domain", "geo_location", "locale", "start_page", "pages", "limit"}, renderParams...)},
+
+	GoogleShoppingUrl: {Params: append([]string{"geo_location", "markdown"}, renderParams...)},
+	GoogleShoppingSearch: {
+		Params:  append([]string{"domain", "geo_location", "locale", "results_language", "start_page", "pages", "limit"}, renderParams...),
+		Context: []string{"sort_by", "min_price", "max_price", "nfpr"},
+	},
+	GoogleShoppingProduct: {Params: append([]string{"domain", "geo_location", "locale", "results_language"}, renderParams...)},
+	GoogleShoppingPricing: {Params: append([]string{"domain", "geo_location", "locale", "results_language", "start_page", "pages"}, renderParams...)},
+
+	Wayfair:       {},
+	WayfairSearch: {Params: []string{"start_page", "pages", "limit"}},
+
+	WalmartUrl: {Params: append([]string{"markdown"}, renderParams...)},
+
+	Universal: {
+		Params: append([]string{"geo_location", "locale", "content_encoding", "parser_type", "markdown", "viewport"}, renderParams...),
+		Context: []string{
+			"content", "cookies", "follow_redirects", "headers",
+			"http_method", "session_id", "successful_status_codes",
+		},
+	},
+
+	AmazonUrl: {Params: append([]string{"markdown"}, renderParams...)},
+	AmazonSearch: {
+		Params:  append([]string{"domain", "geo_location", "start_page", "pages"}, renderParams...),
+		Context: []string{"category_id", "merchant_id"},
+	},
+	AmazonProduct: {
+		Params:  append([]string{"domain", "geo_location"}, renderParams...),
+		Context: []string{"autoselect_variant"},
+	},
+	AmazonPricing:     {Params: append([]string{"domain", "geo_location", "start_page", "pages"}, renderParams...)},
+	AmazonReviews:     {Params: append([]string{"domain", "geo_location", "start_page", "pages"}, renderParams...)},
+	AmazonQuestions:   {Params: append([]string{"domain", "geo_location"}, renderParams...)},
+	AmazonBestsellers: {Params: append([]string{"domain", "geo_location", "start_page", "pages"}, renderParams...)},
+	AmazonSellers:     {Params: append([]string{"domain", "geo_location"}, renderParams...)},
+}
+
+// CapabilitiesOf returns the capabilities of the source, including the common
+// params, and false if the source is unknown.
+func CapabilitiesOf(source Source) (Capabilities, bool) {
+	capabilities, ok := sourceCapabilities[source]
+	if !ok {
+		return Capabilities{}, false
+	}
+
+	return Capabilities{
+		Params:  append(append([]string{}, commonParams...), capabilities.Params...),
+		Context: append([]string{}, capabilities.Context...),
+	}, true
+}
+
+// SupportsParam reports whether the source supports the payload parameter.
+// Unknown sources support every parameter.
+func SupportsParam(source Source, param string) bool {
+	capabilities, ok := CapabilitiesOf(source)
+	return !ok || contains(capabilities.Params, param)
+}
+
+// SupportsContext reports whether the source supports the context parameter.
+// Unknown sources support every parameter.
+func SupportsContext(source Source, key string) bool {
+	capabilities, ok := CapabilitiesOf(source)
+	return !ok || contains(capabilities.Context, key)
+}
+
+// ValidateParams returns a validation error listing the set payload
+// parameters not supported by the source.
+func ValidateParams(source Source, params map[string]interface{}) error {
+	var errs []error
+	for _, key := range sortedKeys(params) {
+		if !isZero(params[key]) && !SupportsParam(source, key) {
+			errs = append(errs, fmt.Errorf("%s parameter is not supported by the %s source", key, source))
+		}
+	}
+
+	return NewValidationError(errs)
+}
+
+// ValidateContext returns a validation error listing the set context
+// parameters not supported by the source.
+func ValidateContext(source Source, context ContextOption) error {
+	var errs []error
+	for _, key := range sortedKeys(context) {
+		if !isZero(context[key]) && !SupportsContext(source, key) {
+			errs = append(errs, fmt.Errorf("%s context parameter is not supported by the %s source", key, source))
+		}
+	}
+
+	return NewValidationError(errs)
+}
+
+// contains reports whether the values contain the value.
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sortedKeys returns the sorted keys of m.
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// isZero reports whether the value is unset.
+func isZero(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	if v, ok := optionalBool(value); ok {
+		return v == nil
+	}
+
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	default:
+		return v.IsZero()
+	}
+}
@@ -0,0 +1,44 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateParams(t *testing.T) {
+	tests := []struct {
+		name    string
+		source  Source
+		params  map[string]interface{}
+		wantErr string
+	}{
+		{name: "supported", source: GoogleSearch, params: map[string]interface{}{"limit": 10, "domain": DOMAIN_COM}},
+		{name: "unset", source: AmazonProduct, params: map[string]interface{}{"limit": 0, "pages": nil}},
+		{name: "unsupported", source: AmazonProduct, params: map[string]interface{}{"limit": 10}, wantErr: "limit parameter is not supported by the amazon_product source"},
+		{name: "unknown source", source: Source("custom"), params: map[string]interface{}{"limit": 10}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateParams(tt.source, tt.params)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, ErrInvalidParameter)
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPayloadValidatesContext(t *testing.T) {
+	req := &Request{Source: AmazonProduct, Query: "B07FZ8S74R", Context: []func(ContextOption){ResultsLanguage("en")}}
+	_, err := req.Payload()
+	assert.ErrorIs(t, err, ErrInvalidParameter)
+	assert.ErrorContains(t, err, "results_language context parameter is not supported by the amazon_product source")
+
+	req.Context = []func(ContextOption){AutoselectVariant(Bool(true))}
+	_, err = req.Payload()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,102 @@
+package oxylabs
+
+import (
+	"bytes"
+	"strings"
+)
+
+// Classification is the classification of the content of a response,
+// telling soft failures returned with a 200 status code from real results.
+type Classification string
+
+const (
+	ClassificationOK      Classification = "ok"
+	ClassificationBlocked Classification = "blocked"
+	ClassificationCaptcha Classification = "captcha"
+	ClassificationEmpty   Classification = "empty"
+)
+
+// Classifier classifies the content of responses with heuristics. Content
+// with a captcha marker is a captcha, content with a blocked status code or
+// marker is blocked, and content shorter than MinContentLength bytes, or
+// missing, is empty. Markers are matched case-insensitively, and only in
+// raw content, since parsed content may quote them, e.g. in a product title.
+type Classifier struct {
+	CaptchaMarkers     []string
+	BlockedMarkers     []string
+	BlockedStatusCodes []int
+	MinContentLength   int
+}
+
+// DefaultClassifier returns the classifier of the markers of the common
+// captcha and anti-bot pages of the scraped sites.
+func DefaultClassifier() Classifier {
+	return Classifier{
+		CaptchaMarkers: []string{
+			"g-recaptcha",
+			"recaptcha/api",
+			"hcaptcha.com",
+			"captcha-delivery.com",
+			"/sorry/index",
+			"px-captcha",
+			"type the characters you see in this image",
+			"/errors/validatecaptcha",
+		},
+		BlockedMarkers: []string{
+			"unusual traffic from your computer network",
+			"<title>access denied</title>",
+			"attention required! | cloudflare",
+			"pardon our interruption",
+			"request blocked",
+		},
+		BlockedStatusCodes: []int{403, 429},
+	}
+}
+
+// Classify returns the classification of the raw content returned with the status code.
+func (c Classifier) Classify(statusCode int, content []byte) Classification {
+	return c.classify(statusCode, content, true)
+}
+
+// ClassifyParsed returns the classification of the parsed content returned
+// with the status code, by its status code and length only.
+func (c Classifier) ClassifyParsed(statusCode int, content []byte) Classification {
+	return c.classify(statusCode, content, false)
+}
+
+// classify returns the classification of the content returned with the
+// status code, matching the markers if set.
+func (c Classifier) classify(statusCode int, content []byte, markers bool) Classification {
+	trimmed := bytes.TrimSpace(content)
+	lower := strings.ToLower(string(trimmed))
+
+	if markers {
+		for _, marker := range c.CaptchaMarkers {
+			if strings.Contains(lower, strings.ToLower(marker)) {
+				return ClassificationCaptcha
+			}
+		}
+	}
+	for _, code := range c.BlockedStatusCodes {
+		if statusCode == code {
+			return ClassificationBlocked
+		}
+	}
+	if markers {
+		for _, marker := range c.BlockedMarkers {
+			if strings.Contains(lower, strings.ToLower(marker)) {
+				return ClassificationBlocked
+			}
+		}
+	}
+
+	switch lower {
+	case "", "null", `""`, "{}", "[]":
+		return ClassificationEmpty
+	}
+	if len(trimmed) < c.MinContentLength {
+		return ClassificationEmpty
+	}
+
+	return ClassificationOK
+}
@@ -0,0 +1,71 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifierClassify(t *testing.T) {
+	tests := []struct {
+		name       string
+		classifier Classifier
+		statusCode int
+		content    string
+		want       Classification
+	}{
+		{name: "ok", statusCode: 200, content: "<html>results</html>", want: ClassificationOK},
+		{name: "captcha", statusCode: 200, content: `<div class="g-recaptcha"></div>`, want: ClassificationCaptcha},
+		{name: "blocked marker", statusCode: 200, content: "Our systems have detected Unusual traffic from your computer network.", want: ClassificationBlocked},
+		{name: "blocked status code", statusCode: 429, content: "<html></html>", want: ClassificationBlocked},
+		{name: "empty", statusCode: 200, content: "  \n", want: ClassificationEmpty},
+		{name: "empty json", statusCode: 200, content: "null", want: ClassificationEmpty},
+		{
+			name:       "short content",
+			classifier: Classifier{MinContentLength: 100},
+			statusCode: 200,
+			content:    "<html></html>",
+			want:       ClassificationEmpty,
+		},
+		{
+			name:       "custom marker",
+			classifier: Classifier{BlockedMarkers: []string{"Go away"}},
+			statusCode: 200,
+			content:    "<h1>go away, bot</h1>",
+			want:       ClassificationBlocked,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classifier := tt.classifier
+			if classifier.CaptchaMarkers == nil && classifier.BlockedMarkers == nil && classifier.MinContentLength == 0 {
+				classifier = DefaultClassifier()
+			}
+			assert.Equal(t, tt.want, classifier.Classify(tt.statusCode, []byte(tt.content)))
+		})
+	}
+}
+
+func TestClassifierClassifyParsed(t *testing.T) {
+	classifier := DefaultClassifier()
+
+	tests := []struct {
+		name       string
+		statusCode int
+		content    string
+		want       Classification
+	}{
+		{name: "ok", statusCode: 200, content: `{"title": "Shoe"}`, want: ClassificationOK},
+		{name: "captcha marker quoted", statusCode: 200, content: `{"title": "g-recaptcha solver"}`, want: ClassificationOK},
+		{name: "blocked marker quoted", statusCode: 200, content: `{"title": "Request Blocked: a novel"}`, want: ClassificationOK},
+		{name: "blocked status code", statusCode: 403, content: `{"title": "Shoe"}`, want: ClassificationBlocked},
+		{name: "empty", statusCode: 200, content: "{}", want: ClassificationEmpty},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, classifier.ClassifyParsed(tt.statusCode, []byte(tt.content)))
+		})
+	}
+}
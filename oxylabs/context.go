@@ -7,6 +7,12 @@ type PageLimit struct {
 	Limit int `json:"limit"`
 }
 
+// Bool returns a pointer to v, for optional boolean parameters
+// where an explicit false differs from unset.
+func Bool(v bool) *bool {
+	return &v
+}
+
 type KeyValue struct {
 	Key   string `json:"key"`
 	Value string `json:"value"`
@@ -82,10 +88,13 @@ func Filter(filter int) func(ContextOption) {
 	}
 }
 
-// Nfpr sets the nfpr context option.
-func Nfpr(nfpr bool) func(ContextOption) {
+// Nfpr sets the nfpr context option, e.g. to Bool(false) to explicitly
+// send false. A nil nfpr leaves the option unset.
+func Nfpr(nfpr *bool) func(ContextOption) {
 	return func(ctx ContextOption) {
-		ctx["nfpr"] = nfpr
+		if nfpr != nil {
+			ctx["nfpr"] = *nfpr
+		}
 	}
 }
 
@@ -194,9 +203,12 @@ func MerchantId(merchantId int) func(ContextOption) {
 	}
 }
 
-// AutoselectVariant sets the autoselect_variant context option.
-func AutoselectVariant(variant bool) func(ContextOption) {
+// AutoselectVariant sets the autoselect_variant context option, e.g. to
+// Bool(false) to explicitly send false. A nil variant leaves the option unset.
+func AutoselectVariant(variant *bool) func(ContextOption) {
 	return func(ctx ContextOption) {
-		ctx["autoselect_variant"] = variant
+		if variant != nil {
+			ctx["autoselect_variant"] = *variant
+		}
 	}
 }
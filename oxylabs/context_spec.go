@@ -0,0 +1,126 @@
+package oxylabs
+
+import (
+	"reflect"
+	"sort"
+)
+
+// ContextSpec is an immutable set of context options. Unlike the modifiers
+// mutating a ContextOption map, a spec can be shared by many concurrent
+// requests, since its values are copied both when it is built and when it
+// is applied.
+type ContextSpec struct {
+	values map[string]interface{}
+}
+
+// NewContextSpec returns the spec of the context options set by the modifiers.
+func NewContextSpec(modifiers ...func(ContextOption)) ContextSpec {
+	return ContextSpec{}.With(modifiers...)
+}
+
+// With returns a copy of the spec with the context options set by the
+// modifiers added. The spec itself is left untouched.
+func (s ContextSpec) With(modifiers ...func(ContextOption)) ContextSpec {
+	context := make(ContextOption, len(s.values))
+	for key, value := range s.values {
+		context[key] = copyValue(value)
+	}
+	for _, modifier := range modifiers {
+		if modifier != nil {
+			modifier(context)
+		}
+	}
+
+	values := make(map[string]interface{}, len(context))
+	for key, value := range context {
+		values[key] = copyValue(value)
+	}
+
+	return ContextSpec{values: values}
+}
+
+// Get returns a copy of the value of the context option with the key.
+func (s ContextSpec) Get(key string) (interface{}, bool) {
+	value, ok := s.values[key]
+	return copyValue(value), ok
+}
+
+// Keys returns the sorted keys of the context options of the spec.
+func (s ContextSpec) Keys() []string {
+	keys := make([]string, 0, len(s.values))
+	for key := range s.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Len returns the number of context options of the spec.
+func (s ContextSpec) Len() int {
+	return len(s.values)
+}
+
+// Modifier returns the modifier setting the context options of the spec.
+func (s ContextSpec) Modifier() func(ContextOption) {
+	return func(ctx ContextOption) {
+		for key, value := range s.values {
+			ctx[key] = copyValue(value)
+		}
+	}
+}
+
+// Modifiers returns the spec as the Context field of the opts.
+func (s ContextSpec) Modifiers() []func(ContextOption) {
+	return []func(ContextOption){s.Modifier()}
+}
+
+// copyValue returns a deep copy of the slices, maps and pointers of value.
+func copyValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+
+	return deepCopy(reflect.ValueOf(value)).Interface()
+}
+
+// deepCopy returns a deep copy of v.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			c.SetMapIndex(iter.Key(), deepCopy(iter.Value()))
+		}
+		return c
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Elem().Type())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type()).Elem()
+		c.Set(deepCopy(v.Elem()))
+		return c
+	default:
+		return v
+	}
+}
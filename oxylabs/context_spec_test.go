@@ -0,0 +1,43 @@
+package oxylabs
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContextSpec(t *testing.T) {
+	headers := map[string]string{"Accept": "text/html"}
+	spec := NewContextSpec(Headers(headers), FollowRedirects(true))
+	headers["Accept"] = "application/json"
+
+	value, ok := spec.Get("headers")
+	assert.True(t, ok)
+	assert.Equal(t, map[string]string{"Accept": "text/html"}, value)
+	assert.Equal(t, []string{"follow_redirects", "headers"}, spec.Keys())
+
+	extended := spec.With(SessionId("abc"))
+	assert.Equal(t, 2, spec.Len())
+	assert.Equal(t, 3, extended.Len())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := &Request{Source: "universal", Url: "https://example.com", Context: spec.Modifiers()}
+			payload, err := req.Payload()
+			assert.NoError(t, err)
+			assert.Len(t, payload["context"], 2)
+
+			context := make(ContextOption)
+			spec.Modifier()(context)
+			context["headers"].(map[string]string)["Accept"] = "*/*"
+		}()
+	}
+	wg.Wait()
+
+	value, _ = spec.Get("headers")
+	assert.Equal(t, map[string]string{"Accept": "text/html"}, value)
+}
@@ -0,0 +1,102 @@
+package oxylabs
+
+import "strings"
+
+// SourceClass is the pricing class of a source.
+type SourceClass string
+
+const (
+	SourceClassSerp      SourceClass = "serp"
+	SourceClassEcommerce SourceClass = "ecommerce"
+	SourceClassUniversal SourceClass = "universal"
+)
+
+// ClassOf returns the pricing class of the source.
+func ClassOf(source Source) SourceClass {
+	switch {
+	case source == Universal:
+		return SourceClassUniversal
+	case strings.HasPrefix(string(source), "google_shopping"),
+		strings.HasPrefix(string(source), "amazon"),
+		strings.HasPrefix(string(source), "wayfair"),
+		strings.HasPrefix(string(source), "walmart"):
+		return SourceClassEcommerce
+	default:
+		return SourceClassSerp
+	}
+}
+
+// Pricing holds the rules used to estimate the cost of a request.
+// ClassUnits is the number of billable units of a single result page
+// per source class, multiplied by RenderMultiplier for rendered
+// requests and by ParseMultiplier for parsed ones.
+type Pricing struct {
+	ClassUnits       map[SourceClass]float64
+	RenderMultiplier float64
+	ParseMultiplier  float64
+}
+
+// DefaultPricing is the pricing used by EstimateCost.
+var DefaultPricing = Pricing{
+	ClassUnits: map[SourceClass]float64{
+		SourceClassSerp:      1,
+		SourceClassEcommerce: 1,
+		SourceClassUniversal: 1,
+	},
+	RenderMultiplier: 5,
+	ParseMultiplier:  1,
+}
+
+// CostEstimate is the estimated cost of a request in billable units.
+type CostEstimate struct {
+	Source Source
+	Class  SourceClass
+	Pages  int
+	Render bool
+	Parse  bool
+	Units  float64
+}
+
+// EstimateCost returns the estimated cost of the req with DefaultPricing.
+func EstimateCost(req *Request) CostEstimate {
+	return DefaultPricing.Estimate(req)
+}
+
+// Estimate returns the estimated cost of the req:
+// pages × class units × render multiplier × parse multiplier.
+func (p Pricing) Estimate(req *Request) CostEstimate {
+	source := req.Source
+	if source == "" {
+		source, _ = req.Params["source"].(Source)
+	}
+
+	estimate := CostEstimate{
+		Source: source,
+		Class:  ClassOf(source),
+		Pages:  1,
+		Parse:  req.Parse(),
+	}
+	if pages, ok := req.Params["pages"].(int); ok && pages > 1 {
+		estimate.Pages = pages
+	}
+	switch render := req.Params["render"].(type) {
+	case Render:
+		estimate.Render = render != ""
+	case string:
+		estimate.Render = render != ""
+	}
+
+	units, ok := p.ClassUnits[estimate.Class]
+	if !ok {
+		units = 1
+	}
+	estimate.Units = float64(estimate.Pages) * units
+	if estimate.Render && p.RenderMultiplier > 0 {
+		estimate.Units *= p.RenderMultiplier
+	}
+	if estimate.Parse && p.ParseMultiplier > 0 {
+		estimate.Units *= p.ParseMultiplier
+	}
+
+	return estimate
+}
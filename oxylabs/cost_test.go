@@ -0,0 +1,48 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEstimateCost(t *testing.T) {
+	pricing := Pricing{
+		ClassUnits:       map[SourceClass]float64{SourceClassSerp: 1, SourceClassEcommerce: 2},
+		RenderMultiplier: 5,
+		ParseMultiplier:  1.5,
+	}
+
+	tests := []struct {
+		name string
+		req  *Request
+		want CostEstimate
+	}{
+		{
+			name: "single page",
+			req:  &Request{Source: GoogleSearch},
+			want: CostEstimate{Source: GoogleSearch, Class: SourceClassSerp, Pages: 1, Units: 1},
+		},
+		{
+			name: "rendered pages",
+			req:  &Request{Source: GoogleSearch, Params: map[string]interface{}{"pages": 3, "render": HTML}},
+			want: CostEstimate{Source: GoogleSearch, Class: SourceClassSerp, Pages: 3, Render: true, Units: 15},
+		},
+		{
+			name: "parsed ecommerce",
+			req:  &Request{Source: AmazonProduct, Params: map[string]interface{}{"parse": true}},
+			want: CostEstimate{Source: AmazonProduct, Class: SourceClassEcommerce, Pages: 1, Parse: true, Units: 3},
+		},
+		{
+			name: "unknown class",
+			req:  &Request{Source: Universal},
+			want: CostEstimate{Source: Universal, Class: SourceClassUniversal, Pages: 1, Units: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, pricing.Estimate(tt.req))
+		})
+	}
+}
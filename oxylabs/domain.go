@@ -0,0 +1,144 @@
+package oxylabs
+
+import "strings"
+
+// googleCountryDomains maps the countries, by name and ISO code, to their Google domain.
+var googleCountryDomains = map[string]Domain{
+	"germany":        DOMAIN_DE,
+	"de":             DOMAIN_DE,
+	"united kingdom": DOMAIN_CO_UK,
+	"uk":             DOMAIN_CO_UK,
+	"gb":             DOMAIN_CO_UK,
+	"france":         DOMAIN_FR,
+	"fr":             DOMAIN_FR,
+	"spain":          DOMAIN_ES,
+	"es":             DOMAIN_ES,
+	"italy":          DOMAIN_IT,
+	"it":             DOMAIN_IT,
+	"netherlands":    DOMAIN_NL,
+	"nl":             DOMAIN_NL,
+	"poland":         DOMAIN_PL,
+	"pl":             DOMAIN_PL,
+	"brazil":         DOMAIN_COM_BR,
+	"br":             DOMAIN_COM_BR,
+	"mexico":         DOMAIN_COM_MX,
+	"mx":             DOMAIN_COM_MX,
+	"japan":          DOMAIN_CO_JP,
+	"jp":             DOMAIN_CO_JP,
+	"australia":      DOMAIN_COM_AU,
+	"au":             DOMAIN_COM_AU,
+	"canada":         DOMAIN_CA,
+	"ca":             DOMAIN_CA,
+	"india":          DOMAIN_CO_IN,
+	"in":             DOMAIN_CO_IN,
+	"switzerland":    DOMAIN_CH,
+	"ch":             DOMAIN_CH,
+	"austria":        DOMAIN_AT,
+	"at":             DOMAIN_AT,
+	"belgium":        DOMAIN_BE,
+	"be":             DOMAIN_BE,
+	"sweden":         DOMAIN_SE,
+	"se":             DOMAIN_SE,
+	"denmark":        DOMAIN_DK,
+	"dk":             DOMAIN_DK,
+	"norway":         DOMAIN_NO,
+	"no":             DOMAIN_NO,
+	"finland":        DOMAIN_FI,
+	"fi":             DOMAIN_FI,
+	"portugal":       DOMAIN_PT,
+	"pt":             DOMAIN_PT,
+	"ireland":        DOMAIN_IE,
+	"ie":             DOMAIN_IE,
+	"turkey":         DOMAIN_COM_TR,
+	"tr":             DOMAIN_COM_TR,
+	"south korea":    DOMAIN_CO_KR,
+	"kr":             DOMAIN_CO_KR,
+	"argentina":      DOMAIN_COM_AR,
+	"ar":             DOMAIN_COM_AR,
+	"czechia":        DOMAIN_CZ,
+	"czech republic": DOMAIN_CZ,
+	"cz":             DOMAIN_CZ,
+	"greece":         DOMAIN_GR,
+	"gr":             DOMAIN_GR,
+	"hungary":        DOMAIN_HU,
+	"hu":             DOMAIN_HU,
+	"romania":        DOMAIN_RO,
+	"ro":             DOMAIN_RO,
+	"south africa":   DOMAIN_CO_ZA,
+	"za":             DOMAIN_CO_ZA,
+	"singapore":      DOMAIN_COM_SG,
+	"sg":             DOMAIN_COM_SG,
+	"hong kong":      DOMAIN_COM_HK,
+	"hk":             DOMAIN_COM_HK,
+	"taiwan":         DOMAIN_COM_TW,
+	"tw":             DOMAIN_COM_TW,
+	"indonesia":      DOMAIN_CO_ID,
+	"id":             DOMAIN_CO_ID,
+	"vietnam":        DOMAIN_COM_VN,
+	"vn":             DOMAIN_COM_VN,
+	"philippines":    DOMAIN_COM_PH,
+	"ph":             DOMAIN_COM_PH,
+	"malaysia":       DOMAIN_COM_MY,
+	"my":             DOMAIN_COM_MY,
+	"new zealand":    DOMAIN_CO_NZ,
+	"nz":             DOMAIN_CO_NZ,
+	"israel":         DOMAIN_CO_IL,
+	"il":             DOMAIN_CO_IL,
+	"ukraine":        DOMAIN_UA,
+	"ua":             DOMAIN_UA,
+	"chile":          DOMAIN_CL,
+	"cl":             DOMAIN_CL,
+	"colombia":       DOMAIN_COM_CO,
+	"co":             DOMAIN_COM_CO,
+	"peru":           DOMAIN_COM_PE,
+	"pe":             DOMAIN_COM_PE,
+	"united states":  DOMAIN_COM,
+	"us":             DOMAIN_COM,
+}
+
+// googleLanguageDomains maps the languages spoken mostly in a single country to its Google domain.
+var googleLanguageDomains = map[string]Domain{
+	"de": DOMAIN_DE,
+	"fr": DOMAIN_FR,
+	"it": DOMAIN_IT,
+	"nl": DOMAIN_NL,
+	"pl": DOMAIN_PL,
+	"ja": DOMAIN_CO_JP,
+	"ko": DOMAIN_CO_KR,
+	"tr": DOMAIN_COM_TR,
+	"uk": DOMAIN_UA,
+	"id": DOMAIN_CO_ID,
+	"cs": DOMAIN_CZ,
+	"el": DOMAIN_GR,
+	"hu": DOMAIN_HU,
+	"ro": DOMAIN_RO,
+	"sv": DOMAIN_SE,
+	"da": DOMAIN_DK,
+	"fi": DOMAIN_FI,
+	"vi": DOMAIN_COM_VN,
+}
+
+// GoogleDomainFor returns the Google domain of the country of the geo location,
+// e.g. "Berlin,Germany", or else of the locale, e.g. "en-gb" or "de".
+// It returns false if neither maps to a domain.
+func GoogleDomainFor(geoLocation string, locale string) (Domain, bool) {
+	// The country is the last part of the geo location.
+	if geoLocation != "" {
+		parts := strings.Split(geoLocation, ",")
+		country := strings.ToLower(strings.TrimSpace(parts[len(parts)-1]))
+		if domain, ok := googleCountryDomains[country]; ok {
+			return domain, true
+		}
+	}
+
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if language, region, ok := strings.Cut(locale, "-"); ok {
+		if domain, ok := googleCountryDomains[region]; ok {
+			return domain, true
+		}
+		locale = language
+	}
+	domain, ok := googleLanguageDomains[locale]
+
+	return domain, ok
+}
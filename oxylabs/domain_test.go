@@ -0,0 +1,33 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGoogleDomainFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		geoLocation string
+		locale      string
+		want        Domain
+		wantOk      bool
+	}{
+		{name: "country", geoLocation: "Germany", want: DOMAIN_DE, wantOk: true},
+		{name: "city and country", geoLocation: "London,England,United Kingdom", want: DOMAIN_CO_UK, wantOk: true},
+		{name: "geo over locale", geoLocation: "France", locale: "de", want: DOMAIN_FR, wantOk: true},
+		{name: "locale region", locale: "en-GB", want: DOMAIN_CO_UK, wantOk: true},
+		{name: "locale language", locale: "de", want: DOMAIN_DE, wantOk: true},
+		{name: "ambiguous language", locale: "en"},
+		{name: "unknown geo", geoLocation: "90210"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := GoogleDomainFor(tt.geoLocation, tt.locale)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// Sentinel errors of the common failure classes, matched with errors.Is
+// against the detailed errors returned by the clients.
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+	ErrAuthentication   = errors.New("authentication failed")
+	ErrRateLimited      = errors.New("rate limited")
+	ErrJobFaulted       = errors.New("job faulted")
+	ErrTimeout          = errors.New("timeout exceeded")
+	ErrClientClosed     = errors.New("client closed")
+	ErrResponseTooLarge = errors.New("response too large")
+	ErrDeadlineTooShort = errors.New("deadline too short")
+)
+
+// ValidationError lists every invalid parameter of a request.
+// It matches ErrInvalidParameter.
+type ValidationError struct {
+	Errs []error
+}
+
+// NewValidationError returns a ValidationError of errs, or nil if errs is empty.
+func NewValidationError(errs []error) error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Errs: errs}
+}
+
+func (e *ValidationError) Error() string {
+	return errors.Join(e.Errs...).Error()
+}
+
+func (e *ValidationError) Unwrap() []error {
+	return e.Errs
+}
+
+func (e *ValidationError) Is(target error) bool {
+	return target == ErrInvalidParameter
+}
+
+// APIError is the error of a req rejected by the API or of a job that ended
+// faulted, for callers to branch on its kind. Every *StatusError and
+// *FaultedJobError returned by the clients can be read as an APIError with
+// errors.As. Err is the detailed error, matched with errors.Is.
+type APIError struct {
+	StatusCode int
+	Message    string
+	JobID      string
+	Body       []byte
+	Err        error
+}
+
+func (e *APIError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// StatusError is a non-successful http status returned by the API.
+// JobID is the job whose status or results were requested, if any.
+// It matches ErrAuthentication on 401 and 403, ErrRateLimited on 429
+// and ErrInvalidParameter on 400 and 422.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	Body       []byte
+	JobID      string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("error with status code %s: %s", e.Status, e.Body)
+}
+
+func (e *StatusError) Is(target error) bool {
+	switch target {
+	case ErrAuthentication:
+		return e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden
+	case ErrRateLimited:
+		return e.StatusCode == http.StatusTooManyRequests
+	case ErrInvalidParameter:
+		return e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity
+	}
+
+	return false
+}
+
+// As sets target to the APIError of the status, with the message of the body if any.
+func (e *StatusError) As(target any) bool {
+	apiErr, ok := target.(**APIError)
+	if !ok {
+		return false
+	}
+
+	message := e.Status
+	body := &struct {
+		Message string `json:"message"`
+	}{}
+	if json.Unmarshal(e.Body, body) == nil && body.Message != "" {
+		message = body.Message
+	}
+
+	*apiErr = &APIError{
+		StatusCode: e.StatusCode,
+		Message:    message,
+		JobID:      e.JobID,
+		Body:       e.Body,
+		Err:        e,
+	}
+	return true
+}
+
+// FaultedJobError is the error of an async job that ended faulted.
+// PayloadHash is the hash of the submitted payload, as in AuditRecord,
+// so the job can be matched with its parameters for re-submission.
+// It matches ErrJobFaulted.
+type FaultedJobError struct {
+	JobID       string
+	Source      Source
+	PayloadHash string
+	Reason      string
+}
+
+func (e *FaultedJobError) Error() string {
+	return fmt.Sprintf("job %s faulted: %s", e.JobID, e.Reason)
+}
+
+func (e *FaultedJobError) Is(target error) bool {
+	return target == ErrJobFaulted
+}
+
+// As sets target to the APIError of the job, with the fault reason as message
+// and no status code.
+func (e *FaultedJobError) As(target any) bool {
+	apiErr, ok := target.(**APIError)
+	if !ok {
+		return false
+	}
+
+	*apiErr = &APIError{
+		Message: e.Reason,
+		JobID:   e.JobID,
+		Err:     e,
+	}
+	return true
+}
+
+// PartialSubmitError is the error of a batch whose submission failed after
+// some of its jobs were submitted. JobIDs are the IDs of the submitted jobs in
+// the order of the reqs, empty for the reqs that were not submitted, so that
+// the submitted jobs can still be retrieved. Err is the submission error.
+type PartialSubmitError struct {
+	JobIDs []string
+	Err    error
+}
+
+func (e *PartialSubmitError) Error() string {
+	submitted := 0
+	for _, jobID := range e.JobIDs {
+		if jobID != "" {
+			submitted++
+		}
+	}
+
+	return fmt.Sprintf("%d of %d jobs submitted: %v", submitted, len(e.JobIDs), e.Err)
+}
+
+func (e *PartialSubmitError) Unwrap() error {
+	return e.Err
+}
+
+// ResponseTooLargeError is the error of a resp whose body exceeds the
+// max resp bytes of the client. SpillPath is the file the body was
+// streamed to, if the client has a spill dir.
+// It matches ErrResponseTooLarge.
+type ResponseTooLargeError struct {
+	Limit     int64
+	SpillPath string
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	if e.SpillPath != "" {
+		return fmt.Sprintf("resp body exceeds %d bytes, spilled to %s", e.Limit, e.SpillPath)
+	}
+	return fmt.Sprintf("resp body exceeds %d bytes", e.Limit)
+}
+
+func (e *ResponseTooLargeError) Is(target error) bool {
+	return target == ErrResponseTooLarge
+}
@@ -0,0 +1,165 @@
+package oxylabs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+// ErrSubmissionInProgress is returned for a request whose idempotency key
+// is reserved by a submission that has not stored its job yet.
+var ErrSubmissionInProgress = errors.New("submission in progress")
+
+// DefaultReservationTTL is the time a MemoryJobStore keeps the reservation of
+// a key without a stored job, e.g. of a process that crashed while submitting.
+const DefaultReservationTTL = 5 * time.Minute
+
+// JobStore stores the job IDs of the requests submitted per idempotency key,
+// so that retried submissions of the same work item reuse the job instead of
+// creating a duplicate one. Implementations shared by several processes
+// must make Reserve atomic, and should expire reservations without a stored
+// job, so that a key is not locked forever by a crashed submission.
+type JobStore interface {
+	// Load returns the job ID stored for the key.
+	Load(ctx context.Context, key string) (jobID string, ok bool, err error)
+	// Reserve reserves the key for a submission, returning false if it is
+	// already reserved and the reservation has not expired.
+	Reserve(ctx context.Context, key string) (bool, error)
+	// Store stores the job ID of the key.
+	Store(ctx context.Context, key string, jobID string) error
+	// Release releases the reservation of a key whose submission failed.
+	Release(ctx context.Context, key string) error
+}
+
+// MemoryJobStore is a JobStore kept in memory, for a single process.
+// Reservations without a stored job expire after ReservationTTL, never if 0.
+type MemoryJobStore struct {
+	ReservationTTL time.Duration
+
+	mu         sync.Mutex
+	jobIDs     map[string]string
+	reservedAt map[string]time.Time
+	now        func() time.Time
+}
+
+// NewMemoryJobStore returns an empty in-memory job store whose reservations
+// expire after DefaultReservationTTL.
+func NewMemoryJobStore() *MemoryJobStore {
+	return &MemoryJobStore{
+		ReservationTTL: DefaultReservationTTL,
+		jobIDs:         make(map[string]string),
+		reservedAt:     make(map[string]time.Time),
+		now:            time.Now,
+	}
+}
+
+func (s *MemoryJobStore) Load(_ context.Context, key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobID := s.jobIDs[key]
+	return jobID, jobID != "", nil
+}
+
+func (s *MemoryJobStore) Reserve(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if jobID, ok := s.jobIDs[key]; ok {
+		// Take over the expired reservation of a failed submission.
+		if jobID != "" || s.ReservationTTL <= 0 || s.now().Sub(s.reservedAt[key]) < s.ReservationTTL {
+			return false, nil
+		}
+	}
+	s.jobIDs[key] = ""
+	s.reservedAt[key] = s.now()
+
+	return true, nil
+}
+
+func (s *MemoryJobStore) Store(_ context.Context, key string, jobID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.jobIDs[key] = jobID
+	delete(s.reservedAt, key)
+
+	return nil
+}
+
+func (s *MemoryJobStore) Release(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.jobIDs[key] == "" {
+		delete(s.jobIDs, key)
+		delete(s.reservedAt, key)
+	}
+
+	return nil
+}
+
+// ListableJobStore is a JobStore whose entries can be listed, e.g. to reconcile
+// the stored jobs with the jobs submitted to the API.
+type ListableJobStore interface {
+	JobStore
+	// Entries returns the job IDs stored per key, empty for reserved keys
+	// whose job is not stored yet.
+	Entries(ctx context.Context) (map[string]string, error)
+}
+
+func (s *MemoryJobStore) Entries(_ context.Context) (map[string]string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries := make(map[string]string, len(s.jobIDs))
+	for key, jobID := range s.jobIDs {
+		entries[key] = jobID
+	}
+
+	return entries, nil
+}
+
+// Snapshot writes the entries of the store to w, encoded by the serializer,
+// or as JSON if it is nil, so that the store can be restored by another process.
+func (s *MemoryJobStore) Snapshot(w io.Writer, serializer Serializer) error {
+	entries, _ := s.Entries(context.Background())
+	data, err := SerializerOrDefault(serializer).Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("error marshalling job store: %v", err)
+	}
+
+	if _, err = w.Write(data); err != nil {
+		return fmt.Errorf("error writing job store: %v", err)
+	}
+
+	return nil
+}
+
+// RestoreMemoryJobStore returns an in-memory job store with the entries of
+// the snapshot read from r, decoded by the serializer it was written with.
+// The reservations of the snapshot expire after ReservationTTL from the restore.
+func RestoreMemoryJobStore(r io.Reader, serializer Serializer) (*MemoryJobStore, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading job store: %v", err)
+	}
+
+	s := NewMemoryJobStore()
+	if err = SerializerOrDefault(serializer).Unmarshal(data, &s.jobIDs); err != nil {
+		return nil, fmt.Errorf("error unmarshalling job store: %v", err)
+	}
+	if s.jobIDs == nil {
+		s.jobIDs = make(map[string]string)
+	}
+	for key, jobID := range s.jobIDs {
+		if jobID == "" {
+			s.reservedAt[key] = s.now()
+		}
+	}
+
+	return s, nil
+}
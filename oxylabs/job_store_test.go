@@ -0,0 +1,50 @@
+package oxylabs
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemoryJobStoreReservationTTL(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	store := NewMemoryJobStore()
+	store.now = func() time.Time { return now }
+
+	reserved, _ := store.Reserve(ctx, "item-1")
+	assert.True(t, reserved)
+
+	// The reservation is kept until it expires.
+	now = now.Add(DefaultReservationTTL - time.Second)
+	reserved, _ = store.Reserve(ctx, "item-1")
+	assert.False(t, reserved)
+
+	now = now.Add(time.Second)
+	reserved, _ = store.Reserve(ctx, "item-1")
+	assert.True(t, reserved)
+
+	// The lease restarts with the new reservation.
+	now = now.Add(time.Second)
+	reserved, _ = store.Reserve(ctx, "item-1")
+	assert.False(t, reserved)
+
+	// Stored jobs never expire.
+	_ = store.Store(ctx, "item-1", "job-1")
+	now = now.Add(2 * DefaultReservationTTL)
+	reserved, _ = store.Reserve(ctx, "item-1")
+	assert.False(t, reserved)
+	jobID, ok, _ := store.Load(ctx, "item-1")
+	assert.True(t, ok)
+	assert.Equal(t, "job-1", jobID)
+
+	// Reservations don't expire without a TTL.
+	store.ReservationTTL = 0
+	reserved, _ = store.Reserve(ctx, "item-2")
+	assert.True(t, reserved)
+	now = now.Add(2 * DefaultReservationTTL)
+	reserved, _ = store.Reserve(ctx, "item-2")
+	assert.False(t, reserved)
+}
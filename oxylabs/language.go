@@ -0,0 +1,104 @@
+package oxylabs
+
+import (
+	"fmt"
+	"strings"
+)
+
+// domainLanguages maps the domains to the languages of their results.
+// English is consistent with every domain, and domains not listed,
+// e.g. the international DOMAIN_COM, with every language.
+var domainLanguages = map[Domain][]string{
+	DOMAIN_DE:     {"de"},
+	DOMAIN_AT:     {"de"},
+	DOMAIN_CH:     {"de", "fr", "it"},
+	DOMAIN_FR:     {"fr"},
+	DOMAIN_BE:     {"nl", "fr", "de"},
+	DOMAIN_ES:     {"es", "ca", "eu", "gl"},
+	DOMAIN_IT:     {"it"},
+	DOMAIN_NL:     {"nl"},
+	DOMAIN_PL:     {"pl"},
+	DOMAIN_PT:     {"pt"},
+	DOMAIN_COM_BR: {"pt"},
+	DOMAIN_COM_MX: {"es"},
+	DOMAIN_COM_AR: {"es"},
+	DOMAIN_CL:     {"es"},
+	DOMAIN_COM_CO: {"es"},
+	DOMAIN_COM_PE: {"es"},
+	DOMAIN_CA:     {"fr"},
+	DOMAIN_CO_JP:  {"ja"},
+	DOMAIN_CO_KR:  {"ko"},
+	DOMAIN_COM_TW: {"zh-tw", "zh"},
+	DOMAIN_COM_HK: {"zh-tw", "zh"},
+	DOMAIN_COM_TR: {"tr"},
+	DOMAIN_UA:     {"uk", "ru"},
+	DOMAIN_CZ:     {"cs"},
+	DOMAIN_GR:     {"el"},
+	DOMAIN_HU:     {"hu"},
+	DOMAIN_RO:     {"ro"},
+	DOMAIN_SE:     {"sv"},
+	DOMAIN_DK:     {"da"},
+	DOMAIN_NO:     {"no", "nb", "nn"},
+	DOMAIN_FI:     {"fi", "sv"},
+	DOMAIN_CO_ID:  {"id"},
+	DOMAIN_COM_VN: {"vi"},
+	DOMAIN_COM_PH: {"fil", "tl"},
+	DOMAIN_COM_MY: {"ms", "zh"},
+	DOMAIN_CO_IL:  {"he", "iw", "ar"},
+}
+
+// CheckResultsLanguage returns an error if the results language is inconsistent
+// with the domain, e.g. results_language "de" with DOMAIN_CO_JP, a combination
+// that commonly yields empty parsed results. A results language matching the
+// language of the locale is consistent with any domain.
+func CheckResultsLanguage(domain Domain, locale Locale, resultsLanguage string) error {
+	language := strings.ToLower(resultsLanguage)
+	if language == "" || languageBase(language) == "en" {
+		return nil
+	}
+	if locale != "" && languageBase(strings.ToLower(string(locale))) == languageBase(language) {
+		return nil
+	}
+
+	languages, ok := domainLanguages[domain]
+	if !ok {
+		return nil
+	}
+	for _, l := range languages {
+		if l == language || l == languageBase(language) {
+			return nil
+		}
+	}
+
+	if locale != "" {
+		return fmt.Errorf(
+			"results_language %s is inconsistent with domain %s and locale %s",
+			resultsLanguage, domain, locale,
+		)
+	}
+	return fmt.Errorf("results_language %s is inconsistent with domain %s", resultsLanguage, domain)
+}
+
+// CheckResultsLanguage returns an error if the results_language of the request,
+// set either as a parameter or in the context, is inconsistent with its domain
+// and locale. See CheckResultsLanguage.
+func (r *Request) CheckResultsLanguage() error {
+	payload, err := r.Payload()
+	if err != nil {
+		return err
+	}
+
+	params := searchParams{payload: payload, context: payloadContext(payload)}
+	language := params.contextString("results_language")
+	if language == "" {
+		language = params.string("results_language")
+	}
+
+	return CheckResultsLanguage(Domain(params.string("domain")), Locale(params.string("locale")), language)
+}
+
+// languageBase returns the language of a language tag, e.g. "pt" of "pt-br".
+func languageBase(tag string) string {
+	language, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	return language
+}
@@ -0,0 +1,52 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckResultsLanguage(t *testing.T) {
+	tests := []struct {
+		name     string
+		domain   Domain
+		locale   Locale
+		language string
+		wantErr  bool
+	}{
+		{name: "unset", domain: DOMAIN_CO_JP},
+		{name: "domain language", domain: DOMAIN_CO_JP, language: "ja"},
+		{name: "english", domain: DOMAIN_CO_JP, language: "en"},
+		{name: "language region", domain: DOMAIN_COM_BR, language: "pt-BR"},
+		{name: "international domain", domain: DOMAIN_COM, language: "de"},
+		{name: "locale language", domain: DOMAIN_CO_JP, locale: "de-de", language: "de"},
+		{name: "mismatch", domain: DOMAIN_CO_JP, language: "de", wantErr: true},
+		{name: "mismatch with locale", domain: DOMAIN_CO_JP, locale: "ja", language: "de", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckResultsLanguage(tt.domain, tt.locale, tt.language)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRequest_CheckResultsLanguage(t *testing.T) {
+	req := &Request{
+		Source:  GoogleSearch,
+		Query:   "adidas",
+		Params:  map[string]interface{}{"domain": DOMAIN_CO_JP},
+		Context: []func(ContextOption){ResultsLanguage("de")},
+	}
+	assert.ErrorContains(t, req.CheckResultsLanguage(), "results_language de is inconsistent with domain co.jp")
+
+	req.Source = GoogleShoppingSearch
+	req.Params["results_language"] = "ja"
+	req.Context = nil
+	assert.NoError(t, req.CheckResultsLanguage())
+}
@@ -0,0 +1,120 @@
+package oxylabs
+
+import "strings"
+
+// countryLocales maps the countries, by name and ISO code, to the locale of
+// their main language.
+var countryLocales = map[string]Locale{
+	"germany":        "de-DE",
+	"de":             "de-DE",
+	"united kingdom": "en-GB",
+	"uk":             "en-GB",
+	"gb":             "en-GB",
+	"france":         "fr-FR",
+	"fr":             "fr-FR",
+	"spain":          "es-ES",
+	"es":             "es-ES",
+	"italy":          "it-IT",
+	"it":             "it-IT",
+	"netherlands":    "nl-NL",
+	"nl":             "nl-NL",
+	"poland":         "pl-PL",
+	"pl":             "pl-PL",
+	"brazil":         "pt-BR",
+	"br":             "pt-BR",
+	"mexico":         "es-MX",
+	"mx":             "es-MX",
+	"japan":          "ja-JP",
+	"jp":             "ja-JP",
+	"australia":      "en-AU",
+	"au":             "en-AU",
+	"canada":         "en-CA",
+	"ca":             "en-CA",
+	"india":          "en-IN",
+	"in":             "en-IN",
+	"switzerland":    "de-CH",
+	"ch":             "de-CH",
+	"austria":        "de-AT",
+	"at":             "de-AT",
+	"belgium":        "nl-BE",
+	"be":             "nl-BE",
+	"sweden":         "sv-SE",
+	"se":             "sv-SE",
+	"denmark":        "da-DK",
+	"dk":             "da-DK",
+	"norway":         "nb-NO",
+	"no":             "nb-NO",
+	"finland":        "fi-FI",
+	"fi":             "fi-FI",
+	"portugal":       "pt-PT",
+	"pt":             "pt-PT",
+	"ireland":        "en-IE",
+	"ie":             "en-IE",
+	"turkey":         "tr-TR",
+	"tr":             "tr-TR",
+	"south korea":    "ko-KR",
+	"kr":             "ko-KR",
+	"argentina":      "es-AR",
+	"ar":             "es-AR",
+	"czechia":        "cs-CZ",
+	"czech republic": "cs-CZ",
+	"cz":             "cs-CZ",
+	"greece":         "el-GR",
+	"gr":             "el-GR",
+	"hungary":        "hu-HU",
+	"hu":             "hu-HU",
+	"romania":        "ro-RO",
+	"ro":             "ro-RO",
+	"south africa":   "en-ZA",
+	"za":             "en-ZA",
+	"singapore":      "en-SG",
+	"sg":             "en-SG",
+	"hong kong":      "zh-HK",
+	"hk":             "zh-HK",
+	"taiwan":         "zh-TW",
+	"tw":             "zh-TW",
+	"indonesia":      "id-ID",
+	"id":             "id-ID",
+	"vietnam":        "vi-VN",
+	"vn":             "vi-VN",
+	"philippines":    "en-PH",
+	"ph":             "en-PH",
+	"malaysia":       "ms-MY",
+	"my":             "ms-MY",
+	"new zealand":    "en-NZ",
+	"nz":             "en-NZ",
+	"israel":         "he-IL",
+	"il":             "he-IL",
+	"ukraine":        "uk-UA",
+	"ua":             "uk-UA",
+	"chile":          "es-CL",
+	"cl":             "es-CL",
+	"colombia":       "es-CO",
+	"co":             "es-CO",
+	"peru":           "es-PE",
+	"pe":             "es-PE",
+	"united states":  "en-US",
+	"us":             "en-US",
+}
+
+// LocaleFor returns the locale of the main language of the country of the geo
+// location, e.g. "de-DE" for "Berlin,Germany". Multilingual countries map to
+// the language of most of their population, e.g. "de-CH" for Switzerland.
+// ISO codes only match a geo location made of the code alone, e.g. "FR", since
+// the last part of a longer geo location may be a state, e.g. "Chicago, IL".
+// It returns false if the country is unknown.
+func LocaleFor(geoLocation string) (Locale, bool) {
+	if geoLocation == "" {
+		return "", false
+	}
+
+	// The country is the last part of the geo location.
+	parts := strings.Split(geoLocation, ",")
+	country := strings.ToLower(strings.TrimSpace(parts[len(parts)-1]))
+	if len(country) == 2 && len(parts) > 1 {
+		return "", false
+	}
+	locale, ok := countryLocales[country]
+
+	return locale, ok
+}
@@ -0,0 +1,36 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLocaleFor(t *testing.T) {
+	tests := []struct {
+		name        string
+		geoLocation string
+		want        Locale
+		wantOk      bool
+	}{
+		{name: "country", geoLocation: "Germany", want: "de-DE", wantOk: true},
+		{name: "city and country", geoLocation: "London,England,United Kingdom", want: "en-GB", wantOk: true},
+		{name: "iso code", geoLocation: "FR", want: "fr-FR", wantOk: true},
+		{name: "iso code after city", geoLocation: "Paris, FR"},
+		{name: "illinois", geoLocation: "Chicago, IL"},
+		{name: "colorado", geoLocation: "Denver, CO"},
+		{name: "california", geoLocation: "Los Angeles, CA"},
+		{name: "idaho", geoLocation: "Boise, ID"},
+		{name: "us city", geoLocation: "Chicago,Illinois,United States", want: "en-US", wantOk: true},
+		{name: "unknown geo", geoLocation: "90210"},
+		{name: "empty", geoLocation: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := LocaleFor(tt.geoLocation)
+			assert.Equal(t, tt.wantOk, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package oxylabs
+
+import "context"
+
+type requestMetadataKey struct{}
+
+// WithRequestMetadata returns a copy of ctx carrying the metadata, merged into
+// the metadata ctx already carries. The metadata of the scrapes made with the
+// ctx is surfaced to the hooks of the client, e.g. in the audit records, so
+// that scrapes can be attributed, e.g. to a tenant or campaign.
+func WithRequestMetadata(ctx context.Context, metadata map[string]string) context.Context {
+	merged := RequestMetadata(ctx)
+	if merged == nil {
+		merged = make(map[string]string, len(metadata))
+	}
+	for k, v := range metadata {
+		merged[k] = v
+	}
+
+	return context.WithValue(ctx, requestMetadataKey{}, merged)
+}
+
+// RequestMetadata returns a copy of the metadata carried by ctx, nil if none.
+// Hooks, e.g. the transport of the http client, can read the metadata of the
+// reqs of the client with RequestMetadata(req.Context()).
+func RequestMetadata(ctx context.Context) map[string]string {
+	if ctx == nil {
+		return nil
+	}
+	metadata, ok := ctx.Value(requestMetadataKey{}).(map[string]string)
+	if !ok {
+		return nil
+	}
+
+	copied := make(map[string]string, len(metadata))
+	for k, v := range metadata {
+		copied[k] = v
+	}
+
+	return copied
+}
@@ -0,0 +1,252 @@
+package oxylabs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+)
+
+var (
+	errMsgpackShortData    = errors.New("msgpack: unexpected end of data")
+	errMsgpackTrailingData = errors.New("msgpack: trailing data")
+)
+
+// encodeMsgpack writes the MessagePack encoding of the json value, as decoded
+// with numbers as json.Number, to buf. Map keys are written in sorted order.
+func encodeMsgpack(buf *bytes.Buffer, value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		buf.WriteByte(0xc0)
+	case bool:
+		if v {
+			buf.WriteByte(0xc3)
+		} else {
+			buf.WriteByte(0xc2)
+		}
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			encodeMsgpackInt(buf, i)
+			return nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return fmt.Errorf("msgpack: invalid number %s", v)
+		}
+		buf.WriteByte(0xcb)
+		_ = binary.Write(buf, binary.BigEndian, math.Float64bits(f))
+	case string:
+		encodeMsgpackLen(buf, len(v), 0xa0, 31, 0xd9, 0xda, 0xdb)
+		buf.WriteString(v)
+	case []interface{}:
+		encodeMsgpackLen(buf, len(v), 0x90, 15, 0, 0xdc, 0xdd)
+		for _, item := range v {
+			if err := encodeMsgpack(buf, item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		encodeMsgpackLen(buf, len(v), 0x80, 15, 0, 0xde, 0xdf)
+		for _, key := range keys {
+			_ = encodeMsgpack(buf, key)
+			if err := encodeMsgpack(buf, v[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("msgpack: unsupported type %T", value)
+	}
+
+	return nil
+}
+
+// encodeMsgpackInt writes i in its shortest MessagePack encoding.
+func encodeMsgpackInt(buf *bytes.Buffer, i int64) {
+	switch {
+	case i >= 0 && i <= math.MaxInt8:
+		buf.WriteByte(byte(i))
+	case i < 0 && i >= -32:
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt8 && i <= math.MaxInt8:
+		buf.WriteByte(0xd0)
+		buf.WriteByte(byte(int8(i)))
+	case i >= math.MinInt16 && i <= math.MaxInt16:
+		buf.WriteByte(0xd1)
+		_ = binary.Write(buf, binary.BigEndian, int16(i))
+	case i >= math.MinInt32 && i <= math.MaxInt32:
+		buf.WriteByte(0xd2)
+		_ = binary.Write(buf, binary.BigEndian, int32(i))
+	default:
+		buf.WriteByte(0xd3)
+		_ = binary.Write(buf, binary.BigEndian, i)
+	}
+}
+
+// encodeMsgpackLen writes the header of a string, array or map of length n,
+// as the fix format up to fixMax and as the 8, 16 or 32 bit format otherwise.
+// A zero format8 means the type has no 8 bit format.
+func encodeMsgpackLen(buf *bytes.Buffer, n int, fix byte, fixMax int, format8, format16, format32 byte) {
+	switch {
+	case n <= fixMax:
+		buf.WriteByte(fix | byte(n))
+	case format8 != 0 && n <= math.MaxUint8:
+		buf.WriteByte(format8)
+		buf.WriteByte(byte(n))
+	case n <= math.MaxUint16:
+		buf.WriteByte(format16)
+		_ = binary.Write(buf, binary.BigEndian, uint16(n))
+	default:
+		buf.WriteByte(format32)
+		_ = binary.Write(buf, binary.BigEndian, uint32(n))
+	}
+}
+
+// decodeMsgpack decodes the first MessagePack value of data into its json
+// value and returns it with the rest of data.
+func decodeMsgpack(data []byte) (interface{}, []byte, error) {
+	if len(data) == 0 {
+		return nil, nil, errMsgpackShortData
+	}
+
+	b, data := data[0], data[1:]
+	switch {
+	case b <= 0x7f:
+		return json.Number(fmt.Sprint(b)), data, nil
+	case b >= 0xe0:
+		return json.Number(fmt.Sprint(int8(b))), data, nil
+	case b&0xe0 == 0xa0:
+		return decodeMsgpackString(data, int(b&0x1f))
+	case b&0xf0 == 0x90:
+		return decodeMsgpackArray(data, int(b&0x0f))
+	case b&0xf0 == 0x80:
+		return decodeMsgpackMap(data, int(b&0x0f))
+	}
+
+	switch b {
+	case 0xc0:
+		return nil, data, nil
+	case 0xc2:
+		return false, data, nil
+	case 0xc3:
+		return true, data, nil
+	case 0xca:
+		bits, data, err := decodeMsgpackUint(data, 4)
+		return json.Number(fmt.Sprint(math.Float32frombits(uint32(bits)))), data, err
+	case 0xcb:
+		bits, data, err := decodeMsgpackUint(data, 8)
+		return json.Number(fmt.Sprint(math.Float64frombits(bits))), data, err
+	case 0xcc, 0xcd, 0xce, 0xcf:
+		i, data, err := decodeMsgpackUint(data, 1<<(b-0xcc))
+		return json.Number(fmt.Sprint(i)), data, err
+	case 0xd0:
+		i, data, err := decodeMsgpackUint(data, 1)
+		return json.Number(fmt.Sprint(int8(i))), data, err
+	case 0xd1:
+		i, data, err := decodeMsgpackUint(data, 2)
+		return json.Number(fmt.Sprint(int16(i))), data, err
+	case 0xd2:
+		i, data, err := decodeMsgpackUint(data, 4)
+		return json.Number(fmt.Sprint(int32(i))), data, err
+	case 0xd3:
+		i, data, err := decodeMsgpackUint(data, 8)
+		return json.Number(fmt.Sprint(int64(i))), data, err
+	case 0xd9, 0xda, 0xdb, 0xc4, 0xc5, 0xc6:
+		size := 1 << ((b - 0xd9) % 3)
+		if b <= 0xc6 {
+			size = 1 << (b - 0xc4)
+		}
+		n, data, err := decodeMsgpackUint(data, size)
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgpackString(data, int(n))
+	case 0xdc, 0xdd:
+		n, data, err := decodeMsgpackUint(data, 2<<(b-0xdc))
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgpackArray(data, int(n))
+	case 0xde, 0xdf:
+		n, data, err := decodeMsgpackUint(data, 2<<(b-0xde))
+		if err != nil {
+			return nil, nil, err
+		}
+		return decodeMsgpackMap(data, int(n))
+	}
+
+	return nil, nil, fmt.Errorf("msgpack: unsupported format 0x%x", b)
+}
+
+// decodeMsgpackUint decodes the big endian unsigned integer of size bytes.
+func decodeMsgpackUint(data []byte, size int) (uint64, []byte, error) {
+	if len(data) < size {
+		return 0, nil, errMsgpackShortData
+	}
+
+	var i uint64
+	for _, b := range data[:size] {
+		i = i<<8 | uint64(b)
+	}
+
+	return i, data[size:], nil
+}
+
+// decodeMsgpackString decodes the string of n bytes.
+func decodeMsgpackString(data []byte, n int) (interface{}, []byte, error) {
+	if n < 0 || len(data) < n {
+		return nil, nil, errMsgpackShortData
+	}
+
+	return string(data[:n]), data[n:], nil
+}
+
+// decodeMsgpackArray decodes the array of n values.
+func decodeMsgpackArray(data []byte, n int) (interface{}, []byte, error) {
+	if n < 0 || len(data) < n {
+		return nil, nil, errMsgpackShortData
+	}
+
+	values := make([]interface{}, 0, n)
+	for i := 0; i < n; i++ {
+		value, rest, err := decodeMsgpack(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		values = append(values, value)
+		data = rest
+	}
+
+	return values, data, nil
+}
+
+// decodeMsgpackMap decodes the map of n string keyed values.
+func decodeMsgpackMap(data []byte, n int) (interface{}, []byte, error) {
+	if n < 0 || len(data) < 2*n {
+		return nil, nil, errMsgpackShortData
+	}
+
+	values := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		key, rest, err := decodeMsgpack(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		value, rest, err := decodeMsgpack(rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		values[fmt.Sprint(key)] = value
+		data = rest
+	}
+
+	return values, data, nil
+}
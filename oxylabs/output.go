@@ -0,0 +1,86 @@
+package oxylabs
+
+import "fmt"
+
+// Output is the output format of a request, replacing the combinations of
+// the parse and render parameters with an explicit choice.
+type Output string
+
+const (
+	// OutputRaw is the raw html of the page.
+	OutputRaw Output = "raw"
+	// OutputParsed is the content parsed into structured data.
+	OutputParsed Output = "parsed"
+	// OutputScreenshot is a png screenshot of the rendered page.
+	OutputScreenshot Output = "screenshot"
+	// OutputMarkdown is the page converted to markdown by the API.
+	OutputMarkdown Output = "markdown"
+)
+
+// sourceOutputs are the output formats supported per source, besides OutputRaw.
+var sourceOutputs = map[Source][]Output{
+	GoogleUrl:          {OutputParsed, OutputScreenshot, OutputMarkdown},
+	GoogleSearch:       {OutputParsed, OutputScreenshot},
+	GoogleAds:          {OutputParsed, OutputScreenshot},
+	GoogleImages:       {OutputParsed, OutputScreenshot},
+	GoogleSuggestions:  {OutputScreenshot},
+	GoogleHotels:       {OutputScreenshot},
+	GoogleTravelHotels: {OutputScreenshot},
+
+	BingUrl:    {OutputParsed, OutputScreenshot, OutputMarkdown},
+	BingSearch: {OutputParsed, OutputScreenshot},
+
+	GoogleShoppingUrl:     {OutputParsed, OutputScreenshot, OutputMarkdown},
+	GoogleShoppingSearch:  {OutputParsed, OutputScreenshot},
+	GoogleShoppingProduct: {OutputParsed, OutputScreenshot},
+	GoogleShoppingPricing: {OutputParsed, OutputScreenshot},
+
+	WalmartUrl: {OutputParsed, OutputScreenshot, OutputMarkdown},
+	Universal:  {OutputParsed, OutputScreenshot, OutputMarkdown},
+
+	AmazonUrl:         {OutputParsed, OutputScreenshot, OutputMarkdown},
+	AmazonSearch:      {OutputParsed, OutputScreenshot},
+	AmazonProduct:     {OutputParsed, OutputScreenshot},
+	AmazonPricing:     {OutputParsed, OutputScreenshot},
+	AmazonReviews:     {OutputParsed, OutputScreenshot},
+	AmazonQuestions:   {OutputParsed, OutputScreenshot},
+	AmazonBestsellers: {OutputParsed, OutputScreenshot},
+	AmazonSellers:     {OutputParsed, OutputScreenshot},
+}
+
+// IsOutputValid checks if the output format is valid.
+func IsOutputValid(output Output) bool {
+	switch output {
+	case OutputRaw, OutputParsed, OutputScreenshot, OutputMarkdown:
+		return true
+	default:
+		return false
+	}
+}
+
+// SupportsOutput reports whether the source supports the output format.
+func SupportsOutput(source Source, output Output) bool {
+	if output == OutputRaw {
+		return true
+	}
+	for _, supported := range sourceOutputs[source] {
+		if supported == output {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ValidateOutput returns a validation error if the output format is invalid
+// or not supported by the source.
+func ValidateOutput(source Source, output Output) error {
+	switch {
+	case !IsOutputValid(output):
+		return NewValidationError([]error{fmt.Errorf("invalid output parameter: %s", output)})
+	case !SupportsOutput(source, output):
+		return NewValidationError([]error{fmt.Errorf("output %s is not supported by the %s source", output, source)})
+	}
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package oxylabs
+
+import "log"
+
+// PagesGuard guards sync clients against requests too costly to complete in
+// time, e.g. many rendered pages. Requests whose estimated cost per Pricing,
+// DefaultPricing if unset, exceeds MaxUnits are logged with Logger, or the
+// default logger if unset. If Split is set, query based requests are instead
+// split into single page async jobs, whose results are merged.
+type PagesGuard struct {
+	MaxUnits float64
+	Split    bool
+	Pricing  *Pricing
+	Logger   *log.Logger
+}
@@ -0,0 +1,9 @@
+package oxylabs
+
+// PageHint is the next page hint carried by the parsed content of a page.
+// Url is set if the content carries the url of the next page,
+// Page if it carries the number of the next page.
+type PageHint struct {
+	Url  string
+	Page int
+}
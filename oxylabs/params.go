@@ -0,0 +1,54 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// NormalizeParams converts the parameters of a decoded JSON or YAML payload
+// into the types the request constructors set: whole numbers become int and
+// other numbers float64, and parsing_instructions are decoded into their
+// typed form, e.g. _fns into []Fn. It returns an error if the parsing
+// instructions are malformed.
+func NormalizeParams(params map[string]interface{}) error {
+	for k, v := range params {
+		if k == "parsing_instructions" {
+			instructions, err := decodeParseInstructions(v)
+			if err != nil {
+				return NewValidationError([]error{fmt.Errorf("invalid parse instructions: %w", err)})
+			}
+			params[k] = instructions
+			continue
+		}
+		params[k] = normalizeValue(v)
+	}
+
+	return nil
+}
+
+// normalizeValue returns v with its json.Number values converted to int or
+// float64, recursively.
+func normalizeValue(v interface{}) interface{} {
+	switch v := v.(type) {
+	case json.Number:
+		if n, err := v.Int64(); err == nil {
+			return int(n)
+		}
+		if f, err := v.Float64(); err == nil {
+			return f
+		}
+		return v.String()
+	case map[string]interface{}:
+		for k, entry := range v {
+			v[k] = normalizeValue(entry)
+		}
+		return v
+	case []interface{}:
+		for i, entry := range v {
+			v[i] = normalizeValue(entry)
+		}
+		return v
+	}
+
+	return v
+}
@@ -228,3 +228,76 @@ func validateListStringOptionalInt(args any) error {
 
 	return nil
 }
+
+// decodeParseInstructions returns the generically decoded parse instructions v
+// in their typed form, with their functions as []Fn and the arguments of
+// functions expecting a list of strings as []string.
+func decodeParseInstructions(v interface{}) (map[string]interface{}, error) {
+	instructions, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid parse instructions format")
+	}
+
+	decoded := make(map[string]interface{}, len(instructions))
+	for k, v := range instructions {
+		if k != "_fns" {
+			nested, err := decodeParseInstructions(v)
+			if err != nil {
+				return nil, err
+			}
+			decoded[k] = nested
+			continue
+		}
+
+		// Keep functions that are typed already.
+		list, ok := v.([]interface{})
+		if !ok {
+			decoded[k] = v
+			continue
+		}
+
+		fns := make([]Fn, 0, len(list))
+		for _, entry := range list {
+			fields, ok := entry.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("invalid _fns format")
+			}
+			if _, ok := fields["_fn"]; !ok {
+				return nil, fmt.Errorf("_fn must be set")
+			}
+			name, ok := fields["_fn"].(string)
+			if !ok {
+				return nil, fmt.Errorf("_fn must be string")
+			}
+			fns = append(fns, Fn{Name: FnName(name), Args: decodeFnArgs(FnName(name), fields["_args"])})
+		}
+		decoded[k] = fns
+	}
+
+	return decoded, nil
+}
+
+// decodeFnArgs returns the generically decoded args of the function name in
+// the type its validation expects.
+func decodeFnArgs(name FnName, args interface{}) interface{} {
+	args = normalizeValue(args)
+
+	switch name {
+	case Xpath, XpathOne, Css, CssOne:
+		list, ok := args.([]interface{})
+		if !ok {
+			return args
+		}
+		strs := make([]string, 0, len(list))
+		for _, entry := range list {
+			str, ok := entry.(string)
+			if !ok {
+				return args
+			}
+			strs = append(strs, str)
+		}
+		return strs
+	}
+
+	return args
+}
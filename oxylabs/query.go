@@ -0,0 +1,77 @@
+package oxylabs
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxQueryLength is the max length of a query in characters.
+const MaxQueryLength = 2048
+
+// SanitizeQuery returns the query without control characters,
+// with runs of whitespace collapsed into single spaces and trimmed.
+func SanitizeQuery(query string) string {
+	query = strings.Map(func(r rune) rune {
+		switch {
+		case r == utf8.RuneError:
+			return -1
+		case unicode.IsSpace(r):
+			return ' '
+		case unicode.IsControl(r):
+			return -1
+		}
+		return r
+	}, query)
+
+	return strings.Join(strings.Fields(query), " ")
+}
+
+// ValidateQuery checks that the query is not empty, contains no control
+// characters and does not exceed MaxQueryLength.
+func ValidateQuery(query string) error {
+	var errs []error
+
+	if strings.TrimSpace(query) == "" {
+		errs = append(errs, fmt.Errorf("query parameter is empty"))
+	}
+	if !utf8.ValidString(query) {
+		errs = append(errs, fmt.Errorf("query parameter is not valid UTF-8"))
+	}
+	if strings.IndexFunc(query, func(r rune) bool { return unicode.IsControl(r) && !unicode.IsSpace(r) }) >= 0 {
+		errs = append(errs, fmt.Errorf("query parameter contains control characters"))
+	}
+	if n := utf8.RuneCountInString(query); n > MaxQueryLength {
+		errs = append(errs, fmt.Errorf("query parameter exceeds %d characters: %d", MaxQueryLength, n))
+	}
+
+	return NewValidationError(errs)
+}
+
+// IsUrlQuery returns whether the query is a url, which is likely meant
+// to be scraped with the url source instead of searched for.
+func IsUrlQuery(query string) bool {
+	query = strings.TrimSpace(query)
+	if strings.ContainsAny(query, " \t\n") {
+		return false
+	}
+
+	parsedUrl, err := url.ParseRequestURI(query)
+	if err == nil && parsedUrl.Host != "" && (parsedUrl.Scheme == "http" || parsedUrl.Scheme == "https") {
+		return true
+	}
+
+	return strings.HasPrefix(strings.ToLower(query), "www.")
+}
+
+// CheckQuery returns an error if the query of the request is a url, see
+// IsUrlQuery. The query of google_images is the url of an image and is not checked.
+func (r *Request) CheckQuery() error {
+	if r.Source == GoogleImages || !IsUrlQuery(r.Query) {
+		return nil
+	}
+
+	return fmt.Errorf("query parameter is a url, which %s searches for instead of scraping: %s", r.Source, r.Query)
+}
@@ -0,0 +1,55 @@
+package oxylabs
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizeQuery(t *testing.T) {
+	assert.Equal(t, "adidas shoes", SanitizeQuery("  adidas\x00\t\n shoes\x1b "))
+	assert.Equal(t, "nike", SanitizeQuery("nike"))
+}
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{name: "valid", query: "adidas shoes"},
+		{name: "empty", query: "  ", wantErr: true},
+		{name: "control characters", query: "adidas\x00", wantErr: true},
+		{name: "too long", query: strings.Repeat("a", MaxQueryLength+1), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateQuery(tt.query)
+			assert.Equal(t, tt.wantErr, err != nil)
+			if tt.wantErr {
+				assert.True(t, errors.Is(err, ErrInvalidParameter))
+			}
+		})
+	}
+}
+
+func TestIsUrlQuery(t *testing.T) {
+	assert.True(t, IsUrlQuery("https://www.example.com/shoes"))
+	assert.True(t, IsUrlQuery("www.example.com"))
+	assert.False(t, IsUrlQuery("adidas shoes"))
+	assert.False(t, IsUrlQuery("example.com"))
+}
+
+func TestRequestCheckQuery(t *testing.T) {
+	req := &Request{Source: GoogleShoppingSearch, Query: "https://www.example.com/shoes"}
+	assert.ErrorContains(t, req.CheckQuery(), "query parameter is a url")
+
+	req = &Request{Source: GoogleShoppingSearch, Query: "adidas shoes"}
+	assert.NoError(t, req.CheckQuery())
+
+	req = &Request{Source: GoogleImages, Query: "https://www.example.com/shoe.png"}
+	assert.NoError(t, req.CheckQuery(), "the query of google_images is an image url")
+}
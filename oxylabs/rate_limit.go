@@ -0,0 +1,9 @@
+package oxylabs
+
+// RateLimit is the pace of the jobs submitted with a set of credentials:
+// up to RequestsPerSecond jobs per second, in bursts of up to Burst jobs.
+// A Burst of 0 allows bursts of a single job.
+type RateLimit struct {
+	RequestsPerSecond float64
+	Burst             int
+}
@@ -0,0 +1,65 @@
+package oxylabs
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// ParsePayload returns the request of a previously submitted payload, e.g. the
+// Payload of an AuditRecord, whose parameters are sent as is when it is replayed.
+// It returns a ValidationError if the payload has no source, sets parameters
+// not supported by its source, or is a batch payload with a list of queries or
+// urls, which is resubmitted with SubmitBatch instead.
+func ParsePayload(raw []byte) (*Request, error) {
+	// Decode numbers as given, so that whole numbers stay ints.
+	var params map[string]interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&params); err != nil {
+		return nil, fmt.Errorf("error unmarshalling payload: %v", err)
+	}
+	if params == nil {
+		return nil, NewValidationError([]error{fmt.Errorf("payload is empty")})
+	}
+
+	for _, key := range []string{"query", "url"} {
+		if value, ok := params[key]; ok {
+			if _, ok := value.(string); !ok {
+				return nil, NewValidationError([]error{fmt.Errorf("%s must be a string, submit batch payloads with SubmitBatch", key)})
+			}
+		}
+	}
+	if err := NormalizeParams(params); err != nil {
+		return nil, err
+	}
+
+	source, _ := params["source"].(string)
+	delete(params, "source")
+	req := &Request{Source: Source(source), Params: params}
+
+	// Keep the context parameters in the form of the payload of a request.
+	if context, ok := params["context"].([]interface{}); ok {
+		contextParams := make([]map[string]interface{}, 0, len(context))
+		contextOption := make(ContextOption, len(context))
+		for _, param := range context {
+			fields, ok := param.(map[string]interface{})
+			if !ok {
+				return nil, NewValidationError([]error{fmt.Errorf("context parameter is not an object")})
+			}
+			contextParams = append(contextParams, fields)
+			contextOption[contextKey(fields)] = fields["value"]
+		}
+		if err := ValidateContext(req.Source, contextOption); err != nil {
+			return nil, err
+		}
+		params["context"] = contextParams
+	}
+
+	// Validate the payload.
+	if _, err := req.Payload(); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
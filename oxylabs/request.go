@@ -0,0 +1,201 @@
+package oxylabs
+
+import (
+	"fmt"
+	"time"
+)
+
+// Request is a single scrape request to the Oxylabs Scraper APIs.
+// Params contains any payload parameter not covered by the other fields
+// and is sent as is. FireAndForget makes async clients return as soon as
+// a job with a callback_url is submitted, instead of polling it.
+// IdempotencyKey identifies the work item of the request, so that async
+// clients with a job store don't submit it twice. BaseUrl, if set, directs
+// the request at an alternate base url, see WithBaseUrl. Session, if set, sends
+// the current id of the session as the session_id context parameter, which only
+// the sources supporting it accept, see SupportsContext.
+type Request struct {
+	Source            Source
+	Query             string
+	Url               string
+	Params            map[string]interface{}
+	Context           []func(ContextOption)
+	Session           *Session
+	ParseInstructions *map[string]interface{}
+	PollInterval      time.Duration
+	FireAndForget     bool
+	IdempotencyKey    string
+	BaseUrl           string
+}
+
+// Parse returns whether the request asks for parsed results.
+func (r *Request) Parse() bool {
+	switch parse := r.Params["parse"].(type) {
+	case bool:
+		return parse
+	case *bool:
+		return parse != nil && *parse
+	}
+
+	return false
+}
+
+// CallbackUrl returns the callback_url of the request, if any.
+func (r *Request) CallbackUrl() string {
+	callbackUrl, _ := r.Params["callback_url"].(string)
+	return callbackUrl
+}
+
+// CustomParser returns whether the request carries custom parsing instructions.
+func (r *Request) CustomParser() bool {
+	return r.ParseInstructions != nil || r.Params["parsing_instructions"] != nil
+}
+
+// Payload returns the payload sent to the API for the request.
+func (r *Request) Payload() (map[string]interface{}, error) {
+	// Copy params so that the request is left untouched,
+	// omitting unset optional booleans.
+	payload := make(map[string]interface{}, len(r.Params)+4)
+	for k, v := range r.Params {
+		if v, ok := optionalBool(v); ok {
+			if v != nil {
+				payload[k] = *v
+			}
+			continue
+		}
+		payload[k] = v
+	}
+
+	if r.Source != "" {
+		payload["source"] = r.Source
+	}
+	if payload["source"] == nil || payload["source"] == "" {
+		return nil, NewValidationError([]error{fmt.Errorf("source parameter is empty")})
+	}
+
+	// Check the parameters are supported by the source.
+	source := Source(fmt.Sprint(payload["source"]))
+	if err := ValidateParams(source, payload); err != nil {
+		return nil, err
+	}
+
+	if r.Query != "" {
+		if err := ValidateQuery(r.Query); err != nil {
+			return nil, err
+		}
+		payload["query"] = r.Query
+	}
+	if r.Url != "" {
+		payload["url"] = r.Url
+	}
+
+	// Apply the context modifiers to a spec, so that the payload does not share
+	// the values of the modifiers, and append its parameters in a stable order.
+	if len(r.Context) > 0 {
+		spec := NewContextSpec(r.Context...)
+		context := make(ContextOption, spec.Len())
+		spec.Modifier()(context)
+		if err := ValidateContext(source, context); err != nil {
+			return nil, err
+		}
+
+		contextParams, _ := payload["context"].([]map[string]interface{})
+		contextParams = append([]map[string]interface{}{}, contextParams...)
+		for _, key := range spec.Keys() {
+			value, _ := spec.Get(key)
+			contextParams = append(contextParams, map[string]interface{}{
+				"key":   key,
+				"value": value,
+			})
+		}
+		payload["context"] = contextParams
+	}
+
+	// Send the id of the current session, unless the source doesn't support sessions.
+	if r.Session != nil {
+		if !SupportsContext(source, "session_id") {
+			return nil, NewValidationError([]error{fmt.Errorf("sessions are not supported by the %s source", source)})
+		}
+
+		contextParams, _ := payload["context"].([]map[string]interface{})
+		for _, param := range contextParams {
+			if param["key"] == "session_id" && !isZero(param["value"]) {
+				return nil, NewValidationError([]error{fmt.Errorf("session and session_id context option cannot both be set")})
+			}
+		}
+		contextParams = append([]map[string]interface{}{}, contextParams...)
+		payload["context"] = append(contextParams, map[string]interface{}{
+			"key":   "session_id",
+			"value": r.Session.ID(),
+		})
+	}
+
+	// Omit unset context parameters, so that only explicitly set values are sent.
+	if contextParams, ok := payload["context"].([]map[string]interface{}); ok {
+		setParams := make([]map[string]interface{}, 0, len(contextParams))
+		for _, param := range contextParams {
+			value := param["value"]
+			if v, ok := optionalBool(value); ok {
+				if v == nil {
+					continue
+				}
+				value = *v
+			}
+			if value == nil {
+				continue
+			}
+			setParams = append(setParams, map[string]interface{}{"key": param["key"], "value": value})
+		}
+
+		if len(setParams) > 0 {
+			payload["context"] = setParams
+		} else {
+			delete(payload, "context")
+		}
+	}
+
+	// Add custom parsing instructions to the payload if provided.
+	if r.ParseInstructions != nil {
+		if err := ValidateParseInstructions(r.ParseInstructions); err != nil {
+			return nil, NewValidationError([]error{fmt.Errorf("invalid parse instructions: %w", err)})
+		}
+		payload["parsing_instructions"] = r.ParseInstructions
+	} else if instructions, ok := payload["parsing_instructions"].(map[string]interface{}); ok {
+		if err := ValidateParseInstructions(&instructions); err != nil {
+			return nil, NewValidationError([]error{fmt.Errorf("invalid parse instructions: %w", err)})
+		}
+	}
+
+	return payload, nil
+}
+
+// Clone returns a copy of the request that can be modified
+// without affecting the original params and context.
+func (r *Request) Clone() *Request {
+	clone := *r
+	if r.Params != nil {
+		clone.Params = make(map[string]interface{}, len(r.Params))
+		for k, v := range r.Params {
+			clone.Params[k] = v
+		}
+	}
+	clone.Context = append([]func(ContextOption){}, r.Context...)
+
+	return &clone
+}
+
+// MarshalJSON marshals the request into its payload.
+func (r *Request) MarshalJSON() ([]byte, error) {
+	payload, err := r.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	return MarshalPayload(payload)
+}
+
+// optionalBool returns v as an optional boolean if it is a *bool.
+func optionalBool(v interface{}) (*bool, bool) {
+	b, ok := v.(*bool)
+	return b, ok
+}
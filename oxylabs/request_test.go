@@ -0,0 +1,183 @@
+package oxylabs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Payload(t *testing.T) {
+	req := &Request{
+		Source: GoogleShoppingSearch,
+		Query:  "adidas",
+		Params: map[string]interface{}{
+			"parse": true,
+			"context": []map[string]interface{}{
+				{"key": "sort_by", "value": "r"},
+			},
+		},
+		Context: []func(ContextOption){
+			Nfpr(Bool(true)),
+			MinPrice(10),
+		},
+	}
+
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, GoogleShoppingSearch, payload["source"])
+	assert.Equal(t, "adidas", payload["query"])
+	assert.Equal(t, []map[string]interface{}{
+		{"key": "sort_by", "value": "r"},
+		{"key": "min_price", "value": 10},
+		{"key": "nfpr", "value": true},
+	}, payload["context"])
+	assert.True(t, req.Parse())
+	assert.False(t, req.CustomParser())
+
+	// Params are left untouched.
+	assert.Len(t, req.Params["context"], 1)
+	assert.Nil(t, req.Params["source"])
+}
+
+func TestRequest_PayloadErrors(t *testing.T) {
+	_, err := (&Request{Query: "adidas"}).Payload()
+	assert.Error(t, err)
+
+	_, err = (&Request{
+		Source:            Universal,
+		ParseInstructions: &map[string]interface{}{"title": "invalid"},
+	}).Payload()
+	assert.Error(t, err)
+}
+
+func TestRequest_PayloadOptionalBools(t *testing.T) {
+	var unset *bool
+	req := &Request{
+		Source: GoogleSearch,
+		Query:  "adidas",
+		Params: map[string]interface{}{
+			"parse":  Bool(false),
+			"render": unset,
+			"context": []map[string]interface{}{
+				{"key": "nfpr", "value": Bool(false)},
+				{"key": "filter", "value": nil},
+				{"key": "safe_search", "value": unset},
+			},
+		},
+	}
+
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, false, payload["parse"])
+	assert.NotContains(t, payload, "render")
+	assert.Equal(t, []map[string]interface{}{
+		{"key": "nfpr", "value": false},
+	}, payload["context"])
+	assert.False(t, req.Parse())
+
+	payload, err = (&Request{
+		Source: GoogleSearch,
+		Params: map[string]interface{}{"context": []map[string]interface{}{{"key": "nfpr", "value": nil}}},
+	}).Payload()
+	assert.NoError(t, err)
+	assert.NotContains(t, payload, "context")
+}
+
+func TestOptionalBoolContextHelpers(t *testing.T) {
+	req := &Request{
+		Source:  AmazonProduct,
+		Query:   "B07FZ8S74R",
+		Context: []func(ContextOption){AutoselectVariant(Bool(false))},
+	}
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{{"key": "autoselect_variant", "value": false}}, payload["context"])
+
+	req.Context = []func(ContextOption){AutoselectVariant(nil)}
+	payload, err = req.Payload()
+	assert.NoError(t, err)
+	assert.NotContains(t, payload, "context")
+
+	context := ContextOption{}
+	Nfpr(nil)(context)
+	assert.NotContains(t, context, "nfpr")
+	Nfpr(Bool(false))(context)
+	assert.Equal(t, false, context["nfpr"])
+}
+
+func TestStatusErrorIs(t *testing.T) {
+	assert.ErrorIs(t, &StatusError{StatusCode: 401}, ErrAuthentication)
+	assert.ErrorIs(t, &StatusError{StatusCode: 429}, ErrRateLimited)
+	assert.ErrorIs(t, &StatusError{StatusCode: 400}, ErrInvalidParameter)
+	assert.NotErrorIs(t, &StatusError{StatusCode: 500}, ErrRateLimited)
+}
+
+func TestAPIError(t *testing.T) {
+	var apiErr *APIError
+
+	err := fmt.Errorf("error scraping: %w", &StatusError{
+		StatusCode: 401,
+		Status:     "401 Unauthorized",
+		Body:       []byte(`{"message":"Unauthorized"}`),
+		JobID:      "123",
+	})
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, 401, apiErr.StatusCode)
+	assert.Equal(t, "Unauthorized", apiErr.Message)
+	assert.Equal(t, "123", apiErr.JobID)
+	assert.ErrorIs(t, apiErr, ErrAuthentication)
+
+	err = &StatusError{StatusCode: 502, Status: "502 Bad Gateway", Body: []byte("<html>")}
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "502 Bad Gateway", apiErr.Message)
+	assert.Equal(t, []byte("<html>"), apiErr.Body)
+
+	err = &FaultedJobError{JobID: "456", Reason: "blocked"}
+	assert.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "456", apiErr.JobID)
+	assert.Equal(t, "blocked", apiErr.Message)
+	assert.ErrorIs(t, apiErr, ErrJobFaulted)
+
+	assert.False(t, errors.As(ErrTimeout, &apiErr))
+}
+
+func TestParsePayload(t *testing.T) {
+	raw := []byte(`{"context":[{"key":"nfpr","value":true}],"pages":2,"parse":true,"query":"adidas","source":"google_shopping_search"}`)
+
+	req, err := ParsePayload(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, GoogleShoppingSearch, req.Source)
+	assert.True(t, req.Parse())
+
+	// The payload is resubmitted verbatim.
+	replayed, err := req.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, string(raw), string(replayed))
+
+	// Numbers and parse instructions are decoded into their typed form.
+	raw = []byte(`{"parsing_instructions":{"title":{"_fns":[{"_args":["h1"],"_fn":"css_one"}]}},"source":"universal","start_page":3,"url":"https://example.com"}`)
+	req, err = ParsePayload(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, req.Params["start_page"])
+	instructions, _ := req.Params["parsing_instructions"].(map[string]interface{})
+	assert.Equal(t, map[string]interface{}{"_fns": []Fn{{Name: CssOne, Args: []string{"h1"}}}}, instructions["title"])
+	replayed, err = req.MarshalJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, string(raw), string(replayed))
+
+	for _, raw := range []string{
+		`not json`,
+		`null`,
+		`{"query":"adidas"}`,
+		`{"source":"google_search","query":"adidas","unknown_param":1}`,
+		`{"source":"google_search","query":"adidas","context":[{"key":"min_price","value":10}]}`,
+		`{"source":"google_search","query":["adidas","nike"]}`,
+		`{"source":"universal","url":["https://example.com"]}`,
+		`{"source":"universal","url":"https://example.com","parsing_instructions":{"title":{"_fns":"xpath"}}}`,
+	} {
+		_, err := ParsePayload([]byte(raw))
+		assert.Error(t, err, raw)
+	}
+}
@@ -0,0 +1,36 @@
+package oxylabs
+
+import "fmt"
+
+// ResubmitPolicy resubmits the faulted jobs of async clients up to MaxResubmits times.
+// Mutate, if set, is called with a copy of the request before each resubmission,
+// attempt starting at 1, so parameters can be changed, e.g. to enable rendering.
+type ResubmitPolicy struct {
+	MaxResubmits int
+	Mutate       func(req *Request, attempt int)
+}
+
+// Attempt is a single submission of an async job.
+// Err is the error of the attempt, nil for the attempt that succeeded.
+type Attempt struct {
+	JobID   string
+	Request *Request
+	Err     error
+}
+
+// ResubmitError is the error of a job that kept failing after being resubmitted.
+// It wraps the error of the last attempt.
+type ResubmitError struct {
+	Attempts []Attempt
+}
+
+func (e *ResubmitError) Error() string {
+	return fmt.Sprintf("job failed after %d attempts: %v", len(e.Attempts), e.Unwrap())
+}
+
+func (e *ResubmitError) Unwrap() error {
+	if len(e.Attempts) == 0 {
+		return nil
+	}
+	return e.Attempts[len(e.Attempts)-1].Err
+}
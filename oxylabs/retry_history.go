@@ -0,0 +1,59 @@
+package oxylabs
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// RetryKind is the kind of a retry.
+type RetryKind string
+
+const (
+	// RetryTransport is a req sent once more by the transport of the client,
+	// e.g. after refreshing rejected credentials.
+	RetryTransport RetryKind = "transport"
+	// RetryResubmit is a faulted async job resubmitted per the resubmit policy.
+	RetryResubmit RetryKind = "resubmit"
+)
+
+// Retry is a failed attempt of a scrape that was retried.
+// Attempt starts at 1, Time is when the attempt failed and
+// Delay is the time waited before the next attempt.
+type Retry struct {
+	Kind        RetryKind
+	Attempt     int
+	Time        time.Time
+	Err         error
+	Delay       time.Duration
+	Source      Source
+	GeoLocation string
+}
+
+// RetryHistory is the retries of a scrape, in order.
+type RetryHistory []Retry
+
+// RetryError is the error of a scrape that failed after being retried.
+// It wraps the error of the last attempt.
+type RetryError struct {
+	History RetryHistory
+	Err     error
+}
+
+func (e *RetryError) Error() string {
+	return fmt.Sprintf("%v (after %d retries)", e.Err, len(e.History))
+}
+
+func (e *RetryError) Unwrap() error {
+	return e.Err
+}
+
+// RetryHistoryOf returns the retry history of the error of a scrape, nil if none.
+func RetryHistoryOf(err error) RetryHistory {
+	var retryErr *RetryError
+	if errors.As(err, &retryErr) {
+		return retryErr.History
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package oxylabs
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// DefaultRetryBaseDelay is the delay before the first retry of a RetryPolicy without BaseDelay.
+const DefaultRetryBaseDelay = 500 * time.Millisecond
+
+// RetryPolicy retries the reqs answered with a 429 or 5xx status code, or
+// failing with a network error, up to MaxAttempts attempts in total. The delay
+// before the nth retry is BaseDelay * 2^(n-1), capped at MaxDelay if set and
+// randomized by up to Jitter of itself, e.g. 0.2 for +/-20%.
+type RetryPolicy struct {
+	MaxAttempts int
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Jitter      float64
+}
+
+// Delay returns the delay before the retry of the failed attempt, starting at 1.
+func (p RetryPolicy) Delay(attempt int) time.Duration {
+	base := p.BaseDelay
+	if base <= 0 {
+		base = DefaultRetryBaseDelay
+	}
+
+	delay := float64(base) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		delay *= 1 + p.Jitter*(2*rand.Float64()-1)
+	}
+
+	return time.Duration(delay)
+}
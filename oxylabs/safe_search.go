@@ -0,0 +1,85 @@
+package oxylabs
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AdultContent is the handling of the adult content of search results.
+type AdultContent string
+
+const (
+	ADULT_CONTENT_ALLOW  AdultContent = "allow"
+	ADULT_CONTENT_FILTER AdultContent = "filter"
+)
+
+func IsAdultContentValid(adultContent AdultContent) bool {
+	switch adultContent {
+	case
+		ADULT_CONTENT_ALLOW,
+		ADULT_CONTENT_FILTER:
+		return true
+	default:
+		return false
+	}
+}
+
+// ValidateSafeSearch returns an error if the safe_search context parameter is
+// not a boolean, or contradicts the adult content handling if one is set.
+func ValidateSafeSearch(context ContextOption, adultContent AdultContent) error {
+	if adultContent != "" && !IsAdultContentValid(adultContent) {
+		return fmt.Errorf("invalid adult content parameter: %v", adultContent)
+	}
+
+	value, ok := context["safe_search"]
+	if !ok || value == nil {
+		return nil
+	}
+	safeSearch, ok := value.(bool)
+	if !ok {
+		return fmt.Errorf("safe_search context parameter must be a boolean, got %T", value)
+	}
+	if adultContent != "" && safeSearch != (adultContent == ADULT_CONTENT_FILTER) {
+		return fmt.Errorf("safe_search context parameter contradicts the %s adult content handling", adultContent)
+	}
+
+	return nil
+}
+
+// ApplyAdultContent sets the safe_search context parameter per the adult
+// content handling, if the handling is set and the parameter is not.
+func ApplyAdultContent(context ContextOption, adultContent AdultContent) {
+	if adultContent == "" || context["safe_search"] != nil {
+		return
+	}
+
+	context["safe_search"] = adultContent == ADULT_CONTENT_FILTER
+}
+
+// consentMarkers are the markers of the html of consent walls.
+var consentMarkers = []string{
+	`action="https://consent.google.`,
+	`action="https://consent.youtube.com`,
+	`action="https://consent.yahoo.com`,
+	`action="https://consent.bing.com`,
+}
+
+// IsConsentPage returns whether the page at url with the html content is a
+// consent wall or an interstitial, e.g. a cookie consent or age verification
+// page, returned instead of the search results.
+func IsConsentPage(rawUrl string, content string) bool {
+	if u, err := url.Parse(rawUrl); err == nil && u.Host != "" {
+		if strings.HasPrefix(u.Host, "consent.") || strings.HasPrefix(u.Path, "/interstitial") {
+			return true
+		}
+	}
+
+	for _, marker := range consentMarkers {
+		if strings.Contains(content, marker) {
+			return true
+		}
+	}
+
+	return false
+}
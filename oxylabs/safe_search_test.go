@@ -0,0 +1,46 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateSafeSearch(t *testing.T) {
+	assert.NoError(t, ValidateSafeSearch(ContextOption{}, ""))
+	assert.NoError(t, ValidateSafeSearch(ContextOption{"safe_search": true}, ADULT_CONTENT_FILTER))
+	assert.NoError(t, ValidateSafeSearch(ContextOption{"safe_search": false}, ADULT_CONTENT_ALLOW))
+	assert.Error(t, ValidateSafeSearch(ContextOption{}, "blur"))
+	assert.Error(t, ValidateSafeSearch(ContextOption{"safe_search": "on"}, ""))
+	assert.Error(t, ValidateSafeSearch(ContextOption{"safe_search": false}, ADULT_CONTENT_FILTER))
+
+	context := ContextOption{}
+	ApplyAdultContent(context, ADULT_CONTENT_FILTER)
+	assert.Equal(t, true, context["safe_search"])
+}
+
+func TestIsConsentPage(t *testing.T) {
+	tests := []struct {
+		name    string
+		url     string
+		content string
+		want    bool
+	}{
+		{name: "results", url: "https://www.google.com/search?q=adidas", content: "<html>results</html>"},
+		{name: "consent host", url: "https://consent.google.com/ml?continue=https://www.google.com/search", want: true},
+		{name: "interstitial", url: "https://www.google.com/interstitial?url=https://example.com", want: true},
+		{
+			name:    "consent form",
+			url:     "https://www.google.de/search?q=adidas",
+			content: `<form action="https://consent.google.de/save" method="POST">`,
+			want:    true,
+		},
+		{name: "invalid url", url: "::", content: "<html></html>"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsConsentPage(tt.url, tt.content))
+		})
+	}
+}
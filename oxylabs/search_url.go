@@ -0,0 +1,199 @@
+package oxylabs
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// SearchUrl returns the public url equivalent to the request, e.g.
+// https://www.google.de/search?q=adidas&tbm=shop, for auditing and manual
+// verification of the scraped results. The url of url based requests is
+// returned as is. Parameters without a public url equivalent, e.g.
+// geo_location, are left out.
+func (r *Request) SearchUrl() (string, error) {
+	payload, err := r.Payload()
+	if err != nil {
+		return "", err
+	}
+	if r.Url != "" {
+		return r.Url, nil
+	}
+
+	params := searchParams{payload: payload, context: payloadContext(payload)}
+	domain := params.string("domain")
+	if domain == "" {
+		domain = string(DOMAIN_COM)
+	}
+
+	values := url.Values{}
+	var path string
+	switch source := Source(fmt.Sprint(payload["source"])); source {
+	case GoogleSearch, GoogleAds, GoogleImages, GoogleShoppingSearch:
+		path = fmt.Sprintf("https://www.google.%s/search", domain)
+		values.Set("q", r.Query)
+		params.setGoogle(values)
+		switch source {
+		case GoogleImages:
+			values.Set("tbm", "isch")
+		case GoogleShoppingSearch:
+			values.Set("tbm", "shop")
+			params.setShopping(values)
+		}
+	case BingSearch:
+		path = "https://www.bing.com/search"
+		values.Set("q", r.Query)
+		if limit := params.int("limit"); limit > 0 {
+			values.Set("count", fmt.Sprint(limit))
+			if page := params.int("start_page"); page > 1 {
+				values.Set("first", fmt.Sprint((page-1)*limit+1))
+			}
+		}
+		if locale := params.string("locale"); locale != "" {
+			values.Set("setlang", locale)
+		}
+	case AmazonSearch:
+		path = fmt.Sprintf("https://www.amazon.%s/s", domain)
+		values.Set("k", r.Query)
+		if page := params.int("start_page"); page > 1 {
+			values.Set("page", fmt.Sprint(page))
+		}
+		if category := params.contextString("category_id"); category != "" {
+			values.Set("rh", "n:"+category)
+		}
+		if merchant := params.contextString("merchant_id"); merchant != "" {
+			values.Set("me", merchant)
+		}
+	case AmazonProduct:
+		path = fmt.Sprintf("https://www.amazon.%s/dp/%s", domain, url.PathEscape(r.Query))
+	case AmazonPricing:
+		path = fmt.Sprintf("https://www.amazon.%s/gp/offer-listing/%s", domain, url.PathEscape(r.Query))
+	case AmazonReviews:
+		path = fmt.Sprintf("https://www.amazon.%s/product-reviews/%s", domain, url.PathEscape(r.Query))
+		if page := params.int("start_page"); page > 1 {
+			values.Set("pageNumber", fmt.Sprint(page))
+		}
+	case AmazonQuestions:
+		path = fmt.Sprintf("https://www.amazon.%s/ask/questions/asin/%s", domain, url.PathEscape(r.Query))
+	case AmazonSellers:
+		path = fmt.Sprintf("https://www.amazon.%s/sp", domain)
+		values.Set("seller", r.Query)
+	case WayfairSearch:
+		path = "https://www.wayfair.com/keyword.php"
+		values.Set("keyword", r.Query)
+		if page := params.int("start_page"); page > 1 {
+			values.Set("curpage", fmt.Sprint(page))
+		}
+	default:
+		return "", fmt.Errorf("no search url equivalent for the %s source", source)
+	}
+
+	if len(values) == 0 {
+		return path, nil
+	}
+
+	return path + "?" + values.Encode(), nil
+}
+
+// searchParams are the payload and context parameters of a request.
+type searchParams struct {
+	payload map[string]interface{}
+	context map[string]interface{}
+}
+
+// setGoogle sets the common google search parameters.
+func (p searchParams) setGoogle(values url.Values) {
+	if locale := p.string("locale"); locale != "" {
+		values.Set("hl", locale)
+	}
+	if language := p.contextString("results_language"); language != "" {
+		values.Set("lr", "lang_"+language)
+	} else if language := p.string("results_language"); language != "" {
+		values.Set("lr", "lang_"+language)
+	}
+
+	limit := p.int("limit")
+	if limit > 0 {
+		values.Set("num", fmt.Sprint(limit))
+	}
+	if page := p.int("start_page"); page > 1 {
+		if limit <= 0 {
+			limit = 10
+		}
+		values.Set("start", fmt.Sprint((page-1)*limit))
+	}
+
+	for _, key := range []string{"tbm", "tbs", "fpstate"} {
+		if v := p.contextString(key); v != "" {
+			values.Set(key, v)
+		}
+	}
+	if nfpr, ok := p.context["nfpr"].(bool); ok && nfpr {
+		values.Set("nfpr", "1")
+	}
+	if filter := p.contextString("filter"); filter != "" {
+		values.Set("filter", filter)
+	}
+	if safe, ok := p.context["safe_search"].(bool); ok && safe {
+		values.Set("safe", "active")
+	}
+}
+
+// setShopping sets the google shopping sorting and price parameters.
+func (p searchParams) setShopping(values url.Values) {
+	var tbs []string
+	if sortBy := p.contextString("sort_by"); sortBy != "" && sortBy != "r" {
+		tbs = append(tbs, "p_ord:"+sortBy)
+	}
+	minPrice, maxPrice := p.contextString("min_price"), p.contextString("max_price")
+	if minPrice != "" || maxPrice != "" {
+		tbs = append(tbs, "mr:1", "price:1")
+		if minPrice != "" {
+			tbs = append(tbs, "ppr_min:"+minPrice)
+		}
+		if maxPrice != "" {
+			tbs = append(tbs, "ppr_max:"+maxPrice)
+		}
+	}
+	if len(tbs) > 0 {
+		values.Set("tbs", strings.Join(tbs, ","))
+	}
+}
+
+// string returns the payload parameter as a string, empty if unset.
+func (p searchParams) string(key string) string {
+	return stringOf(p.payload[key])
+}
+
+// int returns the payload parameter as an int, 0 if unset.
+func (p searchParams) int(key string) int {
+	v, _ := p.payload[key].(int)
+	return v
+}
+
+// contextString returns the context parameter as a string, empty if unset.
+func (p searchParams) contextString(key string) string {
+	return stringOf(p.context[key])
+}
+
+// stringOf returns v formatted as a string, empty if unset.
+func stringOf(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprint(v)
+}
+
+// payloadContext returns the context parameters of the payload by key.
+func payloadContext(payload map[string]interface{}) map[string]interface{} {
+	context := make(map[string]interface{})
+	params, _ := payload["context"].([]map[string]interface{})
+	for _, param := range params {
+		if key, ok := param["key"].(string); ok {
+			context[key] = param["value"]
+		}
+	}
+
+	return context
+}
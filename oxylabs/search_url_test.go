@@ -0,0 +1,59 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchUrl(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     *Request
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "google shopping",
+			req: &Request{
+				Source:  GoogleShoppingSearch,
+				Query:   "adidas shoes",
+				Params:  map[string]interface{}{"domain": DOMAIN_DE, "start_page": 2, "limit": 20},
+				Context: []func(ContextOption){Nfpr(Bool(true)), SortBy("p"), MinPrice(10)},
+			},
+			want: "https://www.google.de/search?nfpr=1&num=20&q=adidas+shoes&start=20&tbm=shop&tbs=p_ord%3Ap%2Cmr%3A1%2Cprice%3A1%2Cppr_min%3A10",
+		},
+		{
+			name: "google search",
+			req:  &Request{Source: GoogleSearch, Query: "adidas", Params: map[string]interface{}{"locale": Locale("de")}},
+			want: "https://www.google.com/search?hl=de&q=adidas",
+		},
+		{
+			name: "amazon product",
+			req:  &Request{Source: AmazonProduct, Query: "B07FZ8S74R", Params: map[string]interface{}{"domain": DOMAIN_CO_UK}},
+			want: "https://www.amazon.co.uk/dp/B07FZ8S74R",
+		},
+		{
+			name: "url",
+			req:  &Request{Source: Universal, Url: "https://example.com/a?b=c"},
+			want: "https://example.com/a?b=c",
+		},
+		{
+			name:    "no equivalent",
+			req:     &Request{Source: GoogleTrendsExplore, Query: "adidas"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.req.SearchUrl()
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package oxylabs
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/json"
+)
+
+// Serializer encodes the values persisted by the storages of the SDK, e.g.
+// the observations of the monitor or the snapshots of a job store.
+type Serializer interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// JSONSerializer encodes values as JSON. It is the default serializer.
+type JSONSerializer struct{}
+
+func (JSONSerializer) Marshal(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+func (JSONSerializer) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// GobSerializer encodes values with encoding/gob. The concrete types of the
+// interface values must be registered with gob.Register.
+type GobSerializer struct{}
+
+func (GobSerializer) Marshal(v interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(v); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (GobSerializer) Unmarshal(data []byte, v interface{}) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(v)
+}
+
+// MsgpackSerializer encodes values as MessagePack. Values are mapped as they
+// are in JSON, honoring the json tags and the json (un)marshalers of their types.
+type MsgpackSerializer struct{}
+
+func (MsgpackSerializer) Marshal(v interface{}) ([]byte, error) {
+	// Map the value as JSON does.
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var value interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := encodeMsgpack(&buf, value); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (MsgpackSerializer) Unmarshal(data []byte, v interface{}) error {
+	value, rest, err := decodeMsgpack(data)
+	if err != nil {
+		return err
+	}
+	if len(rest) > 0 {
+		return errMsgpackTrailingData
+	}
+
+	data, err = json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// SerializerOrDefault returns the serializer, or a JSONSerializer if it is nil.
+func SerializerOrDefault(serializer Serializer) Serializer {
+	if serializer == nil {
+		return JSONSerializer{}
+	}
+
+	return serializer
+}
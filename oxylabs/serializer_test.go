@@ -0,0 +1,78 @@
+package oxylabs
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type serializedRecord struct {
+	Name    string            `json:"name"`
+	Count   int               `json:"count"`
+	Big     int64             `json:"big"`
+	Price   float64           `json:"price"`
+	Ok      bool              `json:"ok"`
+	Tags    []string          `json:"tags"`
+	Labels  map[string]string `json:"labels"`
+	Missing *string           `json:"missing"`
+}
+
+func TestSerializers(t *testing.T) {
+	record := serializedRecord{
+		Name:   strings.Repeat("a", 300),
+		Count:  -200,
+		Big:    1 << 40,
+		Price:  19.99,
+		Ok:     true,
+		Tags:   []string{"x", "y"},
+		Labels: map[string]string{"b": "2", "a": "1"},
+	}
+
+	for name, serializer := range map[string]Serializer{
+		"json":    JSONSerializer{},
+		"gob":     GobSerializer{},
+		"msgpack": MsgpackSerializer{},
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := serializer.Marshal(record)
+			assert.NoError(t, err)
+
+			var decoded serializedRecord
+			assert.NoError(t, serializer.Unmarshal(data, &decoded))
+			assert.Equal(t, record, decoded)
+		})
+	}
+}
+
+func TestMsgpackSerializerSize(t *testing.T) {
+	record := serializedRecord{Name: "adidas", Count: 3, Price: 10.5, Tags: []string{"x"}}
+
+	jsonData, _ := JSONSerializer{}.Marshal(record)
+	msgpackData, err := MsgpackSerializer{}.Marshal(record)
+	assert.NoError(t, err)
+	assert.Less(t, len(msgpackData), len(jsonData))
+
+	var decoded serializedRecord
+	assert.Error(t, MsgpackSerializer{}.Unmarshal(msgpackData[:len(msgpackData)-1], &decoded))
+	assert.Error(t, MsgpackSerializer{}.Unmarshal(append(msgpackData, 0xc0), &decoded))
+}
+
+func TestMemoryJobStoreSnapshot(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryJobStore()
+	_ = store.Store(ctx, "item-1", "job-1")
+	_, _ = store.Reserve(ctx, "item-2")
+
+	for _, serializer := range []Serializer{nil, GobSerializer{}, MsgpackSerializer{}} {
+		var buf bytes.Buffer
+		assert.NoError(t, store.Snapshot(&buf, serializer))
+
+		restored, err := RestoreMemoryJobStore(&buf, serializer)
+		assert.NoError(t, err)
+		entries, _ := restored.Entries(ctx)
+		assert.Equal(t, map[string]string{"item-1": "job-1", "item-2": ""}, entries)
+	}
+}
@@ -0,0 +1,64 @@
+package oxylabs
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+)
+
+// DefaultSessionTTL is the time Oxylabs keeps the exit node of a session.
+const DefaultSessionTTL = 10 * time.Minute
+
+// Session is a sticky proxy session, so that a sequence of requests
+// sharing it originates from a consistent exit node.
+// A new session id is started once TTL has elapsed since the current one
+// started, DefaultSessionTTL if 0. A negative TTL never rotates the id.
+// Sessions are set on the Session of a Request, or of the opts of the sources
+// supporting them, i.e. universal_ecommerce; other sources reject them.
+type Session struct {
+	TTL time.Duration
+
+	mu      sync.Mutex
+	id      string
+	started time.Time
+}
+
+// NewSession returns a session with a random id rotated after ttl.
+func NewSession(ttl time.Duration) *Session {
+	return &Session{TTL: ttl}
+}
+
+// ID returns the id of the current session, starting a new one if the TTL has elapsed.
+func (s *Session) ID() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ttl := s.TTL
+	if ttl == 0 {
+		ttl = DefaultSessionTTL
+	}
+
+	if s.id == "" || (ttl > 0 && time.Since(s.started) >= ttl) {
+		s.id = newSessionId()
+		s.started = time.Now()
+	}
+
+	return s.id
+}
+
+// Rotate ends the current session, so the next request starts a new one.
+func (s *Session) Rotate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.id = ""
+}
+
+// newSessionId returns a random session id.
+func newSessionId() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
@@ -0,0 +1,62 @@
+package oxylabs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionID(t *testing.T) {
+	session := NewSession(time.Hour)
+	id := session.ID()
+	assert.Len(t, id, 32)
+	assert.Equal(t, id, session.ID())
+
+	session.Rotate()
+	assert.NotEqual(t, id, session.ID())
+
+	expiring := NewSession(time.Nanosecond)
+	id = expiring.ID()
+	time.Sleep(time.Millisecond)
+	assert.NotEqual(t, id, expiring.ID())
+}
+
+func TestRequestSession(t *testing.T) {
+	session := NewSession(time.Hour)
+
+	req := &Request{Source: Universal, Url: "https://example.com", Session: session}
+	payload, err := req.Payload()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []map[string]interface{}{{"key": "session_id", "value": session.ID()}}, payload["context"])
+
+	tests := []struct {
+		name string
+		req  *Request
+		err  string
+	}{
+		{
+			name: "unsupported source",
+			req:  &Request{Source: GoogleSearch, Query: "adidas", Session: session},
+			err:  "sessions are not supported by the google_search source",
+		},
+		{
+			name: "session_id context option",
+			req: &Request{Source: Universal, Url: "https://example.com", Session: session, Params: map[string]interface{}{
+				"context": []map[string]interface{}{{"key": "session_id", "value": "abc"}},
+			}},
+			err: "session and session_id context option cannot both be set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := tt.req.Payload()
+			var validationErr *ValidationError
+			if assert.ErrorAs(t, err, &validationErr) {
+				assert.Contains(t, err.Error(), tt.err)
+			}
+		})
+	}
+}
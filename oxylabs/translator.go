@@ -0,0 +1,9 @@
+package oxylabs
+
+import "context"
+
+// Translator translates texts into the target language, e.g. with a machine
+// translation provider. The translations are returned in the order of the texts.
+type Translator interface {
+	Translate(ctx context.Context, texts []string, targetLanguage string) ([]string, error)
+}
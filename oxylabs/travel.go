@@ -0,0 +1,45 @@
+package oxylabs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// HotelDateLayout is the layout of the check-in and check-out dates of hotel scrapes.
+const HotelDateLayout = "2006-01-02"
+
+// HotelStay sets the hotel_dates context option to the check-in and check-out dates.
+func HotelStay(checkIn, checkOut time.Time) func(ContextOption) {
+	return HotelCheckInOut(checkIn.Format(HotelDateLayout), checkOut.Format(HotelDateLayout))
+}
+
+// HotelCheckInOut sets the hotel_dates context option to the check-in and
+// check-out dates, formatted YYYY-MM-DD.
+func HotelCheckInOut(checkIn, checkOut string) func(ContextOption) {
+	return HotelDates(checkIn + "," + checkOut)
+}
+
+// ValidateHotelDates checks the hotel_dates are a check-in and a check-out date,
+// formatted YYYY-MM-DD and separated by a comma, the check-out after the check-in.
+func ValidateHotelDates(dates string) error {
+	checkIn, checkOut, ok := strings.Cut(dates, ",")
+	if !ok {
+		return fmt.Errorf("invalid hotel_dates parameter %q: must be a check-in and a check-out date separated by a comma", dates)
+	}
+
+	checkInDate, err := time.Parse(HotelDateLayout, strings.TrimSpace(checkIn))
+	if err != nil {
+		return fmt.Errorf("invalid hotel_dates check-in date %q: must be formatted YYYY-MM-DD", checkIn)
+	}
+	checkOutDate, err := time.Parse(HotelDateLayout, strings.TrimSpace(checkOut))
+	if err != nil {
+		return fmt.Errorf("invalid hotel_dates check-out date %q: must be formatted YYYY-MM-DD", checkOut)
+	}
+
+	if !checkOutDate.After(checkInDate) {
+		return fmt.Errorf("invalid hotel_dates parameter %q: check-out must be after check-in", dates)
+	}
+
+	return nil
+}
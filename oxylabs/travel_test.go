@@ -0,0 +1,44 @@
+package oxylabs
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateHotelDates(t *testing.T) {
+	tests := []struct {
+		name    string
+		dates   string
+		wantErr string
+	}{
+		{name: "valid", dates: "2024-07-12,2024-07-14"},
+		{name: "single date", dates: "2024-07-12", wantErr: "separated by a comma"},
+		{name: "bad check-in", dates: "12/07/2024,2024-07-14", wantErr: "check-in date"},
+		{name: "bad check-out", dates: "2024-07-12,2024-07-32", wantErr: "check-out date"},
+		{name: "check-out before check-in", dates: "2024-07-14,2024-07-12", wantErr: "check-out must be after check-in"},
+		{name: "same day", dates: "2024-07-12,2024-07-12", wantErr: "check-out must be after check-in"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHotelDates(tt.dates)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestHotelStay(t *testing.T) {
+	ctx := ContextOption{}
+	HotelStay(
+		time.Date(2024, 7, 12, 15, 0, 0, 0, time.UTC),
+		time.Date(2024, 7, 14, 11, 0, 0, 0, time.UTC),
+	)(ctx)
+
+	assert.Equal(t, "2024-07-12,2024-07-14", ctx["hotel_dates"])
+}
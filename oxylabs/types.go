@@ -1,5 +1,11 @@
 package oxylabs
 
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
 type UserAgent string
 
 const (
@@ -79,6 +85,8 @@ const (
 	Wayfair       Source = "wayfair"
 	WayfairSearch Source = "wayfair_search"
 
+	WalmartUrl Source = "walmart"
+
 	Universal Source = "universal_ecommerce"
 
 	AmazonUrl         Source = "amazon"
@@ -320,3 +328,79 @@ const (
 	LOCALE_TR Locale = "tr"
 	LOCALE_UK Locale = "uk"
 )
+
+// SourceStats contains the request counters of a single source.
+// Requests and Faults are counted per job, batches counting a request per job
+// and, if they are not submitted, a fault per job.
+// Latency is the moving average of the latency of its successful sync reqs,
+// AsyncLatency that of its async jobs, from submission to completion.
+type SourceStats struct {
+	Requests     int64
+	Successes    int64
+	Faults       int64
+	Retries      int64
+	Latency      time.Duration
+	AsyncLatency time.Duration
+}
+
+// AuditRecord is the record of a single payload submitted to the API.
+type AuditRecord struct {
+	Timestamp time.Time         `json:"timestamp"`
+	Hash      string            `json:"hash"`
+	Source    Source            `json:"source"`
+	Payload   json.RawMessage   `json:"payload"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// CredentialsProvider returns the API credentials. It is called lazily on the
+// first request of a client and again once the credentials are rejected.
+type CredentialsProvider func(ctx context.Context) (username string, password string, err error)
+
+// Health is the result of a health probe of the API.
+// Authenticated is false if the credentials were rejected.
+type Health struct {
+	Reachable     bool
+	Authenticated bool
+	StatusCode    int
+	Latency       time.Duration
+}
+
+// ArchiveRecord is the metadata of a raw response passed to an ArchiveHook.
+// PayloadHash is the hash of the submitted payload, as in AuditRecord, if known.
+// Metadata is the request metadata of the call, see WithRequestMetadata.
+type ArchiveRecord struct {
+	Timestamp   time.Time         `json:"timestamp"`
+	Source      Source            `json:"source,omitempty"`
+	JobID       string            `json:"job_id,omitempty"`
+	PayloadHash string            `json:"payload_hash,omitempty"`
+	StatusCode  int               `json:"status_code"`
+	Metadata    map[string]string `json:"metadata,omitempty"`
+}
+
+// ArchiveHook archives the raw response of every successful call of a client,
+// e.g. to retain the evidence of every scrape for compliance. An error of the
+// hook fails the call.
+type ArchiveHook interface {
+	Archive(ctx context.Context, record ArchiveRecord, body []byte) error
+}
+
+// QueueStats contains the metrics of the concurrency guard of a client.
+// Active is the number of outstanding reqs and Queued the number of reqs
+// waiting for a free slot. Waits is the number of reqs that had to wait,
+// for TotalWait in total and at most MaxWait.
+type QueueStats struct {
+	Limit     int
+	Active    int
+	Queued    int
+	Waits     int64
+	TotalWait time.Duration
+	MaxWait   time.Duration
+}
+
+// SchemaVersion is a version of the schema of the parsed results of a source,
+// as first seen by a client in the resp of the job.
+type SchemaVersion struct {
+	Source  Source `json:"source"`
+	Version string `json:"version"`
+	JobID   string `json:"job_id,omitempty"`
+}
@@ -0,0 +1,57 @@
+package oxylabs
+
+import "fmt"
+
+// Viewport sets the browser viewport and device metrics of rendered scrapes,
+// so rendered HTML and screenshots match a mobile or desktop layout.
+// It is sent as the top-level viewport parameter of the payload, see SetViewport,
+// of the sources supporting it, see SupportsParam.
+type Viewport struct {
+	Width             int     `json:"width"`
+	Height            int     `json:"height"`
+	DeviceScaleFactor float64 `json:"device_scale_factor,omitempty"`
+}
+
+// ValidateViewport checks the validity of the viewport of a scrape of the source
+// rendered with render. A nil viewport is valid.
+func ValidateViewport(source Source, viewport *Viewport, render Render) error {
+	if viewport == nil {
+		return nil
+	}
+
+	if !SupportsParam(source, "viewport") {
+		return fmt.Errorf("viewport parameter is not supported by the %s source", source)
+	}
+
+	if render == "" {
+		return fmt.Errorf("viewport is useful only if render is set")
+	}
+
+	if viewport.Width <= 0 || viewport.Height <= 0 {
+		return fmt.Errorf("invalid viewport parameter: width and height must be greater than 0")
+	}
+
+	if viewport.DeviceScaleFactor < 0 {
+		return fmt.Errorf("invalid viewport parameter: device scale factor cannot be negative")
+	}
+
+	return nil
+}
+
+// SetViewport adds the viewport to the payload of a rendered scrape, if set.
+// The request constructors set the viewport through it once validated by
+// ValidateViewport, so the parameter is encoded the same way for every source.
+func SetViewport(payload map[string]interface{}, viewport *Viewport) {
+	if viewport == nil {
+		return
+	}
+
+	params := map[string]interface{}{
+		"width":  viewport.Width,
+		"height": viewport.Height,
+	}
+	if viewport.DeviceScaleFactor != 0 {
+		params["device_scale_factor"] = viewport.DeviceScaleFactor
+	}
+	payload["viewport"] = params
+}
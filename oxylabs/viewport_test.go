@@ -0,0 +1,56 @@
+package oxylabs
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateViewport(t *testing.T) {
+	tests := []struct {
+		name     string
+		viewport *Viewport
+		render   Render
+		wantErr  string
+	}{
+		{name: "nil", viewport: nil},
+		{name: "valid", viewport: &Viewport{Width: 390, Height: 844, DeviceScaleFactor: 3}, render: HTML},
+		{name: "without render", viewport: &Viewport{Width: 390, Height: 844}, wantErr: "viewport is useful only if render is set"},
+		{name: "zero width", viewport: &Viewport{Height: 844}, render: HTML, wantErr: "width and height must be greater than 0"},
+		{name: "negative height", viewport: &Viewport{Width: 390, Height: -1}, render: PNG, wantErr: "width and height must be greater than 0"},
+		{name: "negative scale", viewport: &Viewport{Width: 390, Height: 844, DeviceScaleFactor: -1}, render: HTML, wantErr: "device scale factor cannot be negative"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateViewport(Universal, tt.viewport, tt.render)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestValidateViewportSource(t *testing.T) {
+	viewport := &Viewport{Width: 390, Height: 844}
+	assert.NoError(t, ValidateViewport(Universal, viewport, HTML))
+	assert.NoError(t, ValidateViewport(GoogleSearch, nil, HTML))
+	assert.ErrorContains(t, ValidateViewport(GoogleSearch, viewport, HTML), "viewport parameter is not supported by the google_search source")
+}
+
+func TestSetViewport(t *testing.T) {
+	payload := map[string]interface{}{"source": "universal"}
+	SetViewport(payload, nil)
+	assert.NotContains(t, payload, "viewport")
+
+	SetViewport(payload, &Viewport{Width: 1920, Height: 1080})
+	data, _ := json.Marshal(payload)
+	assert.JSONEq(t, `{"source": "universal", "viewport": {"width": 1920, "height": 1080}}`, string(data))
+
+	SetViewport(payload, &Viewport{Width: 390, Height: 844, DeviceScaleFactor: 3})
+	data, _ = json.Marshal(payload)
+	assert.JSONEq(t, `{"source": "universal", "viewport": {"width": 390, "height": 844, "device_scale_factor": 3}}`, string(data))
+}
@@ -0,0 +1,30 @@
+package oxylabs
+
+import "fmt"
+
+// ParseStatusSuccess is the parse status code of fully parsed content.
+const ParseStatusSuccess = 12000
+
+// Warning is a non-fatal issue of a page whose content was only partially parsed.
+type Warning struct {
+	Page            int
+	ParseStatusCode int
+	Message         string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("page %d (parse status %d): %s", w.Page, w.ParseStatusCode, w.Message)
+}
+
+// PageStatus is the status of a single page of a multi-page resp.
+type PageStatus struct {
+	Page            int
+	JobID           string
+	StatusCode      int
+	ParseStatusCode int
+}
+
+// Failed returns whether the page failed to be scraped or parsed.
+func (s PageStatus) Failed() bool {
+	return s.StatusCode != 200 || (s.ParseStatusCode != 0 && s.ParseStatusCode != ParseStatusSuccess)
+}
@@ -0,0 +1,26 @@
+package oxylabs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageStatusFailed(t *testing.T) {
+	tests := []struct {
+		name   string
+		status PageStatus
+		want   bool
+	}{
+		{name: "parsed", status: PageStatus{StatusCode: 200, ParseStatusCode: ParseStatusSuccess}},
+		{name: "not parsed", status: PageStatus{StatusCode: 200}},
+		{name: "parse failure", status: PageStatus{StatusCode: 200, ParseStatusCode: 12004}, want: true},
+		{name: "scrape failure", status: PageStatus{StatusCode: 613}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.status.Failed())
+		})
+	}
+}
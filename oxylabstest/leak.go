@@ -0,0 +1,99 @@
+package oxylabstest
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+)
+
+// DefaultLeakTimeout is the time the goroutines started by a test are given
+// to exit once it ends.
+const DefaultLeakTimeout = time.Second
+
+// LeakOpts configures CheckLeaks.
+// Timeout is the time the goroutines started by the test are given to exit,
+// DefaultLeakTimeout if unset.
+// Ignore lists substrings of the stacks of goroutines expected to outlive the test.
+type LeakOpts struct {
+	Timeout time.Duration
+	Ignore  []string
+}
+
+// CheckLeaks fails t, once it ends, if goroutines started during the test are
+// still running after the timeout, e.g. polls outliving a cancelled ctx.
+// It must be called at the start of the test, and not in parallel tests.
+func CheckLeaks(t testing.TB, opts ...*LeakOpts) {
+	t.Helper()
+
+	// Prepare options.
+	opt := LeakOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = *opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	if opt.Timeout == 0 {
+		opt.Timeout = DefaultLeakTimeout
+	}
+
+	before := internal.Goroutines()
+	t.Cleanup(func() {
+		var leaked []string
+		for deadline := time.Now().Add(opt.Timeout); ; time.Sleep(time.Millisecond) {
+			leaked = ignoreStacks(internal.LeakedGoroutines(before), opt.Ignore)
+			if len(leaked) == 0 || time.Now().After(deadline) {
+				break
+			}
+		}
+		if len(leaked) > 0 {
+			t.Errorf("%d goroutines leaked:\n\n%s", len(leaked), strings.Join(leaked, "\n\n"))
+		}
+	})
+}
+
+// CheckBodies fails t, once it ends, if resp bodies served by the simulator
+// were not closed by the client, after the timeout of the last non-nil opts.
+func CheckBodies(t testing.TB, s *Simulator, opts ...*LeakOpts) {
+	t.Helper()
+
+	// Prepare options.
+	opt := LeakOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = *opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	if opt.Timeout == 0 {
+		opt.Timeout = DefaultLeakTimeout
+	}
+
+	t.Cleanup(func() {
+		for deadline := time.Now().Add(opt.Timeout); s.OpenBodies() > 0; time.Sleep(time.Millisecond) {
+			if time.Now().After(deadline) {
+				t.Errorf("%d resp bodies not closed", s.OpenBodies())
+				return
+			}
+		}
+	})
+}
+
+// ignoreStacks returns the stacks not containing any of the ignored substrings.
+func ignoreStacks(stacks []string, ignore []string) []string {
+	kept := stacks[:0]
+	for _, stack := range stacks {
+		ignored := false
+		for _, substr := range ignore {
+			if strings.Contains(stack, substr) {
+				ignored = true
+				break
+			}
+		}
+		if !ignored {
+			kept = append(kept, stack)
+		}
+	}
+
+	return kept
+}
@@ -0,0 +1,102 @@
+package oxylabstest
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/serp"
+)
+
+// cancelOn returns a ctx cancelled by the returned hook once a req matches.
+func cancelOn(match func(req *http.Request) bool) (context.Context, func(req *http.Request)) {
+	ctx, cancel := context.WithCancel(context.Background())
+	return ctx, func(req *http.Request) {
+		if match(req) {
+			cancel()
+		}
+	}
+}
+
+func TestCancelMidPollReleasesResources(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, onRequest := cancelOn(func(req *http.Request) bool {
+		return req.Method == http.MethodGet
+	})
+	sim := NewSimulator(&SimulatorOpts{Delay: time.Hour, OnRequest: onRequest})
+	CheckBodies(t, sim)
+	c := serp.InitAsync("user", "pass", serp.WithHttpClient(sim.HttpClient()))
+
+	_, err := c.ScrapeGoogleSearchCtx(ctx, "adidas", &serp.GoogleSearchOpts{
+		PollInterval: time.Millisecond,
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrTimeout)
+	assert.Len(t, sim.Jobs(), 1)
+	assert.Zero(t, sim.Jobs()[0].Polls, "the poll is cancelled before it is served")
+}
+
+func TestCancelMidResultsReleasesResources(t *testing.T) {
+	CheckLeaks(t)
+
+	ctx, onRequest := cancelOn(func(req *http.Request) bool {
+		return strings.HasSuffix(req.URL.Path, "/results")
+	})
+	sim := NewSimulator(&SimulatorOpts{OnRequest: onRequest})
+	CheckBodies(t, sim)
+	c := serp.InitAsync("user", "pass", serp.WithHttpClient(sim.HttpClient()))
+
+	_, err := c.ScrapeGoogleSearchCtx(ctx, "adidas", &serp.GoogleSearchOpts{
+		PollInterval: time.Millisecond,
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrTimeout)
+}
+
+// recordingTB records the errors of CheckLeaks and runs its cleanups on demand.
+type recordingTB struct {
+	testing.TB
+	mu       sync.Mutex
+	errs     []string
+	cleanups []func()
+}
+
+func (r *recordingTB) Helper() {}
+
+func (r *recordingTB) Cleanup(fn func()) {
+	r.cleanups = append(r.cleanups, fn)
+}
+
+func (r *recordingTB) Errorf(format string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.errs = append(r.errs, format)
+}
+
+func TestCheckLeaks(t *testing.T) {
+	rec := &recordingTB{TB: t}
+	CheckLeaks(rec, &LeakOpts{Timeout: 10 * time.Millisecond})
+
+	stop := make(chan struct{})
+	go func() {
+		<-stop
+	}()
+	rec.cleanups[0]()
+	close(stop)
+	assert.Len(t, rec.errs, 1, "the blocked goroutine is reported")
+
+	rec = &recordingTB{TB: t}
+	CheckLeaks(rec)
+	done := make(chan struct{})
+	go func() {
+		close(done)
+	}()
+	<-done
+	rec.cleanups[0]()
+	assert.Empty(t, rec.errs, "exited goroutines are not reported")
+}
@@ -0,0 +1,323 @@
+// Package oxylabstest provides test doubles of the Oxylabs Scraper APIs.
+package oxylabstest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// DefaultFaultReason is the reason of the simulated job faults.
+const DefaultFaultReason = "simulated fault"
+
+// SimulatorOpts configures the job lifecycle of a Simulator.
+// Delay is the time a job stays pending before it is done or faulted.
+// FaultRate is the probability of a job to fault, drawn on submission from
+// a source seeded with Seed, so that the same jobs fault on every run.
+// Now is the clock of the simulator, read once per req, time.Now if unset.
+// Content returns the content of the results of the job of the payload,
+// an html page echoing the query or url if unset.
+// OnRequest is called with every req before it is served, e.g. to cancel the
+// ctx of a scrape once its job is polled.
+type SimulatorOpts struct {
+	Delay     time.Duration
+	FaultRate float64
+	Seed      int64
+	Now       func() time.Time
+	Content   func(payload map[string]interface{}) interface{}
+	OnRequest func(req *http.Request)
+}
+
+// SimulatedJob is the state of a job submitted to a Simulator.
+type SimulatedJob struct {
+	ID          string
+	Source      oxylabs.Source
+	Payload     map[string]interface{}
+	SubmittedAt time.Time
+	Faulted     bool
+	Polls       int
+}
+
+// Simulator is an in-memory emulation of the async jobs of the Oxylabs
+// Scraper APIs, served as an http.RoundTripper. Submitted jobs are pending
+// for the delay of the simulator and then done, or faulted per its fault rate.
+type Simulator struct {
+	opts SimulatorOpts
+
+	mu     sync.Mutex
+	rand   *rand.Rand
+	jobs   map[string]*SimulatedJob
+	nextID int
+	open   int
+}
+
+// NewSimulator returns a simulator configured with the last non-nil opts.
+func NewSimulator(opts ...*SimulatorOpts) *Simulator {
+	s := &Simulator{jobs: make(map[string]*SimulatedJob)}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		s.opts = *opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	if s.opts.Now == nil {
+		s.opts.Now = time.Now
+	}
+	if s.opts.Content == nil {
+		s.opts.Content = defaultContent
+	}
+	s.rand = rand.New(rand.NewSource(s.opts.Seed))
+
+	return s
+}
+
+// HttpClient returns an http client sending its reqs to the simulator,
+// to be passed to the client under test with WithHttpClient.
+func (s *Simulator) HttpClient() *http.Client {
+	return &http.Client{Transport: s}
+}
+
+// Jobs returns the state of the submitted jobs in order of submission.
+func (s *Simulator) Jobs() []SimulatedJob {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	jobs := make([]SimulatedJob, 0, len(s.jobs))
+	for i := 1; i <= s.nextID; i++ {
+		jobs = append(jobs, *s.jobs[strconv.Itoa(i)])
+	}
+
+	return jobs
+}
+
+// OpenBodies returns the number of resp bodies served by the simulator
+// and not closed yet by the client.
+func (s *Simulator) OpenBodies() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.open
+}
+
+// RoundTrip serves the req as the job endpoints of the API would.
+// Reqs whose ctx is done fail with the ctx error, as on a real transport.
+func (s *Simulator) RoundTrip(req *http.Request) (*http.Response, error) {
+	if s.opts.OnRequest != nil {
+		s.opts.OnRequest(req)
+	}
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.serve(req)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.open++
+	s.mu.Unlock()
+	resp.Body = &trackedBody{ReadCloser: resp.Body, s: s}
+
+	return resp, nil
+}
+
+// serve returns the resp of the job endpoint of the req.
+func (s *Simulator) serve(req *http.Request) (*http.Response, error) {
+	path := strings.TrimSuffix(req.URL.Path, "/")
+	switch {
+	case req.Method == http.MethodPost && path == "/v1/queries":
+		return s.submit(req, false)
+	case req.Method == http.MethodPost && path == "/v1/queries/batch":
+		return s.submit(req, true)
+	case req.Method == http.MethodGet && strings.HasPrefix(path, "/v1/queries/"):
+		id := strings.TrimPrefix(path, "/v1/queries/")
+		if id, ok := strings.CutSuffix(id, "/results"); ok {
+			return s.results(req, id)
+		}
+		return s.status(req, id)
+	}
+
+	return respond(req, http.StatusNotFound, map[string]string{"message": "not found"})
+}
+
+// submit creates a job per query or url of the payload of the req.
+func (s *Simulator) submit(req *http.Request, batch bool) (*http.Response, error) {
+	var payload map[string]interface{}
+	if req.Body != nil {
+		defer req.Body.Close()
+		if err := json.NewDecoder(req.Body).Decode(&payload); err != nil {
+			return respond(req, http.StatusBadRequest, map[string]string{"message": err.Error()})
+		}
+	}
+
+	// Split batch payloads into a payload per query or url.
+	payloads := []map[string]interface{}{payload}
+	if batch {
+		payloads = nil
+		for _, key := range []string{"query", "url"} {
+			values, _ := payload[key].([]interface{})
+			for _, value := range values {
+				single := make(map[string]interface{}, len(payload))
+				for k, v := range payload {
+					single[k] = v
+				}
+				single[key] = value
+				payloads = append(payloads, single)
+			}
+		}
+	}
+
+	s.mu.Lock()
+	now := s.opts.Now()
+	jobs := make([]map[string]interface{}, 0, len(payloads))
+	for _, payload := range payloads {
+		s.nextID++
+		job := &SimulatedJob{
+			ID:          strconv.Itoa(s.nextID),
+			Source:      oxylabs.Source(fmt.Sprint(payload["source"])),
+			Payload:     payload,
+			SubmittedAt: now,
+			Faulted:     s.rand.Float64() < s.opts.FaultRate,
+		}
+		s.jobs[job.ID] = job
+		jobs = append(jobs, s.jobJSON(job, now))
+	}
+	s.mu.Unlock()
+
+	if batch {
+		return respond(req, http.StatusOK, map[string]interface{}{"queries": jobs})
+	}
+	return respond(req, http.StatusOK, jobs[0])
+}
+
+// status returns the status of the job.
+func (s *Simulator) status(req *http.Request, id string) (*http.Response, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	job, ok := s.jobs[id]
+	if !ok {
+		return respond(req, http.StatusNotFound, map[string]string{"message": "job not found"})
+	}
+	job.Polls++
+
+	return respond(req, http.StatusOK, s.jobJSON(job, s.opts.Now()))
+}
+
+// results returns the results of the done job.
+func (s *Simulator) results(req *http.Request, id string) (*http.Response, error) {
+	s.mu.Lock()
+	job, ok := s.jobs[id]
+	var (
+		jobJSON map[string]interface{}
+		status  string
+	)
+	if ok {
+		jobJSON = s.jobJSON(job, s.opts.Now())
+		status = jobJSON["status"].(string)
+	}
+	s.mu.Unlock()
+
+	switch {
+	case !ok:
+		return respond(req, http.StatusNotFound, map[string]string{"message": "job not found"})
+	case status != "done":
+		return respond(req, http.StatusNoContent, nil)
+	}
+
+	url, _ := job.Payload["url"].(string)
+	return respond(req, http.StatusOK, map[string]interface{}{
+		"results": []map[string]interface{}{{
+			"content":     s.opts.Content(job.Payload),
+			"created_at":  jobJSON["created_at"],
+			"updated_at":  jobJSON["updated_at"],
+			"page":        1,
+			"url":         url,
+			"job_id":      job.ID,
+			"status_code": 200,
+		}},
+		"job": jobJSON,
+	})
+}
+
+// jobJSON returns the job as returned by the API at the time now.
+func (s *Simulator) jobJSON(job *SimulatedJob, now time.Time) map[string]interface{} {
+	status := "pending"
+	updatedAt := job.SubmittedAt
+	if doneAt := job.SubmittedAt.Add(s.opts.Delay); !now.Before(doneAt) {
+		status = "done"
+		if job.Faulted {
+			status = "faulted"
+		}
+		updatedAt = doneAt
+	}
+
+	jobJSON := map[string]interface{}{
+		"id":         job.ID,
+		"status":     status,
+		"source":     job.Source,
+		"query":      job.Payload["query"],
+		"url":        job.Payload["url"],
+		"created_at": job.SubmittedAt.UTC().Format(time.RFC3339),
+		"updated_at": updatedAt.UTC().Format(time.RFC3339),
+	}
+	if status == "faulted" {
+		jobJSON["statuses"] = []map[string]interface{}{{"status": "faulted", "reason": DefaultFaultReason}}
+	}
+
+	return jobJSON
+}
+
+// defaultContent returns an html page echoing the query or url of the payload.
+func defaultContent(payload map[string]interface{}) interface{} {
+	if query, ok := payload["query"]; ok {
+		return fmt.Sprintf("<html><body>%v</body></html>", query)
+	}
+
+	return fmt.Sprintf("<html><body>%v</body></html>", payload["url"])
+}
+
+// respond returns an http resp to req with the json encoded body.
+func respond(req *http.Request, statusCode int, body interface{}) (*http.Response, error) {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return nil, fmt.Errorf("error marshalling resp body: %v", err)
+		}
+	}
+
+	return &http.Response{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(data)),
+		Request:    req,
+	}, nil
+}
+
+// trackedBody is a resp body counted in the open bodies of the simulator until closed.
+type trackedBody struct {
+	io.ReadCloser
+	s    *Simulator
+	once sync.Once
+}
+
+func (b *trackedBody) Close() error {
+	b.once.Do(func() {
+		b.s.mu.Lock()
+		b.s.open--
+		b.s.mu.Unlock()
+	})
+
+	return b.ReadCloser.Close()
+}
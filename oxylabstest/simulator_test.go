@@ -0,0 +1,74 @@
+package oxylabstest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/serp"
+)
+
+// tickingClock returns a clock advancing by a second on every reading.
+func tickingClock() func() time.Time {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+}
+
+func TestSimulator(t *testing.T) {
+	sim := NewSimulator(&SimulatorOpts{Delay: 3 * time.Second, Now: tickingClock()})
+	c := serp.InitAsync("user", "pass", serp.WithHttpClient(sim.HttpClient()))
+
+	respChan, err := c.ScrapeGoogleSearchCtx(context.Background(), "adidas", &serp.GoogleSearchOpts{
+		PollInterval: time.Millisecond,
+	})
+	assert.NoError(t, err)
+	resp := <-respChan
+	assert.Equal(t, "<html><body>adidas</body></html>", resp.Results[0].Content)
+	assert.Equal(t, "done", resp.Job.Status)
+
+	jobs := sim.Jobs()
+	assert.Len(t, jobs, 1)
+	assert.Equal(t, oxylabs.GoogleSearch, jobs[0].Source)
+	assert.Equal(t, 3, jobs[0].Polls, "the job is pending for two polls")
+}
+
+func TestSimulatorFaults(t *testing.T) {
+	sim := NewSimulator(&SimulatorOpts{FaultRate: 1})
+	c := serp.InitAsync("user", "pass", serp.WithHttpClient(sim.HttpClient()))
+
+	_, err := c.ScrapeGoogleSearchCtx(context.Background(), "adidas", &serp.GoogleSearchOpts{
+		PollInterval: time.Millisecond,
+	})
+	var faulted *oxylabs.FaultedJobError
+	assert.True(t, errors.As(err, &faulted))
+	assert.Equal(t, DefaultFaultReason, faulted.Reason)
+}
+
+func TestSimulatorDeterministicFaults(t *testing.T) {
+	faults := func() []bool {
+		sim := NewSimulator(&SimulatorOpts{FaultRate: 0.5, Seed: 42})
+		for i := 0; i < 20; i++ {
+			resp, err := sim.HttpClient().Post("https://data.oxylabs.io/v1/queries", "application/json", nil)
+			assert.NoError(t, err)
+			resp.Body.Close()
+		}
+
+		var faults []bool
+		for _, job := range sim.Jobs() {
+			faults = append(faults, job.Faulted)
+		}
+		return faults
+	}
+
+	first := faults()
+	assert.Equal(t, first, faults())
+	assert.Contains(t, first, true)
+	assert.Contains(t, first, false)
+}
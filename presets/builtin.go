@@ -0,0 +1,53 @@
+package presets
+
+import "github.com/revvim/oxylabs-sdk-go/oxylabs"
+
+// builtin are the presets maintained in-tree, keyed by domain.
+// Contributed presets are added here.
+var builtin = map[string]map[string]interface{}{
+	"books.toscrape.com": {
+		"title":        text(oxylabs.XpathOne, "//div[contains(@class, 'product_main')]/h1/text()"),
+		"price":        amount("//div[contains(@class, 'product_main')]/p[@class='price_color']/text()"),
+		"availability": text(oxylabs.XpathOne, "normalize-space(//div[contains(@class, 'product_main')]/p[contains(@class, 'availability')])"),
+		"description":  text(oxylabs.XpathOne, "//div[@id='product_description']/following-sibling::p/text()"),
+		"upc":          text(oxylabs.XpathOne, "//th[text()='UPC']/following-sibling::td/text()"),
+	},
+	"quotes.toscrape.com": {
+		"quotes":  text(oxylabs.Xpath, "//div[@class='quote']/span[@class='text']/text()"),
+		"authors": text(oxylabs.Xpath, "//div[@class='quote']//small[@class='author']/text()"),
+	},
+	"news.ycombinator.com": {
+		"titles": text(oxylabs.Xpath, "//span[@class='titleline']/a/text()"),
+		"links":  text(oxylabs.Xpath, "//span[@class='titleline']/a/@href"),
+	},
+}
+
+// newDefault returns the registry of the builtin presets.
+func newDefault() *Registry {
+	r := NewRegistry()
+	for domain, instructions := range builtin {
+		if err := r.Register(domain, instructions); err != nil {
+			panic("invalid builtin preset of " + domain + ": " + err.Error())
+		}
+	}
+
+	return r
+}
+
+// text returns the instructions selecting text with the selector fn.
+func text(fn oxylabs.FnName, selector string) map[string]interface{} {
+	return map[string]interface{}{
+		"_fns": []oxylabs.Fn{{Name: fn, Args: []string{selector}}},
+	}
+}
+
+// amount returns the instructions selecting a price amount.
+func amount(selector string) map[string]interface{} {
+	return map[string]interface{}{
+		"_fns": []oxylabs.Fn{
+			{Name: oxylabs.XpathOne, Args: []string{selector}},
+			{Name: oxylabs.RegexSearch, Args: []any{`[0-9]+(?:\.[0-9]+)?`}},
+			{Name: oxylabs.ConvertToFloat},
+		},
+	}
+}
@@ -0,0 +1,109 @@
+package presets
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Registry maps target domains to parsing_instructions presets.
+type Registry struct {
+	mu      sync.RWMutex
+	presets map[string]map[string]interface{}
+}
+
+// NewRegistry returns an empty registry.
+func NewRegistry() *Registry {
+	return &Registry{presets: make(map[string]map[string]interface{})}
+}
+
+// Default is the registry of the presets maintained in-tree.
+var Default = newDefault()
+
+// Register validates and registers the parsing instructions of the domain,
+// replacing any existing preset. Subdomains of the domain use the preset too.
+func (r *Registry) Register(domain string, instructions map[string]interface{}) error {
+	if err := oxylabs.ValidateParseInstructions(&instructions); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.presets[normalizeDomain(domain)] = instructions
+
+	return nil
+}
+
+// Lookup returns a copy of the preset of the url's domain, falling back
+// to the presets of its parent domains.
+func (r *Registry) Lookup(rawUrl string) (map[string]interface{}, bool) {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil || parsedUrl.Hostname() == "" {
+		return nil, false
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	domain := normalizeDomain(parsedUrl.Hostname())
+	for {
+		if instructions, ok := r.presets[domain]; ok {
+			return copyInstructions(instructions), true
+		}
+
+		i := strings.Index(domain, ".")
+		if i < 0 || !strings.Contains(domain[i+1:], ".") {
+			return nil, false
+		}
+		domain = domain[i+1:]
+	}
+}
+
+// Domains returns the domains with a preset.
+func (r *Registry) Domains() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	domains := make([]string, 0, len(r.presets))
+	for domain := range r.presets {
+		domains = append(domains, domain)
+	}
+
+	return domains
+}
+
+// Register registers the preset of the domain in the default registry.
+func Register(domain string, instructions map[string]interface{}) error {
+	return Default.Register(domain, instructions)
+}
+
+// Lookup returns the preset of the url's domain from the default registry.
+func Lookup(rawUrl string) (map[string]interface{}, bool) {
+	return Default.Lookup(rawUrl)
+}
+
+// normalizeDomain returns the lowercase domain without the www prefix.
+func normalizeDomain(domain string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(domain)), "www.")
+}
+
+// copyInstructions returns a deep copy of the parsing instructions,
+// so that callers can modify a preset without affecting the registry.
+func copyInstructions(instructions map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(instructions))
+	for k, v := range instructions {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			copied[k] = copyInstructions(v)
+		case []oxylabs.Fn:
+			copied[k] = append([]oxylabs.Fn(nil), v...)
+		default:
+			copied[k] = v
+		}
+	}
+
+	return copied
+}
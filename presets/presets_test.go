@@ -0,0 +1,41 @@
+package presets
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookup(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want bool
+	}{
+		{name: "domain", url: "https://books.toscrape.com/catalogue/a-light-in-the-attic_1000/index.html", want: true},
+		{name: "www prefix", url: "https://www.news.ycombinator.com/", want: true},
+		{name: "unknown domain", url: "https://example.com/", want: false},
+		{name: "invalid url", url: "not a url", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ok := Lookup(tt.url)
+			assert.Equal(t, tt.want, ok)
+		})
+	}
+}
+
+func TestRegisterSubdomain(t *testing.T) {
+	r := NewRegistry()
+	assert.NoError(t, r.Register("example.com", text("xpath_one", "//h1/text()")))
+	assert.Error(t, r.Register("example.org", map[string]interface{}{"title": "h1"}))
+
+	preset, ok := r.Lookup("https://shop.example.com/item")
+	assert.True(t, ok)
+
+	// Modifying the returned preset leaves the registry untouched.
+	delete(preset, "_fns")
+	preset, _ = r.Lookup("https://example.com/")
+	assert.Contains(t, preset, "_fns")
+}
@@ -0,0 +1,103 @@
+package proxy
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Validators are the cache validators of a resp, sent back on later reqs
+// so that unchanged pages are answered with 304 Not Modified without a body.
+type Validators struct {
+	ETag         string
+	LastModified time.Time
+}
+
+// ValidatorsFromResp returns the cache validators of the resp.
+func ValidatorsFromResp(resp *http.Response) Validators {
+	validators := Validators{ETag: resp.Header.Get("ETag")}
+	if lastModified, err := http.ParseTime(resp.Header.Get("Last-Modified")); err == nil {
+		validators.LastModified = lastModified
+	}
+
+	return validators
+}
+
+// Empty returns whether there is no validator.
+func (v Validators) Empty() bool {
+	return v.ETag == "" && v.LastModified.IsZero()
+}
+
+// AddConditionalHeaders adds the If-None-Match and If-Modified-Since headers
+// of the validators to the req.
+func AddConditionalHeaders(req *http.Request, validators Validators) {
+	if validators.ETag != "" {
+		req.Header.Set("If-None-Match", validators.ETag)
+	}
+	if !validators.LastModified.IsZero() {
+		req.Header.Set("If-Modified-Since", validators.LastModified.UTC().Format(http.TimeFormat))
+	}
+}
+
+// IsNotModified returns whether the resp is a 304 Not Modified.
+func IsNotModified(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusNotModified
+}
+
+// ValidatorCache keeps the cache validators of the scraped urls.
+type ValidatorCache struct {
+	mu         sync.Mutex
+	validators map[string]Validators
+}
+
+// NewValidatorCache returns an empty validator cache.
+func NewValidatorCache() *ValidatorCache {
+	return &ValidatorCache{validators: make(map[string]Validators)}
+}
+
+// Get returns the validators of the url.
+func (c *ValidatorCache) Get(url string) (Validators, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	validators, ok := c.validators[url]
+	return validators, ok
+}
+
+// Set sets the validators of the url.
+func (c *ValidatorCache) Set(url string, validators Validators) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.validators[url] = validators
+}
+
+// DoConditional sends the req via the proxy client with the validators cached
+// for its url, and caches the validators of a 200 resp.
+// notModified is true if the page is unchanged, in which case the resp has no body.
+func DoConditional(
+	client *http.Client,
+	cache *ValidatorCache,
+	req *http.Request,
+) (resp *http.Response, notModified bool, err error) {
+	url := req.URL.String()
+	if validators, ok := cache.Get(url); ok {
+		AddConditionalHeaders(req, validators)
+	}
+
+	resp, err = client.Do(req)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if IsNotModified(resp) {
+		return resp, true, nil
+	}
+	if resp.StatusCode == http.StatusOK {
+		if validators := ValidatorsFromResp(resp); !validators.Empty() {
+			cache.Set(url, validators)
+		}
+	}
+
+	return resp, false, nil
+}
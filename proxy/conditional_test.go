@@ -0,0 +1,30 @@
+package proxy
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDoConditional(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte("page"))
+	}))
+	defer server.Close()
+
+	cache := NewValidatorCache()
+	for i, wantNotModified := range []bool{false, true} {
+		req, _ := NewRequest("GET", server.URL, nil)
+		resp, notModified, err := DoConditional(server.Client(), cache, req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, wantNotModified, notModified, "req %d", i)
+	}
+}
@@ -2,7 +2,6 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -22,48 +21,64 @@ var BingSearchAcceptedDomainParameters = []oxylabs.Domain{
 
 // checkParameterValidity checks validity of ScrapeBingSearch parameters.
 func (opt *BingSearchOpts) checkParameterValidity() error {
+	var errs []error
+
 	if opt.Domain != "" && !internal.InList(opt.Domain, BingSearchAcceptedDomainParameters) {
-		return fmt.Errorf("invalid domain parameter: %s", opt.Domain)
+		errs = append(errs, fmt.Errorf("invalid domain parameter: %s", opt.Domain))
 	}
 
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.BingSearch, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.Limit > internal.MaxLimit_SERP {
+		errs = append(errs, fmt.Errorf("limit parameter must be between 1 and %d", internal.MaxLimit_SERP))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeBingUrl parameters.
 func (opt *BingUrlOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.BingUrl, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // BingSearchOpts contains all the query parameters available for bing_search.
@@ -77,29 +92,24 @@ type BingSearchOpts struct {
 	UserAgent         oxylabs.UserAgent
 	CallbackUrl       string
 	Render            oxylabs.Render
-	Parse             bool
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
-// ScrapeBingSearch scrapes bing via Oxylabs SERP API with bing_search as source.
-func (c *SerpClient) ScrapeBingSearch(
-	query string,
-	opts ...*BingSearchOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeBingSearchCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *BingSearchOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeBingSearchCtx scrapes bing via Oxylabs SERP API with bing_search as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeBingSearchCtx(
-	ctx context.Context,
+// NewBingSearchRequest prepares and validates the request of ScrapeBingSearch
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewBingSearchRequest(
 	query string,
 	opts ...*BingSearchOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &BingSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -121,9 +131,7 @@ func (c *SerpClient) ScrapeBingSearchCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.BingSearch,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"limit":           opt.Limit,
@@ -135,32 +143,46 @@ func (c *SerpClient) ScrapeBingSearchCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.BingSearch,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeBingSearch scrapes bing via Oxylabs SERP API with bing_search as source.
+func (c *SerpClient) ScrapeBingSearch(
+	query string,
+	opts ...*BingSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeBingSearchCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeBingSearchCtx scrapes bing via Oxylabs SERP API with bing_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeBingSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*BingSearchOpts,
+) (*Resp, error) {
+	req, err := NewBingSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // BingUrlOpts contains all the query parameters available for bing.
@@ -168,30 +190,25 @@ type BingUrlOpts struct {
 	UserAgent         oxylabs.UserAgent
 	GeoLocation       string
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 }
 
-// ScrapeBingUrl scrapes bing via Oxylabs SERP API with bing as source.
-func (c *SerpClient) ScrapeBingUrl(
-	url string,
-	opts ...*BingUrlOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeBingUrlCtx(ctx, url, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *BingUrlOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeBingUrlCtx scrapes bing via Oxylabs SERP API with bing as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeBingUrlCtx(
-	ctx context.Context,
+// NewBingUrlRequest prepares and validates the request of ScrapeBingUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewBingUrlRequest(
 	url string,
 	opts ...*BingUrlOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Check validity of url.
 	err := internal.ValidateUrl(url, "bing")
 	if err != nil {
@@ -215,8 +232,6 @@ func (c *SerpClient) ScrapeBingUrlCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.BingUrl,
-		"url":             url,
 		"user_agent_type": opt.UserAgent,
 		"geo_location":    opt.GeoLocation,
 		"render":          opt.Render,
@@ -224,30 +239,44 @@ func (c *SerpClient) ScrapeBingUrlCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.BingUrl,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeBingUrl scrapes bing via Oxylabs SERP API with bing as source.
+func (c *SerpClient) ScrapeBingUrl(
+	url string,
+	opts ...*BingUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	return c.ScrapeBingUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeBingUrlCtx scrapes bing via Oxylabs SERP API with bing as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeBingUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*BingUrlOpts,
+) (*Resp, error) {
+	req, err := NewBingUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
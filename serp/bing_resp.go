@@ -0,0 +1,63 @@
+package serp
+
+// BingOrganicResult is an organic result of a parsed bing_search page.
+type BingOrganicResult struct {
+	Page       int    `json:"page"`
+	Pos        int    `json:"pos"`
+	Url        string `json:"url"`
+	Title      string `json:"title"`
+	Desc       string `json:"desc"`
+	UrlShown   string `json:"url_shown"`
+	PosOverall int    `json:"pos_overall"`
+}
+
+// BingAdResult is a paid result of a parsed bing_search page.
+type BingAdResult struct {
+	Page       int    `json:"page"`
+	Pos        int    `json:"pos"`
+	Url        string `json:"url"`
+	Title      string `json:"title"`
+	Desc       string `json:"desc"`
+	UrlShown   string `json:"url_shown"`
+	PosOverall int    `json:"pos_overall"`
+}
+
+// BingOrganic returns the organic results of every parsed page of a bing_search resp.
+func (r *Resp) BingOrganic() []BingOrganicResult {
+	var organic []BingOrganicResult
+	for _, result := range r.Results {
+		for _, item := range result.ContentParsed.Results.Organic {
+			organic = append(organic, BingOrganicResult{
+				Page:       result.Page,
+				Pos:        item.Pos,
+				Url:        item.Url,
+				Title:      item.Title,
+				Desc:       item.Desc,
+				UrlShown:   item.UrlShown,
+				PosOverall: item.PosOverall,
+			})
+		}
+	}
+
+	return organic
+}
+
+// BingAds returns the paid results of every parsed page of a bing_search resp.
+func (r *Resp) BingAds() []BingAdResult {
+	var ads []BingAdResult
+	for _, result := range r.Results {
+		for _, item := range result.ContentParsed.Results.Paid {
+			ads = append(ads, BingAdResult{
+				Page:       result.Page,
+				Pos:        item.Pos,
+				Url:        item.Url,
+				Title:      item.Title,
+				Desc:       item.Desc,
+				UrlShown:   item.UrlShown,
+				PosOverall: item.PosOverall,
+			})
+		}
+	}
+
+	return ads
+}
@@ -0,0 +1,56 @@
+package serp
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespBingResults(t *testing.T) {
+	body := []byte(`{"results": [
+		{"page": 1, "content": {"page": 1, "results": {
+			"paid": [{"pos": 1, "url": "https://ad.com", "title": "Ad", "desc": "Buy", "url_shown": "ad.com", "pos_overall": 1}],
+			"organic": [
+				{"pos": 1, "url": "https://a.com", "title": "A", "desc": "First", "url_shown": "a.com", "pos_overall": 2},
+				{"pos": 2, "url": "https://b.com", "title": "B", "desc": "Second", "url_shown": "b.com", "pos_overall": 3}
+			]
+		}}},
+		{"page": 2, "content": {"page": 2, "results": {
+			"organic": [{"pos": 1, "url": "https://c.com", "title": "C", "desc": "Third", "url_shown": "c.com", "pos_overall": 1}]
+		}}}
+	], "job": {"id": "1", "source": "bing_search"}}`)
+
+	resp, err := GetResp(&http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, true, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []BingOrganicResult{
+		{Page: 1, Pos: 1, Url: "https://a.com", Title: "A", Desc: "First", UrlShown: "a.com", PosOverall: 2},
+		{Page: 1, Pos: 2, Url: "https://b.com", Title: "B", Desc: "Second", UrlShown: "b.com", PosOverall: 3},
+		{Page: 2, Pos: 1, Url: "https://c.com", Title: "C", Desc: "Third", UrlShown: "c.com", PosOverall: 1},
+	}, resp.BingOrganic())
+	assert.Equal(t, []BingAdResult{
+		{Page: 1, Pos: 1, Url: "https://ad.com", Title: "Ad", Desc: "Buy", UrlShown: "ad.com", PosOverall: 1},
+	}, resp.BingAds())
+}
+
+func TestRespBingResultsUnparsed(t *testing.T) {
+	resp, err := GetResp(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader([]byte(`{"results": [{"page": 1, "content": "<html></html>"}]}`))),
+	}, false, false)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Empty(t, resp.BingOrganic())
+	assert.Empty(t, resp.BingAds())
+}
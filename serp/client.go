@@ -1,9 +1,10 @@
 package serp
 
 import (
-	"net/http"
+	"context"
 
 	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 type SerpClient struct {
@@ -14,16 +15,10 @@ type SerpClient struct {
 func Init(
 	username string,
 	password string,
+	opts ...ClientOption,
 ) *SerpClient {
 	return &SerpClient{
-		C: &internal.Client{
-			BaseUrl: internal.SyncBaseUrl,
-			ApiCredentials: &internal.ApiCredentials{
-				Username: username,
-				Password: password,
-			},
-			HttpClient: &http.Client{},
-		},
+		C: internal.NewClient(internal.SyncBaseUrl, username, password, opts...),
 	}
 }
 
@@ -31,19 +26,67 @@ type SerpClientAsync struct {
 	C *internal.Client
 }
 
-// Init for Async runtime model.
+// InitAsync for Async runtime model.
 func InitAsync(
 	username string,
 	password string,
+	opts ...ClientOption,
 ) *SerpClientAsync {
 	return &SerpClientAsync{
-		C: &internal.Client{
-			BaseUrl: internal.AsyncBaseUrl,
-			ApiCredentials: &internal.ApiCredentials{
-				Username: username,
-				Password: password,
-			},
-			HttpClient: &http.Client{},
-		},
+		C: internal.NewClient(internal.AsyncBaseUrl, username, password, opts...),
 	}
 }
+
+// Stats returns the request counters per source of the client.
+func (c *SerpClient) Stats() map[oxylabs.Source]oxylabs.SourceStats {
+	return c.C.Stats()
+}
+
+// Stats returns the request counters per source of the client.
+func (c *SerpClientAsync) Stats() map[oxylabs.Source]oxylabs.SourceStats {
+	return c.C.Stats()
+}
+
+// QueueStats returns the metrics of the concurrency guard of the client.
+func (c *SerpClient) QueueStats() oxylabs.QueueStats {
+	return c.C.QueueStats()
+}
+
+// QueueStats returns the metrics of the concurrency guard of the client.
+func (c *SerpClientAsync) QueueStats() oxylabs.QueueStats {
+	return c.C.QueueStats()
+}
+
+// With returns a clone of the client with opts applied on top of its options,
+// sharing its http client and concurrency limit.
+func (c *SerpClient) With(opts ...ClientOption) *SerpClient {
+	return &SerpClient{C: c.C.With(opts...)}
+}
+
+// With returns a clone of the client with opts applied on top of its options,
+// sharing its http client and concurrency limit.
+func (c *SerpClientAsync) With(opts ...ClientOption) *SerpClientAsync {
+	return &SerpClientAsync{C: c.C.With(opts...)}
+}
+
+// Shutdown stops the client from accepting new reqs and waits for the
+// in-flight ones to complete or for ctx to be done.
+func (c *SerpClient) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Shutdown stops the client from accepting new reqs and waits for the
+// in-flight ones to complete or for ctx to be done.
+func (c *SerpClientAsync) Shutdown(ctx context.Context) error {
+	return c.C.Shutdown(ctx)
+}
+
+// Health probes the API and returns its reachability and latency.
+func (c *SerpClient) Health(ctx context.Context) (oxylabs.Health, error) {
+	return c.C.Health(ctx)
+}
+
+// Health probes the API and returns its reachability and latency.
+func (c *SerpClientAsync) Health(ctx context.Context) (oxylabs.Health, error) {
+	return c.C.Health(ctx)
+}
@@ -0,0 +1,25 @@
+package serp
+
+import (
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// detectConsent flags the resp if any of its pages is a consent wall or an
+// interstitial instead of the search results, with a warning per page.
+func (r *Resp) detectConsent() {
+	for _, result := range r.Results {
+		url := result.Url
+		if result.ContentParsed.Url != "" {
+			url = result.ContentParsed.Url
+		}
+		if !oxylabs.IsConsentPage(url, result.Content) {
+			continue
+		}
+
+		r.ConsentWall = true
+		r.Warnings = append(r.Warnings, oxylabs.Warning{
+			Page:    result.Page,
+			Message: "consent or interstitial page returned instead of the results",
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+func TestScrapeCustomSource(t *testing.T) {
+	var payload map[string]interface{}
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"results":[{"content":"<html>adidas</html>","page":1}]}`)),
+		}
+	})}))
+
+	resp, err := c.ScrapeCustomSource(context.Background(), "new_search", map[string]interface{}{
+		"query":  "adidas",
+		"domain": "de",
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, map[string]interface{}{"source": "new_search", "query": "adidas", "domain": "de"}, payload)
+	if assert.Len(t, resp.Results, 1) {
+		assert.Equal(t, "<html>adidas</html>", resp.Results[0].Content)
+	}
+}
+
+func TestScrapeCustomSourceAsync(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(5*time.Millisecond))
+
+	respChan, err := c.ScrapeCustomSource(context.Background(), "new_search", map[string]interface{}{"query": "adidas"})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	resp := <-respChan
+	if assert.NotNil(t, resp) && assert.Len(t, resp.Results, 1) {
+		assert.Contains(t, resp.Results[0].Content, "adidas")
+	}
+	if jobs := sim.Jobs(); assert.Len(t, jobs, 1) {
+		assert.Equal(t, map[string]interface{}{"source": "new_search", "query": "adidas"}, jobs[0].Payload)
+	}
+}
@@ -0,0 +1,129 @@
+package serp
+
+// SerpFeatures are the SERP features of the parsed google results of a resp,
+// in order of the pages. The Has flags tell whether a feature is present on any page.
+type SerpFeatures struct {
+	HasFeaturedSnippet bool
+	HasPeopleAlsoAsk   bool
+	HasSitelinks       bool
+	HasTopStories      bool
+
+	FeaturedSnippets []FeaturedSnippetFeature
+	PeopleAlsoAsk    []PeopleAlsoAskQuestion
+	Sitelinks        []Sitelink
+	TopStories       []TopStoryFeature
+}
+
+// FeaturedSnippetFeature is a featured snippet of a serp.
+type FeaturedSnippetFeature struct {
+	Page       int
+	PosOverall int
+	Url        string
+	Title      string
+	Desc       string
+}
+
+// PeopleAlsoAskQuestion is a question of the People Also Ask box of a serp.
+type PeopleAlsoAskQuestion struct {
+	Page        int
+	Pos         int
+	Question    string
+	Answer      string
+	SourceUrl   string
+	SourceTitle string
+}
+
+// Sitelink is a sitelink of an organic result of a serp. Expanded is true for
+// the sitelinks shown below the result, false for the inline ones.
+type Sitelink struct {
+	Page      int
+	Pos       int
+	ResultUrl string
+	Url       string
+	Title     string
+	Desc      string
+	Expanded  bool
+}
+
+// TopStoryFeature is a story of the top stories box of a serp.
+type TopStoryFeature struct {
+	Page      int
+	Pos       int
+	Url       string
+	Title     string
+	Source    string
+	TimeFrame string
+}
+
+// Features returns the featured snippets, People Also Ask questions,
+// sitelinks and top stories of every parsed page of a google_search resp.
+func (r *Resp) Features() SerpFeatures {
+	var features SerpFeatures
+	for _, result := range r.Results {
+		page := result.Page
+		results := result.ContentParsed.Results
+
+		for _, snippet := range results.FeaturedSnippet {
+			features.FeaturedSnippets = append(features.FeaturedSnippets, FeaturedSnippetFeature{
+				Page:       page,
+				PosOverall: snippet.PosOverall,
+				Url:        snippet.Url,
+				Title:      snippet.Title,
+				Desc:       snippet.Desc,
+			})
+		}
+
+		for _, item := range results.RelatedQuestions.Items {
+			features.PeopleAlsoAsk = append(features.PeopleAlsoAsk, PeopleAlsoAskQuestion{
+				Page:        page,
+				Pos:         item.Pos,
+				Question:    item.Question,
+				Answer:      item.Answer,
+				SourceUrl:   item.Source.Url,
+				SourceTitle: item.Source.Title,
+			})
+		}
+
+		for _, organic := range results.Organic {
+			for _, link := range organic.Sitelinks.Expanded {
+				features.Sitelinks = append(features.Sitelinks, Sitelink{
+					Page:      page,
+					Pos:       organic.Pos,
+					ResultUrl: organic.Url,
+					Url:       link.Url,
+					Title:     link.Title,
+					Desc:      link.Desc,
+					Expanded:  true,
+				})
+			}
+			for _, link := range organic.Sitelinks.Inline {
+				features.Sitelinks = append(features.Sitelinks, Sitelink{
+					Page:      page,
+					Pos:       organic.Pos,
+					ResultUrl: organic.Url,
+					Url:       link.Url,
+					Title:     link.Title,
+					Desc:      link.Desc,
+				})
+			}
+		}
+
+		for _, item := range results.TopStories.Items {
+			features.TopStories = append(features.TopStories, TopStoryFeature{
+				Page:      page,
+				Pos:       item.Pos,
+				Url:       item.Url,
+				Title:     item.Title,
+				Source:    item.Source,
+				TimeFrame: item.TimeFrame,
+			})
+		}
+	}
+
+	features.HasFeaturedSnippet = len(features.FeaturedSnippets) > 0
+	features.HasPeopleAlsoAsk = len(features.PeopleAlsoAsk) > 0
+	features.HasSitelinks = len(features.Sitelinks) > 0
+	features.HasTopStories = len(features.TopStories) > 0
+
+	return features
+}
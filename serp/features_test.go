@@ -0,0 +1,34 @@
+package serp
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespFeatures(t *testing.T) {
+	body := []byte(`{"results": [{"page": 1, "content": {"results": {
+		"featured_snippet": [{"url": "https://a.com", "title": "A", "desc": "snippet", "pos_overall": 1}],
+		"related_questions": {"items": [{"pos": 1, "question": "Why?", "answer": "Because.", "source": {"url": "https://b.com", "title": "B"}}]},
+		"organic": [{"pos": 1, "url": "https://c.com", "sitelinks": {"expanded": [{"url": "https://c.com/x", "title": "X"}], "inline": [{"url": "https://c.com/y", "title": "Y"}]}}]
+	}}}]}`)
+
+	resp := &Resp{Parse: true}
+	assert.NoError(t, json.Unmarshal(body, resp))
+
+	features := resp.Features()
+	assert.True(t, features.HasFeaturedSnippet)
+	assert.True(t, features.HasPeopleAlsoAsk)
+	assert.True(t, features.HasSitelinks)
+	assert.False(t, features.HasTopStories)
+	assert.Equal(t, []FeaturedSnippetFeature{{Page: 1, PosOverall: 1, Url: "https://a.com", Title: "A", Desc: "snippet"}}, features.FeaturedSnippets)
+	assert.Equal(t, []PeopleAlsoAskQuestion{{
+		Page: 1, Pos: 1, Question: "Why?", Answer: "Because.", SourceUrl: "https://b.com", SourceTitle: "B",
+	}}, features.PeopleAlsoAsk)
+	assert.Equal(t, []Sitelink{
+		{Page: 1, Pos: 1, ResultUrl: "https://c.com", Url: "https://c.com/x", Title: "X", Expanded: true},
+		{Page: 1, Pos: 1, ResultUrl: "https://c.com", Url: "https://c.com/y", Title: "Y"},
+	}, features.Sitelinks)
+	assert.Empty(t, features.TopStories)
+}
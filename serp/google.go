@@ -2,7 +2,6 @@ package serp
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"time"
 
@@ -32,235 +31,305 @@ var AcceptedSearchTypeParameters = []string{
 
 // checkParameterValidity checks validity of ScrapeGoogleSearch parameters.
 func (opt *GoogleSearchOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleSearch, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.Limit > internal.MaxLimit_SERP {
+		errs = append(errs, fmt.Errorf("limit parameter must be between 1 and %d", internal.MaxLimit_SERP))
 	}
 
 	if ctx["tbm"] != nil && !internal.InList(ctx["tbm"].(string), AcceptedTbmParameters) {
-		return fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"])
+		errs = append(errs, fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"]))
+	}
+
+	if err := oxylabs.ValidateSafeSearch(ctx, opt.AdultContent); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleUrl parameters.
 func (opt *GoogleUrlOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleUrl, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleAds parameters.
 func (opt *GoogleAdsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleAds, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if ctx["tbm"] != nil && !internal.InList(ctx["tbm"].(string), AcceptedTbmParameters) {
-		return fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"])
+		errs = append(errs, fmt.Errorf("invalid tbm parameter: %v", ctx["tbm"]))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleSuggestions parameters.
 func (opt *GoogleSuggestionsOpts) checkParameterValidity() error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleSuggestions, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleHotels parameters.
 func (opt *GoogleHotelsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleHotels, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Limit <= 0 || opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("limit, pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("limit, pages and start_page parameters must be greater than 0"))
+	}
+
+	if opt.Limit > internal.MaxLimit_SERP {
+		errs = append(errs, fmt.Errorf("limit parameter must be between 1 and %d", internal.MaxLimit_SERP))
 	}
 
 	if ctx["hotel_occupancy"] != nil && ctx["hotel_occupancy"].(int) < 0 {
-		return fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"])
+		errs = append(errs, fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"]))
+	}
+
+	if dates, ok := ctx["hotel_dates"].(string); ok {
+		if err := oxylabs.ValidateHotelDates(dates); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleTravelHotels parameters.
 func (opt *GoogleTravelHotelsOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleTravelHotels, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.StartPage <= 0 {
-		return fmt.Errorf("start_page must be greater than 0")
+		errs = append(errs, fmt.Errorf("start_page must be greater than 0"))
 	}
 
 	if ctx["hotel_occupancy"] != nil && ctx["hotel_occupancy"].(int) < 0 {
-		return fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"])
+		errs = append(errs, fmt.Errorf("invalid hotel_occupancy parameter: %v", ctx["hotel_occupancy"]))
+	}
+
+	if dates, ok := ctx["hotel_dates"].(string); ok {
+		if err := oxylabs.ValidateHotelDates(dates); err != nil {
+			errs = append(errs, err)
+		}
 	}
 
 	if ctx["hotel_classes"] != nil {
 		for _, value := range ctx["hotel_classes"].([]int) {
 			if value < 2 || value > 5 {
-				return fmt.Errorf("invalid hotel_classes parameter: %v", value)
+				errs = append(errs, fmt.Errorf("invalid hotel_classes parameter: %v", value))
 			}
 		}
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleTrendsExplore parameters.
 func (opt *GoogleTrendsExploreOpts) checkParameterValidity(ctx oxylabs.ContextOption) error {
+	var errs []error
+
 	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
-		return fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent)
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
 	}
 
 	if ctx["search_type"] != nil && !internal.InList(ctx["search_type"].(string), AcceptedSearchTypeParameters) {
-		return fmt.Errorf("invalid search_type parameter: %v", ctx["search_type"])
+		errs = append(errs, fmt.Errorf("invalid search_type parameter: %v", ctx["search_type"]))
 	}
 
 	if ctx["category_id"] != nil && ctx["category_id"].(int) < 0 {
-		return fmt.Errorf("invalid category_id")
+		errs = append(errs, fmt.Errorf("invalid category_id"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // checkParameterValidity checks validity of ScrapeGoogleImages parameters.
 func (opt *GoogleImagesOpts) checkParameterValidity() error {
+	var errs []error
+
+	if !oxylabs.IsUserAgentValid(opt.UserAgent) {
+		errs = append(errs, fmt.Errorf("invalid user agent parameter: %v", opt.UserAgent))
+	}
+
 	if opt.Render != "" && !oxylabs.IsRenderValid(opt.Render) {
-		return fmt.Errorf("invalid render parameter: %v", opt.Render)
+		errs = append(errs, fmt.Errorf("invalid render parameter: %v", opt.Render))
+	}
+
+	if err := oxylabs.ValidateViewport(oxylabs.GoogleImages, opt.Viewport, opt.Render); err != nil {
+		errs = append(errs, err)
 	}
 
 	if opt.Pages <= 0 || opt.StartPage <= 0 {
-		return fmt.Errorf("pages and start_page parameters must be greater than 0")
+		errs = append(errs, fmt.Errorf("pages and start_page parameters must be greater than 0"))
 	}
 
 	if opt.ParseInstructions != nil {
 		if err := oxylabs.ValidateParseInstructions(opt.ParseInstructions); err != nil {
-			return fmt.Errorf("invalid parse instructions: %w", err)
+			errs = append(errs, fmt.Errorf("invalid parse instructions: %w", err))
 		}
 	}
 
-	return nil
+	return oxylabs.NewValidationError(errs)
 }
 
 // GoogleSearchOpts contains all the query parameters available for google_search.
 type GoogleSearchOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Pages             int
 	Limit             int
 	Locale            oxylabs.Locale
+	AutoLocale        bool
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
+	AdultContent      oxylabs.AdultContent
 	Context           []func(oxylabs.ContextOption)
 }
 
-// ScrapeGoogleSearch scrapes google via Oxylabs SERP API with google_search as source.
-func (c *SerpClient) ScrapeGoogleSearch(
-	query string,
-	opts ...*GoogleSearchOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleSearchCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleSearchOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleSearchCtx scrapes google via Oxylabs SERP API with google_search as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleSearchCtx(
-	ctx context.Context,
+// NewGoogleSearchRequest prepares and validates the request of ScrapeGoogleSearch
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleSearchRequest(
 	query string,
 	opts ...*GoogleSearchOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleSearchOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -273,14 +342,25 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleSearch, context); err != nil {
+		return nil, err
+	}
+
 	// Check if limit_per_page context parameter is used together with limit, start_page or pages parameters.
 	if (opt.Limit != 0 || opt.StartPage != 0 || opt.Pages != 0) && context["limit_per_page"] != nil {
-		return nil, fmt.Errorf(
+		return nil, oxylabs.NewValidationError([]error{fmt.Errorf(
 			"limit, start_page and pages parameters cannot be used together with limit_per_page context parameter",
-		)
+		)})
 	}
 
 	// Set defaults.
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
 	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
@@ -293,11 +373,12 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 		return nil, err
 	}
 
+	// Filter adult content with safe search, if asked to.
+	oxylabs.ApplyAdultContent(context, opt.AdultContent)
+
 	// Prepare payload with common parameters.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleSearch,
 		"domain":          opt.Domain,
-		"query":           query,
 		"locale":          opt.Locale,
 		"geo_location":    opt.GeoLocation,
 		"user_agent_type": opt.UserAgent,
@@ -345,32 +426,46 @@ func (c *SerpClient) ScrapeGoogleSearchCtx(
 		payload["limit"] = opt.Limit
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleSearch,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeGoogleSearch scrapes google via Oxylabs SERP API with google_search as source.
+func (c *SerpClient) ScrapeGoogleSearch(
+	query string,
+	opts ...*GoogleSearchOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleSearchCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleSearchCtx scrapes google via Oxylabs SERP API with google_search as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleSearchCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSearchOpts,
+) (*Resp, error) {
+	req, err := NewGoogleSearchRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleUrlOpts contains all the query parameters available for google.
@@ -378,30 +473,25 @@ type GoogleUrlOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
-	Parse             bool
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	CallbackUrl       string
 	PollInterval      time.Duration
 }
 
-// ScrapeGoogleUrl scrapes google via Oxylabs SERP API with google as source.
-func (c *SerpClient) ScrapeGoogleUrl(
-	url string,
-	opts ...*GoogleUrlOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleUrlCtx(ctx, url, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleUrlOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleUrlCtx scrapes google via Oxylabs SERP API with google as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleUrlCtx(
-	ctx context.Context,
+// NewGoogleUrlRequest prepares and validates the request of ScrapeGoogleUrl
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleUrlRequest(
 	url string,
 	opts ...*GoogleUrlOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Check validity of URL.
 	err := internal.ValidateUrl(url, "google")
 	if err != nil {
@@ -425,8 +515,6 @@ func (c *SerpClient) ScrapeGoogleUrlCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleUrl,
-		"url":             url,
 		"user_agent_type": opt.UserAgent,
 		"render":          opt.Render,
 		"callback_url":    opt.CallbackUrl,
@@ -434,68 +522,79 @@ func (c *SerpClient) ScrapeGoogleUrlCtx(
 		"parse":           opt.Parse,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleUrl,
+		Url:               url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeGoogleUrl scrapes google via Oxylabs SERP API with google as source.
+func (c *SerpClient) ScrapeGoogleUrl(
+	url string,
+	opts ...*GoogleUrlOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleUrlCtx(ctx, url, opts...)
+}
+
+// ScrapeGoogleUrlCtx scrapes google via Oxylabs SERP API with google as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleUrlCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleUrlOpts,
+) (*Resp, error) {
+	req, err := NewGoogleUrlRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleAdsOpts contains all the query parameters available for google_ads.
 type GoogleAdsOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Pages             int
 	Locale            string
+	AutoLocale        bool
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 	Context           []func(oxylabs.ContextOption)
 }
 
-// ScrapeGoogleAds scrapes google via Oxylabs SERP API with google_ads as source.
-func (c *SerpClient) ScrapeGoogleAds(
-	query string,
-	opts ...*GoogleAdsOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleAdsCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleAdsOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleAdsCtx scrapes google via Oxylabs SERP API with google_ads as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleAdsCtx(
-	ctx context.Context,
+// NewGoogleAdsRequest prepares and validates the request of ScrapeGoogleAds
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleAdsRequest(
 	query string,
 	opts ...*GoogleAdsOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleAdsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -508,7 +607,18 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleAds, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
 	internal.SetDefaultPages(&opt.Pages)
@@ -521,9 +631,7 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 	}
 
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleAds,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"locale":          opt.Locale,
@@ -552,32 +660,46 @@ func (c *SerpClient) ScrapeGoogleAdsCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleAds,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+// ScrapeGoogleAds scrapes google via Oxylabs SERP API with google_ads as source.
+func (c *SerpClient) ScrapeGoogleAds(
+	query string,
+	opts ...*GoogleAdsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleAdsCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleAdsCtx scrapes google via Oxylabs SERP API with google_ads as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleAdsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleAdsOpts,
+) (*Resp, error) {
+	req, err := NewGoogleAdsRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleSuggestionsOpts contains all the query parameters available for google_shopping.
@@ -586,29 +708,24 @@ type GoogleSuggestionsOpts struct {
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 	CallbackUrl       string
 }
 
-// ScrapeGoogleSuggestions scrapes google via Oxylabs SERP API with google_suggestions as source.
-func (c *SerpClient) ScrapeGoogleSuggestions(
-	query string,
-	opts ...*GoogleSuggestionsOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleSuggestionsOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleSuggestionsCtx scrapes google via  Oxylabs SERP API with google_suggestions as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
-	ctx context.Context,
+// NewGoogleSuggestionsRequest prepares and validates the request of ScrapeGoogleSuggestions
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleSuggestionsRequest(
 	query string,
 	opts ...*GoogleSuggestionsOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleSuggestionsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -626,8 +743,6 @@ func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleSuggestions,
-		"query":           query,
 		"locale":          opt.Locale,
 		"geo_location":    opt.GeoLocation,
 		"user_agent_type": opt.UserAgent,
@@ -635,68 +750,84 @@ func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
 		"callback_url":    opt.CallbackUrl,
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
+	// Custom parsing instructions require parsed results.
 	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
+		payload["parse"] = true
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleSuggestions,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeGoogleSuggestions scrapes google via Oxylabs SERP API with google_suggestions as source.
+func (c *SerpClient) ScrapeGoogleSuggestions(
+	query string,
+	opts ...*GoogleSuggestionsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleSuggestionsCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleSuggestionsCtx scrapes google via  Oxylabs SERP API with google_suggestions as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleSuggestionsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleSuggestionsOpts,
+) (*Resp, error) {
+	req, err := NewGoogleSuggestionsRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleHotelsOpts contains all the query parameters available for google_hotels.
 type GoogleHotelsOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Pages             int
 	Limit             int
 	Locale            string
+	AutoLocale        bool
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 	Context           []func(oxylabs.ContextOption)
 }
 
-// ScrapeGoogleHotels scrapes google via Oxylabs SERP API with google_hotels as source.
-func (c *SerpClient) ScrapeGoogleHotels(
-	query string,
-	opts ...*GoogleHotelsOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleHotelsCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleHotelsOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleHotelsCtx scrapes google via the google_hotels source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleHotelsCtx(
-	ctx context.Context,
+// NewGoogleHotelsRequest prepares and validates the request of ScrapeGoogleHotels
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleHotelsRequest(
 	query string,
 	opts ...*GoogleHotelsOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleHotelsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -709,7 +840,18 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleHotels, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
 	internal.SetDefaultLimit(&opt.Limit, internal.DefaultLimit_SERP)
@@ -725,9 +867,7 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleHotels,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"limit":           opt.Limit,
@@ -756,67 +896,82 @@ func (c *SerpClient) ScrapeGoogleHotelsCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
+	// Custom parsing instructions require parsed results.
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleHotels,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeGoogleHotels scrapes google via Oxylabs SERP API with google_hotels as source.
+func (c *SerpClient) ScrapeGoogleHotels(
+	query string,
+	opts ...*GoogleHotelsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleHotelsCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleHotelsCtx scrapes google via the google_hotels source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleHotelsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleHotelsOpts,
+) (*Resp, error) {
+	req, err := NewGoogleHotelsRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleTravelHotelsOpts contains all the query parameters available for google_travel_hotels.
 type GoogleTravelHotelsOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Locale            string
+	AutoLocale        bool
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 	Context           []func(oxylabs.ContextOption)
 }
 
-// ScrapeGoogleTravelHotels scrapes google via Oxylabs SERP API with google_travel_hotels as source.
-func (c *SerpClient) ScrapeGoogleTravelHotels(
-	query string,
-	opts ...*GoogleTravelHotelsOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleTravelHotelsOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleTravelHotelsCtx scrapes google via Oxylabs SERP API with google_travel_hotels as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
-	ctx context.Context,
+// NewGoogleTravelHotelsRequest prepares and validates the request of ScrapeGoogleTravelHotels
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleTravelHotelsRequest(
 	query string,
 	opts ...*GoogleTravelHotelsOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleTravelHotelsOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -829,9 +984,21 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleTravelHotels, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
+	internal.SetDefaultUserAgent(&opt.UserAgent)
 
 	// Check validity of parameters.
 	err := opt.checkParameterValidity(context)
@@ -841,9 +1008,7 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleTravelHotels,
 		"domain":          opt.Domain,
-		"query":           query,
 		"start_page":      opt.StartPage,
 		"locale":          opt.Locale,
 		"geo_location":    opt.GeoLocation,
@@ -866,69 +1031,84 @@ func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
+	// Custom parsing instructions require parsed results.
 	if opt.ParseInstructions != nil {
 		payload["parse"] = true
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleTravelHotels,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeGoogleTravelHotels scrapes google via Oxylabs SERP API with google_travel_hotels as source.
+func (c *SerpClient) ScrapeGoogleTravelHotels(
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleTravelHotelsCtx(ctx, query, opts...)
+}
+
+// ScrapeGoogleTravelHotelsCtx scrapes google via Oxylabs SERP API with google_travel_hotels as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleTravelHotelsCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTravelHotelsOpts,
+) (*Resp, error) {
+	req, err := NewGoogleTravelHotelsRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
 
 // GoogleImagesOpts contains all the query parameters available for google_images.
 type GoogleImagesOpts struct {
 	Domain            oxylabs.Domain
+	AutoDomain        bool
 	StartPage         int
 	Pages             int
 	Locale            string
+	AutoLocale        bool
 	GeoLocation       string
 	UserAgent         oxylabs.UserAgent
 	Render            oxylabs.Render
+	Output            oxylabs.Output
+	Viewport          *oxylabs.Viewport
 	CallbackUrl       string
-	Parse             bool
+	Parse             *bool
 	ParseInstructions *map[string]interface{}
 	PollInterval      time.Duration
 	Context           []func(oxylabs.ContextOption)
 }
 
-// ScrapeGoogleImages scrapes google via Oxylabs SERP API with google_images as source.
-func (c *SerpClient) ScrapeGoogleImages(
-	url string,
-	opts ...*GoogleImagesOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleImagesCtx(ctx, url, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleImagesOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleImagesCtx scrapes google via Oxylabs SERP API with google_images as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleImagesCtx(
-	ctx context.Context,
+// NewGoogleImagesRequest prepares and validates the request of ScrapeGoogleImages
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleImagesRequest(
 	url string,
 	opts ...*GoogleImagesOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleImagesOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -941,8 +1121,19 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleImages, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
 	internal.SetDefaultUserAgent(&opt.UserAgent)
+	if opt.AutoLocale {
+		internal.SetGeoLocale(&opt.Locale, opt.GeoLocation)
+	}
+	if opt.AutoDomain {
+		internal.SetLocaleDomain(&opt.Domain, opt.GeoLocation, string(opt.Locale))
+	}
 	internal.SetDefaultDomain(&opt.Domain)
 	internal.SetDefaultStartPage(&opt.StartPage)
 	internal.SetDefaultPages(&opt.Pages)
@@ -955,9 +1146,7 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source":          oxylabs.GoogleImages,
 		"domain":          opt.Domain,
-		"query":           url,
 		"start_page":      opt.StartPage,
 		"pages":           opt.Pages,
 		"locale":          opt.Locale,
@@ -978,33 +1167,46 @@ func (c *SerpClient) ScrapeGoogleImagesCtx(
 		},
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
-	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
-	}
+	// Add viewport to the payload if provided.
+	oxylabs.SetViewport(payload, opt.Viewport)
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	// Prepare request.
+	req := &oxylabs.Request{
+		Source:            oxylabs.GoogleImages,
+		Query:             url,
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
 	}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+	// Apply the output format.
+	return internal.WithOutput(req, opt.Output)
+}
+
+// ScrapeGoogleImages scrapes google via Oxylabs SERP API with google_images as source.
+func (c *SerpClient) ScrapeGoogleImages(
+	url string,
+	opts ...*GoogleImagesOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, opt.Parse, customParserFlag)
+	return c.ScrapeGoogleImagesCtx(ctx, url, opts...)
+}
+
+// ScrapeGoogleImagesCtx scrapes google via Oxylabs SERP API with google_images as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleImagesCtx(
+	ctx context.Context,
+	url string,
+	opts ...*GoogleImagesOpts,
+) (*Resp, error) {
+	req, err := NewGoogleImagesRequest(url, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
-
+	return c.Do(ctx, req)
 }
 
 // GoogleTrendsExploreOpts contains all the query parameters available for google_trends_explore.
@@ -1017,24 +1219,17 @@ type GoogleTrendsExploreOpts struct {
 	PollInterval      time.Duration
 }
 
-// ScrapeGoogleTrendsExplore scrapes google via Oxylabs SERP API with google_trends_explore as source.
-func (c *SerpClient) ScrapeGoogleTrendsExplore(
-	query string,
-	opts ...*GoogleTrendsExploreOpts,
-) (*Resp, error) {
-	ctx, cancel := context.WithTimeout(context.Background(), internal.DefaultTimeout)
-	defer cancel()
-
-	return c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
+// String returns a compact summary of the set options for logging.
+func (opt *GoogleTrendsExploreOpts) String() string {
+	return internal.Summary(opt)
 }
 
-// ScrapeGoogleTrendsExploreCtx scrapes google via Oxylabs SERP API with google_trends_explore as source.
-// The provided context allows customization of the HTTP req, including setting timeouts.
-func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
-	ctx context.Context,
+// NewGoogleTrendsExploreRequest prepares and validates the request of ScrapeGoogleTrendsExplore
+// without sending it, so it can be inspected, persisted, or sent later via Do.
+func NewGoogleTrendsExploreRequest(
 	query string,
 	opts ...*GoogleTrendsExploreOpts,
-) (*Resp, error) {
+) (*oxylabs.Request, error) {
 	// Prepare options.
 	opt := &GoogleTrendsExploreOpts{}
 	if len(opts) > 0 && opts[len(opts)-1] != nil {
@@ -1047,6 +1242,11 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 		modifier(context)
 	}
 
+	// Check the context parameters are supported by the source.
+	if err := oxylabs.ValidateContext(oxylabs.GoogleTrendsExplore, context); err != nil {
+		return nil, err
+	}
+
 	// Set defaults.
 	internal.SetDefaultUserAgent(&opt.UserAgent)
 
@@ -1058,8 +1258,6 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 
 	// Prepare payload.
 	payload := map[string]interface{}{
-		"source": oxylabs.GoogleTrendsExplore,
-		"query":  query,
 		"context": []map[string]interface{}{
 			{
 				"key":   "search_type",
@@ -1087,30 +1285,43 @@ func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
 		payload["geo_location"] = opt.GeoLocation
 	}
 
-	// Add custom parsing instructions to the payload if provided.
-	customParserFlag := false
+	// Custom parsing instructions require parsed results.
 	if opt.ParseInstructions != nil {
-		payload["parsing_instructions"] = &opt.ParseInstructions
-		customParserFlag = true
+		payload["parse"] = true
 	}
 
-	// Marshal.
-	jsonPayload, err := json.Marshal(payload)
-	if err != nil {
-		return nil, fmt.Errorf("error marshalling payload: %v", err)
-	}
+	// Prepare request.
+	return &oxylabs.Request{
+		Source:            oxylabs.GoogleTrendsExplore,
+		Query:             oxylabs.SanitizeQuery(query),
+		Params:            payload,
+		ParseInstructions: opt.ParseInstructions,
+		PollInterval:      opt.PollInterval,
+	}, nil
+}
 
-	// Req.
-	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
-	if err != nil {
-		return nil, err
-	}
+// ScrapeGoogleTrendsExplore scrapes google via Oxylabs SERP API with google_trends_explore as source.
+func (c *SerpClient) ScrapeGoogleTrendsExplore(
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) (*Resp, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), c.C.Timeout())
+	defer cancel()
+
+	return c.ScrapeGoogleTrendsExploreCtx(ctx, query, opts...)
+}
 
-	// Unmarshal the http Response and get the response.
-	resp, err := GetResp(httpResp, customParserFlag, customParserFlag)
+// ScrapeGoogleTrendsExploreCtx scrapes google via Oxylabs SERP API with google_trends_explore as source.
+// The provided context allows customization of the HTTP req, including setting timeouts.
+func (c *SerpClient) ScrapeGoogleTrendsExploreCtx(
+	ctx context.Context,
+	query string,
+	opts ...*GoogleTrendsExploreOpts,
+) (*Resp, error) {
+	req, err := NewGoogleTrendsExploreRequest(query, internal.WithClientDefaults(c.C, opts))
 	if err != nil {
 		return nil, err
 	}
 
-	return resp, nil
+	return c.Do(ctx, req)
 }
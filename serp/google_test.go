@@ -0,0 +1,210 @@
+package serp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestNewGoogleSearchRequestValidationErrors(t *testing.T) {
+	_, err := NewGoogleSearchRequest("adidas", &GoogleSearchOpts{
+		UserAgent: oxylabs.UserAgent("toaster"),
+		Render:    oxylabs.Render("pdf"),
+		Limit:     -1,
+	})
+
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "invalid user agent parameter: toaster")
+	assert.ErrorContains(t, err, "invalid render parameter: pdf")
+	assert.ErrorContains(t, err, "limit, pages and start_page parameters must be greater than 0")
+}
+
+func TestNewGoogleAdsRequestUnsupportedContext(t *testing.T) {
+	_, err := NewGoogleAdsRequest("adidas", &GoogleAdsOpts{
+		Context: []func(oxylabs.ContextOption){oxylabs.SafeSearch(true)},
+	})
+
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "safe_search context parameter is not supported by the google_ads source")
+}
+
+func TestNewGoogleHotelsRequestHotelDates(t *testing.T) {
+	_, err := NewGoogleHotelsRequest("hotels in paris", &GoogleHotelsOpts{
+		Context: []func(oxylabs.ContextOption){oxylabs.HotelCheckInOut("2024-07-14", "2024-07-12")},
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "check-out must be after check-in")
+
+	_, err = NewGoogleHotelsRequest("hotels in paris", &GoogleHotelsOpts{
+		Context: []func(oxylabs.ContextOption){oxylabs.HotelCheckInOut("2024-07-12", "2024-07-14")},
+	})
+	assert.NoError(t, err)
+}
+
+func TestNewGoogleSearchRequestAdultContent(t *testing.T) {
+	req, err := NewGoogleSearchRequest("adidas", &GoogleSearchOpts{AdultContent: oxylabs.ADULT_CONTENT_FILTER})
+	assert.NoError(t, err)
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Contains(t, payload["context"], map[string]interface{}{"key": "safe_search", "value": true})
+
+	_, err = NewGoogleSearchRequest("adidas", &GoogleSearchOpts{
+		AdultContent: oxylabs.ADULT_CONTENT_FILTER,
+		Context:      []func(oxylabs.ContextOption){oxylabs.SafeSearch(false)},
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "safe_search context parameter contradicts the filter adult content handling")
+}
+
+func TestRespDetectConsent(t *testing.T) {
+	resp := &Resp{Results: []Results{
+		{Page: 1, Url: "https://www.google.com/search?q=adidas", Content: "<html>results</html>"},
+		{Page: 2, Url: "https://consent.google.com/ml?continue=https://www.google.com/search"},
+	}}
+	resp.detectConsent()
+
+	assert.True(t, resp.ConsentWall)
+	assert.Len(t, resp.Warnings, 1)
+	assert.Equal(t, 2, resp.Warnings[0].Page)
+}
+
+func TestNewGoogleSearchRequestAutoLocale(t *testing.T) {
+	req, err := NewGoogleSearchRequest("adidas", &GoogleSearchOpts{
+		GeoLocation: "Berlin,Germany",
+		AutoLocale:  true,
+		AutoDomain:  true,
+	})
+	assert.NoError(t, err)
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.Locale("de-DE"), payload["locale"])
+	assert.Equal(t, oxylabs.DOMAIN_DE, payload["domain"])
+
+	// An explicit locale is kept.
+	req, err = NewGoogleSearchRequest("adidas", &GoogleSearchOpts{
+		GeoLocation: "Germany",
+		Locale:      oxylabs.LOCALE_EN,
+		AutoLocale:  true,
+	})
+	assert.NoError(t, err)
+	payload, err = req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, oxylabs.LOCALE_EN, payload["locale"])
+}
+
+func TestNewGoogleTravelHotelsRequestStartPage(t *testing.T) {
+	req, err := NewGoogleTravelHotelsRequest("hotels in paris", &GoogleTravelHotelsOpts{UserAgent: oxylabs.UA_DESKTOP})
+	assert.NoError(t, err)
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, payload["start_page"])
+
+	_, err = NewGoogleTravelHotelsRequest("hotels in paris", &GoogleTravelHotelsOpts{StartPage: -1})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "start_page must be greater than 0")
+}
+
+func TestNewGoogleSearchRequestViewport(t *testing.T) {
+	_, err := NewGoogleSearchRequest("adidas", &GoogleSearchOpts{
+		Render:   oxylabs.HTML,
+		Viewport: &oxylabs.Viewport{Width: 390, Height: 844, DeviceScaleFactor: 3},
+	})
+	assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+	assert.ErrorContains(t, err, "viewport parameter is not supported by the google_search source")
+
+	req, err := NewGoogleSearchRequest("adidas", &GoogleSearchOpts{Render: oxylabs.HTML})
+	assert.NoError(t, err)
+	payload, err := req.Payload()
+	assert.NoError(t, err)
+	assert.NotContains(t, payload, "viewport")
+}
+
+func TestNewRequestLimit(t *testing.T) {
+	tests := []struct {
+		name    string
+		newReq  func(limit int) (*oxylabs.Request, error)
+		limit   int
+		want    int
+		wantErr string
+	}{
+		{
+			name: "google_search default",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleSearchRequest("adidas", &GoogleSearchOpts{Limit: limit})
+			},
+			want: 10,
+		},
+		{
+			name: "google_search max",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleSearchRequest("adidas", &GoogleSearchOpts{Limit: limit})
+			},
+			limit: 100,
+			want:  100,
+		},
+		{
+			name: "google_search above max",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleSearchRequest("adidas", &GoogleSearchOpts{Limit: limit})
+			},
+			limit:   101,
+			wantErr: "limit parameter must be between 1 and 100",
+		},
+		{
+			name: "google_hotels",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleHotelsRequest("paris", &GoogleHotelsOpts{Limit: limit})
+			},
+			limit: 20,
+			want:  20,
+		},
+		{
+			name: "google_hotels above max",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleHotelsRequest("paris", &GoogleHotelsOpts{Limit: limit})
+			},
+			limit:   200,
+			wantErr: "limit parameter must be between 1 and 100",
+		},
+		{
+			name: "google_hotels negative",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewGoogleHotelsRequest("paris", &GoogleHotelsOpts{Limit: limit})
+			},
+			limit:   -1,
+			wantErr: "limit, pages and start_page parameters must be greater than 0",
+		},
+		{
+			name: "bing_search default",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewBingSearchRequest("adidas", &BingSearchOpts{Limit: limit})
+			},
+			want: 10,
+		},
+		{
+			name: "bing_search above max",
+			newReq: func(limit int) (*oxylabs.Request, error) {
+				return NewBingSearchRequest("adidas", &BingSearchOpts{Limit: limit})
+			},
+			limit:   101,
+			wantErr: "limit parameter must be between 1 and 100",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := tt.newReq(tt.limit)
+			if tt.wantErr != "" {
+				assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter)
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			payload, err := req.Payload()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, payload["limit"])
+		})
+	}
+}
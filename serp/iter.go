@@ -0,0 +1,86 @@
+//go:build go1.23
+
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"iter"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+)
+
+// Items iterates over the parsed organic results of every page of the resp.
+func (r *Resp) Items() iter.Seq[Organic] {
+	return func(yield func(Organic) bool) {
+		for _, result := range r.Results {
+			for _, item := range result.ContentParsed.Results.Organic {
+				if !yield(item) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// RawItems iterates over the raw organic results of every page of the resp,
+// decoding a single result at a time, so large result sets can be decoded
+// lazily into custom types.
+func (r *Resp) RawItems() iter.Seq2[json.RawMessage, error] {
+	return func(yield func(json.RawMessage, error) bool) {
+		for _, result := range r.Results {
+			for item, err := range internal.ArrayItems(result.ContentRaw, "results", "organic") {
+				if !yield(item, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Paginator iterates over the pages of a paginated scrape.
+type Paginator struct {
+	first *Resp
+}
+
+// NewPaginator returns a paginator starting at resp.
+func NewPaginator(resp *Resp) *Paginator {
+	return &Paginator{first: resp}
+}
+
+// All iterates over the first resp and every next page, scraped lazily
+// as the iteration goes on, until there is no next page or scraping fails.
+// The organic results already yielded by previous pages are dropped from every
+// page, as reported by its Dedup; the first resp is left untouched.
+func (p *Paginator) All(ctx context.Context) iter.Seq2[*Resp, error] {
+	return func(yield func(*Resp, error) bool) {
+		seen := make(map[string]bool)
+		resp := p.first
+		for resp != nil {
+			page := resp.copyResults()
+			page.Dedup = page.dedupOrganic(seen)
+			if !yield(page, nil) {
+				return
+			}
+			if _, ok := resp.NextPageHint(); !ok {
+				return
+			}
+
+			next, err := resp.NextPage(ctx)
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			resp = next
+		}
+	}
+}
+
+// copyResults returns a copy of the resp with its own results,
+// so that the results can be changed without changing the resp.
+func (r *Resp) copyResults() *Resp {
+	next := *r
+	next.Results = append([]Results(nil), r.Results...)
+
+	return &next
+}
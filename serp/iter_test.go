@@ -0,0 +1,50 @@
+//go:build go1.23
+
+package serp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPaginatorDedup(t *testing.T) {
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		var payload map[string]interface{}
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		page, _ := payload["start_page"].(float64)
+		body, _ := json.Marshal(map[string]interface{}{
+			"results": []map[string]interface{}{{"content": overlappingPage(int(page)), "page": int(page)}},
+		})
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(bytes.NewReader(body))}
+	})}))
+
+	first, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{Parse: oxylabs.Bool(true)})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var (
+		urls    []string
+		reports []DedupReport
+	)
+	for resp, err := range NewPaginator(first).All(context.Background()) {
+		if !assert.NoError(t, err) {
+			return
+		}
+		for item := range resp.Items() {
+			urls = append(urls, item.Url)
+		}
+		reports = append(reports, resp.Dedup)
+	}
+
+	assert.Equal(t, []string{"https://example.com/1", "https://example.com/2", "https://example.com/3"}, urls)
+	assert.Equal(t, []DedupReport{{Total: 2, Kept: 2}, {Total: 2, Kept: 1, Dropped: 1}}, reports)
+	assert.Len(t, first.Results[0].ContentParsed.Results.Organic, 2, "the first resp is left untouched")
+}
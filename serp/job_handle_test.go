@@ -0,0 +1,72 @@
+package serp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/callback"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJobHandle(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 50 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(10*time.Millisecond))
+	req, _ := NewBingSearchRequest("shoes")
+
+	ctx := context.Background()
+	h, err := c.Submit(ctx, req)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	partial, err := h.PartialResults(ctx)
+	assert.NoError(t, err)
+	assert.Empty(t, partial.Results)
+
+	resp, err := h.Result(ctx)
+	assert.NoError(t, err)
+	if assert.Len(t, resp.Results, 1) {
+		assert.Contains(t, resp.Results[0].Content, "shoes")
+	}
+
+	v := struct {
+		Results []struct {
+			Content string `json:"content"`
+		} `json:"results"`
+	}{}
+	assert.NoError(t, h.ResultInto(ctx, &v))
+	if assert.Len(t, v.Results, 1) {
+		assert.Contains(t, v.Results[0].Content, "shoes")
+	}
+}
+
+func TestJobHandleResultFromCallback(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{Delay: 20 * time.Millisecond})
+	c := InitAsync("user", "pass", WithHttpClient(sim.HttpClient()), WithDefaultPollInterval(time.Hour))
+	receiver := callback.NewReceiver("https://example.com/callback")
+	req, _ := NewBingSearchRequest("shoes", &BingSearchOpts{CallbackUrl: receiver.Url})
+
+	ctx := context.Background()
+	h, err := c.Submit(ctx, req)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// Deliver the callback once the job is done.
+	go func() {
+		time.Sleep(40 * time.Millisecond)
+		body := `{"id":"` + h.JobID + `","status":"done","source":"bing_search"}`
+		receiver.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/", strings.NewReader(body)))
+	}()
+
+	resp, err := h.ResultFromCallback(ctx, receiver, time.Hour)
+	if assert.NoError(t, err) && assert.Len(t, resp.Results, 1) {
+		assert.Contains(t, resp.Results[0].Content, "shoes")
+	}
+	assert.Zero(t, sim.Jobs()[0].Polls, "the job is not polled once notified")
+}
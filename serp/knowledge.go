@@ -0,0 +1,82 @@
+package serp
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Entity is the entity of the knowledge panel of a google serp.
+// Type is the subtitle of the panel, e.g. "Sportswear company".
+type Entity struct {
+	Page        int
+	Name        string
+	Type        string
+	Description string
+	Images      []string
+	Attributes  []EntityAttribute
+	SocialLinks []SocialLink
+}
+
+// EntityAttribute is a factoid of a knowledge panel, e.g. "Founded: 1949".
+type EntityAttribute struct {
+	Name  string
+	Value string
+	Links []LinkElement
+}
+
+// SocialLink is a profile of the entity of a knowledge panel on a social network.
+// Network is the title of the profile, e.g. "Instagram", or its host if untitled.
+type SocialLink struct {
+	Network string
+	Url     string
+}
+
+// Attribute returns the value of the attribute of the entity with the name, ignoring case.
+func (e *Entity) Attribute(name string) (string, bool) {
+	for _, attribute := range e.Attributes {
+		if strings.EqualFold(attribute.Name, name) {
+			return attribute.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// KnowledgePanel returns the entity of the first knowledge panel of the parsed
+// pages of a google_search resp, or false if no page has one.
+func (r *Resp) KnowledgePanel() (*Entity, bool) {
+	for _, result := range r.Results {
+		knowledge := result.ContentParsed.Results.Knowledge
+		if knowledge.Title == "" {
+			continue
+		}
+
+		entity := &Entity{
+			Page:        result.Page,
+			Name:        knowledge.Title,
+			Type:        knowledge.Subtitle,
+			Description: knowledge.Description,
+			Images:      knowledge.Images,
+		}
+		for _, factoid := range knowledge.Factoids {
+			entity.Attributes = append(entity.Attributes, EntityAttribute{
+				Name:  strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(factoid.Title), ":")),
+				Value: strings.TrimSpace(factoid.Content),
+				Links: factoid.Links,
+			})
+		}
+		for _, profile := range knowledge.Profiles {
+			network := profile.Title
+			if network == "" {
+				if u, err := url.Parse(profile.Url); err == nil {
+					network = strings.TrimPrefix(u.Hostname(), "www.")
+				}
+			}
+			entity.SocialLinks = append(entity.SocialLinks, SocialLink{Network: network, Url: profile.Url})
+		}
+
+		return entity, true
+	}
+
+	return nil, false
+}
@@ -0,0 +1,32 @@
+package serp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRespKnowledgePanel(t *testing.T) {
+	resp := &Resp{Results: []Results{{Page: 1}, {Page: 2}}}
+	_, ok := resp.KnowledgePanel()
+	assert.False(t, ok)
+
+	resp.Results[1].ContentParsed.Results.Knowledge = Knowledge{
+		Title:    "Adidas",
+		Subtitle: "Sportswear company",
+		Factoids: []Factoid{{Title: "Founded: ", Content: "1949"}},
+		Profiles: []Profile{{Url: "https://www.instagram.com/adidas", Title: "Instagram"}, {Url: "https://www.youtube.com/adidas"}},
+	}
+	entity, ok := resp.KnowledgePanel()
+	assert.True(t, ok)
+	assert.Equal(t, 2, entity.Page)
+	assert.Equal(t, "Adidas", entity.Name)
+	assert.Equal(t, "Sportswear company", entity.Type)
+	founded, ok := entity.Attribute("founded")
+	assert.True(t, ok)
+	assert.Equal(t, "1949", founded)
+	assert.Equal(t, []SocialLink{
+		{Network: "Instagram", Url: "https://www.instagram.com/adidas"},
+		{Network: "youtube.com", Url: "https://www.youtube.com/adidas"},
+	}, entity.SocialLinks)
+}
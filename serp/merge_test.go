@@ -0,0 +1,69 @@
+package serp
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/revvim/oxylabs-sdk-go/oxylabstest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeOrganic(t *testing.T) {
+	page := func(urls ...string) Results {
+		result := Results{}
+		for _, url := range urls {
+			result.ContentParsed.Results.Organic = append(result.ContentParsed.Results.Organic, Organic{Url: url})
+		}
+		return result
+	}
+
+	resp := &Resp{Results: []Results{
+		page("https://a.com/", "https://b.com/x"),
+		page("https://A.com", "https://c.com", "https://b.com/x#top"),
+	}}
+
+	items, report := MergeOrganic(resp)
+	assert.Equal(t, DedupReport{Total: 5, Kept: 3, Dropped: 2}, report)
+	assert.Equal(t, []Organic{{Url: "https://a.com/"}, {Url: "https://b.com/x"}, {Url: "https://c.com"}}, items)
+}
+
+// overlappingPage returns the parsed content of the page of a google search,
+// repeating the last url of the previous page.
+func overlappingPage(page int) map[string]interface{} {
+	return map[string]interface{}{
+		"page":              page,
+		"last_visible_page": 2,
+		"results": map[string]interface{}{
+			"organic": []map[string]interface{}{
+				{"url": fmt.Sprintf("https://example.com/%d", page), "pos": 1},
+				{"url": fmt.Sprintf("https://example.com/%d", page+1), "pos": 2},
+			},
+		},
+	}
+}
+
+func TestDoPagesDedup(t *testing.T) {
+	sim := oxylabstest.NewSimulator(&oxylabstest.SimulatorOpts{
+		Content: func(payload map[string]interface{}) interface{} {
+			page, _ := payload["start_page"].(float64)
+			return overlappingPage(int(page))
+		},
+	})
+	c := Init("user", "pass",
+		WithHttpClient(sim.HttpClient()),
+		WithPagesGuard(oxylabs.PagesGuard{MaxUnits: 1, Split: true}),
+		WithDefaultPollInterval(5*time.Millisecond),
+	)
+
+	resp, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{Pages: 2, Parse: oxylabs.Bool(true)})
+	if !assert.NoError(t, err) || !assert.Len(t, resp.Results, 2) {
+		return
+	}
+	assert.Equal(t, DedupReport{Total: 4, Kept: 3, Dropped: 1}, resp.Dedup)
+	assert.Len(t, resp.Results[0].ContentParsed.Results.Organic, 2)
+	if assert.Len(t, resp.Results[1].ContentParsed.Results.Organic, 1) {
+		assert.Equal(t, "https://example.com/3", resp.Results[1].ContentParsed.Results.Organic[0].Url)
+	}
+}
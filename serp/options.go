@@ -0,0 +1,171 @@
+package serp
+
+import (
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// ClientOption configures a client on Init and InitAsync.
+type ClientOption = internal.ClientOption
+
+// WithHttpClient sends the reqs of the client with httpClient instead of a
+// default http client, e.g. to reuse an instrumented transport.
+func WithHttpClient(httpClient *http.Client) ClientOption {
+	return internal.WithHttpClient(httpClient)
+}
+
+// WithAuditWriter writes an audit record of every submitted payload
+// (timestamp, payload hash, source and payload) to w as a JSON line.
+func WithAuditWriter(w io.Writer) ClientOption {
+	return internal.WithAuditWriter(w)
+}
+
+// WithAuditFunc calls fn with an audit record of every submitted payload.
+func WithAuditFunc(fn func(oxylabs.AuditRecord)) ClientOption {
+	return internal.WithAuditFunc(fn)
+}
+
+// WithRawCharset disables the UTF-8 normalization of html content,
+// keeping it as returned by the API.
+func WithRawCharset() ClientOption {
+	return internal.WithRawCharset()
+}
+
+// WithFireAndForget makes async scrapes with a callback url return as soon as
+// the job is submitted, with only the job ID set on the resp, instead of polling it.
+func WithFireAndForget() ClientOption {
+	return internal.WithFireAndForget()
+}
+
+// WithMaxResponseBytes rejects resps whose body exceeds n bytes with an
+// *oxylabs.ResponseTooLargeError instead of reading them into memory.
+func WithMaxResponseBytes(n int64) ClientOption {
+	return internal.WithMaxResponseBytes(n)
+}
+
+// WithSpillDir streams the body of the resps exceeding the max resp bytes
+// to a file in dir instead of discarding it.
+func WithSpillDir(dir string) ClientOption {
+	return internal.WithSpillDir(dir)
+}
+
+// WithResubmitPolicy resubmits the faulted jobs of async scrapes per the policy.
+// The attempts made are reported on the resp.
+func WithResubmitPolicy(policy oxylabs.ResubmitPolicy) ClientOption {
+	return internal.WithResubmitPolicy(policy)
+}
+
+// WithJobStore stores the jobs of async scrapes with an idempotency key in store,
+// so that retried submissions of the same work item reuse the job.
+func WithJobStore(store oxylabs.JobStore) ClientOption {
+	return internal.WithJobStore(store)
+}
+
+// WithCredentialsProvider gets the credentials of the client from provider,
+// called on the first request and again once the credentials are rejected,
+// instead of the username and password given on init.
+func WithCredentialsProvider(provider oxylabs.CredentialsProvider) ClientOption {
+	return internal.WithCredentialsProvider(provider)
+}
+
+// WithPayloadHashHeader attaches the hash of the payload, as in the audit records,
+// to every submitted req as a header.
+func WithPayloadHashHeader() ClientOption {
+	return internal.WithPayloadHashHeader()
+}
+
+// WithDefaultDomain sets the domain of the scrapes not setting one.
+func WithDefaultDomain(domain oxylabs.Domain) ClientOption {
+	return internal.WithDefaultDomain(domain)
+}
+
+// WithDefaultUserAgent sets the user agent of the scrapes not setting one.
+func WithDefaultUserAgent(userAgent oxylabs.UserAgent) ClientOption {
+	return internal.WithDefaultUserAgent(userAgent)
+}
+
+// WithDefaultPages sets the pages of the scrapes not setting them.
+func WithDefaultPages(pages int) ClientOption {
+	return internal.WithDefaultPages(pages)
+}
+
+// WithDefaultGeoLocation sets the geo location of the scrapes not setting one.
+func WithDefaultGeoLocation(geoLocation string) ClientOption {
+	return internal.WithDefaultGeoLocation(geoLocation)
+}
+
+// WithDefaultTimeout sets the timeout of the scrapes made without a ctx
+// instead of the default of 50 seconds.
+func WithDefaultTimeout(timeout time.Duration) ClientOption {
+	return internal.WithDefaultTimeout(timeout)
+}
+
+// WithDefaultPollInterval sets the poll interval of the async scrapes not setting one.
+func WithDefaultPollInterval(pollInterval time.Duration) ClientOption {
+	return internal.WithDefaultPollInterval(pollInterval)
+}
+
+// WithPagesGuard guards the sync scrapes too costly to complete in time,
+// logging them or splitting them into single page async jobs.
+func WithPagesGuard(guard oxylabs.PagesGuard) ClientOption {
+	return internal.WithPagesGuard(guard)
+}
+
+// WithArchiveHook archives the raw response of every successful scrape with hook,
+// e.g. archive.GzipDir or archive.S3.
+func WithArchiveHook(hook oxylabs.ArchiveHook) ClientOption {
+	return internal.WithArchiveHook(hook)
+}
+
+// WithLoadShedding fails scrapes with oxylabs.ErrDeadlineTooShort instead of
+// submitting them when their ctx deadline is closer than the expected latency
+// of their source, once minSamples latencies of the source were recorded.
+func WithLoadShedding(minSamples int) ClientOption {
+	return internal.WithLoadShedding(minSamples)
+}
+
+// WithStrictLanguage fails scrapes whose results_language is inconsistent with
+// their domain and locale, instead of warning about it in the resp.
+func WithStrictLanguage() ClientOption {
+	return internal.WithStrictLanguage()
+}
+
+// WithMaxConcurrency limits the outstanding scrapes of the client to n, queueing
+// the others in FIFO order until their ctx is done. A n of 0 means no limit.
+func WithMaxConcurrency(n int) ClientOption {
+	return internal.WithMaxConcurrency(n)
+}
+
+// WithTranslator translates the titles and snippets of parsed results into
+// the target language with the translator before they are returned.
+func WithTranslator(translator oxylabs.Translator, targetLanguage string) ClientOption {
+	return internal.WithTranslator(translator, targetLanguage)
+}
+
+// WithClassifier classifies resps as blocked, captcha, empty or ok with the
+// heuristics of the classifier, instead of oxylabs.DefaultClassifier.
+func WithClassifier(classifier oxylabs.Classifier) ClientOption {
+	return internal.WithClassifier(classifier)
+}
+
+// WithSchemaVersionHook calls hook the first time the client sees each schema
+// version of the parsed results of a source, so mapping changes can be reviewed.
+func WithSchemaVersionHook(hook func(oxylabs.SchemaVersion)) ClientOption {
+	return internal.WithSchemaVersionHook(hook)
+}
+
+// WithRetryPolicy retries the scrapes answered with a 429 or 5xx status code, or
+// failing with a network error, with exponential backoff per the policy.
+func WithRetryPolicy(policy oxylabs.RetryPolicy) ClientOption {
+	return internal.WithRetryPolicy(policy)
+}
+
+// WithRateLimit paces the jobs submitted by the client to the limit, shared with
+// every client of the process submitting with the same username until shut down.
+func WithRateLimit(limit oxylabs.RateLimit) ClientOption {
+	return internal.WithRateLimit(limit)
+}
@@ -0,0 +1,27 @@
+package serp
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithPayloadHashHeader(t *testing.T) {
+	var hash, want string
+	c := Init("user", "pass", WithPayloadHashHeader(), WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		body, _ := io.ReadAll(req.Body)
+		hash, want = req.Header.Get(internal.PayloadHashHeader), internal.PayloadHash(body)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"results":[]}`))}
+	})}))
+
+	_, err := c.ScrapeGoogleSearch("adidas")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NotEmpty(t, hash)
+	assert.Equal(t, want, hash)
+}
@@ -0,0 +1,66 @@
+package serp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// PageStatuses returns the status of every page of the resp.
+func (r *Resp) PageStatuses() []oxylabs.PageStatus {
+	statuses := make([]oxylabs.PageStatus, 0, len(r.Results))
+	for _, result := range r.Results {
+		statuses = append(statuses, oxylabs.PageStatus{
+			Page:            result.Page,
+			JobID:           result.JobID,
+			StatusCode:      result.StatusCode,
+			ParseStatusCode: internal.ParseStatusCode(result.ContentRaw),
+		})
+	}
+
+	return statuses
+}
+
+// FailedPages returns the pages of the resp that failed to be scraped or parsed.
+func (r *Resp) FailedPages() []int {
+	var pages []int
+	for _, status := range r.PageStatuses() {
+		if status.Failed() {
+			pages = append(pages, status.Page)
+		}
+	}
+
+	return pages
+}
+
+// RetryPages scrapes the pages of the request the resp was returned for again,
+// one req per page, so that only the failed pages of a range are retried.
+// The resps of the pages scraped successfully are returned along with the errors of the others.
+func (r *Resp) RetryPages(ctx context.Context, pages ...int) ([]*Resp, error) {
+	if r.req == nil || r.do == nil {
+		return nil, fmt.Errorf("resp was not returned by a client")
+	}
+
+	var (
+		resps []*Resp
+		errs  []error
+	)
+	for _, page := range pages {
+		req, ok := internal.PageRequest(r.req, page)
+		if !ok {
+			return nil, fmt.Errorf("request is not paginated by query")
+		}
+
+		resp, err := r.do(ctx, req)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error retrying page %d: %w", page, err))
+			continue
+		}
+		resps = append(resps, resp)
+	}
+
+	return resps, errors.Join(errs...)
+}
@@ -0,0 +1,83 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+const pageStatusResp = `{"results":[
+	{"content":{"parse_status_code":12000},"page":1,"job_id":"1","status_code":200},
+	{"content":{"parse_status_code":12004},"page":2,"job_id":"1","status_code":200},
+	{"content":{},"page":3,"job_id":"1","status_code":613}
+]}`
+
+func TestPageStatuses(t *testing.T) {
+	resp := &Resp{Parse: true}
+	if !assert.NoError(t, json.Unmarshal([]byte(pageStatusResp), resp)) {
+		return
+	}
+
+	assert.Equal(t, []oxylabs.PageStatus{
+		{Page: 1, JobID: "1", StatusCode: 200, ParseStatusCode: 12000},
+		{Page: 2, JobID: "1", StatusCode: 200, ParseStatusCode: 12004},
+		{Page: 3, JobID: "1", StatusCode: 613},
+	}, resp.PageStatuses())
+	assert.Equal(t, []int{2, 3}, resp.FailedPages())
+}
+
+func TestRetryPages(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		payloads []map[string]interface{}
+	)
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: roundTripFunc(func(req *http.Request) *http.Response {
+		var payload map[string]interface{}
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		mu.Lock()
+		payloads = append(payloads, payload)
+		mu.Unlock()
+
+		body := pageStatusResp
+		if payload["pages"] == 1.0 {
+			body = fmt.Sprintf(`{"results":[{"content":{"parse_status_code":12000},"page":%v,"status_code":200}]}`, payload["start_page"])
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})}))
+
+	resp, err := c.ScrapeGoogleSearch("adidas", &GoogleSearchOpts{Pages: 3, Parse: oxylabs.Bool(true)})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	resps, err := resp.RetryPages(context.Background(), resp.FailedPages()...)
+	if !assert.NoError(t, err) {
+		return
+	}
+	if assert.Len(t, resps, 2) {
+		assert.Empty(t, resps[0].FailedPages())
+		assert.Equal(t, 2, resps[0].Results[0].Page)
+		assert.Equal(t, 3, resps[1].Results[0].Page)
+	}
+	if assert.Len(t, payloads, 3) {
+		assert.EqualValues(t, 3, payloads[0]["pages"])
+		for i, page := range []float64{2, 3} {
+			assert.Equal(t, page, payloads[i+1]["start_page"])
+			assert.EqualValues(t, 1, payloads[i+1]["pages"])
+			assert.Equal(t, "adidas", payloads[i+1]["query"])
+		}
+	}
+}
+
+func TestRetryPagesUnbound(t *testing.T) {
+	_, err := (&Resp{}).RetryPages(context.Background(), 1)
+	assert.EqualError(t, err, "resp was not returned by a client")
+}
@@ -0,0 +1,44 @@
+package serp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// NextPageHint returns the next page hint of the last parsed page of the resp.
+func (r *Resp) NextPageHint() (oxylabs.PageHint, bool) {
+	if len(r.Results) == 0 {
+		return oxylabs.PageHint{}, false
+	}
+
+	return internal.NextPageHint(r.Results[len(r.Results)-1].ContentRaw)
+}
+
+// NextPage scrapes the next page of the request the resp was returned for,
+// following the pagination hints of the parsed content.
+func (r *Resp) NextPage(ctx context.Context) (*Resp, error) {
+	if r.req == nil || r.do == nil {
+		return nil, fmt.Errorf("resp was not returned by a client")
+	}
+
+	hint, ok := r.NextPageHint()
+	if !ok {
+		return nil, fmt.Errorf("no next page")
+	}
+
+	req, ok := internal.NextPageRequest(r.req, hint)
+	if !ok {
+		return nil, fmt.Errorf("no next page")
+	}
+
+	return r.do(ctx, req)
+}
+
+// bind keeps the req and the func sending it, so that the next page can be scraped.
+func (r *Resp) bind(req *oxylabs.Request, do func(context.Context, *oxylabs.Request) (*Resp, error)) {
+	r.req = req
+	r.do = do
+}
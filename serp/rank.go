@@ -0,0 +1,162 @@
+package serp
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// DefaultTrackPages is the default number of pages searched for the target domain.
+const DefaultTrackPages = 3
+
+// DomainRank is the rank of a domain in the organic results of a serp.
+// Found is false if the domain is not part of the results.
+type DomainRank struct {
+	Found      bool
+	Page       int
+	Pos        int
+	PosOverall int
+	Url        string
+}
+
+// KeywordRank is the rank of the target domain for a keyword in a geo location.
+type KeywordRank struct {
+	Geo   string
+	Pages int
+	DomainRank
+	Err error
+}
+
+// TrackKeywordOpts contains the options of TrackKeyword.
+// Search is the base of the google_search options of every geo,
+// Pages the maximum number of pages searched, DefaultTrackPages if 0.
+type TrackKeywordOpts struct {
+	Search *GoogleSearchOpts
+	Pages  int
+}
+
+// FindDomainRank returns the first organic result of the parsed resp
+// belonging to the domain or one of its subdomains.
+func FindDomainRank(resp *Resp, domain string) DomainRank {
+	domain = strings.TrimPrefix(strings.ToLower(domain), "www.")
+
+	for _, result := range resp.Results {
+		for _, organic := range result.ContentParsed.Results.Organic {
+			if matchesDomain(organic.Url, domain) {
+				return DomainRank{
+					Found:      true,
+					Page:       result.Page,
+					Pos:        organic.Pos,
+					PosOverall: organic.PosOverall,
+					Url:        organic.Url,
+				}
+			}
+		}
+	}
+
+	return DomainRank{}
+}
+
+// TrackKeyword searches the keyword via Oxylabs SERP API with google_search as source
+// in every geo location concurrently and returns the rank of the target domain per geo,
+// following the next pages until the domain is found or the page limit is reached.
+func (c *SerpClient) TrackKeyword(
+	ctx context.Context,
+	keyword string,
+	targetDomain string,
+	geos []string,
+	opts ...*TrackKeywordOpts,
+) []KeywordRank {
+	// Prepare options.
+	opt := &TrackKeywordOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	pages := opt.Pages
+	if pages <= 0 {
+		pages = DefaultTrackPages
+	}
+
+	ranks := make([]KeywordRank, len(geos))
+	var wg sync.WaitGroup
+	for i, geo := range geos {
+		wg.Add(1)
+		go func(i int, geo string) {
+			defer wg.Done()
+
+			searchOpt := &GoogleSearchOpts{}
+			if opt.Search != nil {
+				*searchOpt = *opt.Search
+			}
+			searchOpt.GeoLocation = geo
+			searchOpt.Parse = oxylabs.Bool(true)
+			searchOpt.Pages = 1
+
+			ranks[i] = c.trackKeyword(ctx, keyword, targetDomain, searchOpt, pages, nil)
+			ranks[i].Geo = geo
+		}(i, geo)
+	}
+	wg.Wait()
+
+	return ranks
+}
+
+// trackKeyword searches up to pages pages of the keyword for the target domain.
+// wait, if set, is called before every req.
+func (c *SerpClient) trackKeyword(
+	ctx context.Context,
+	keyword string,
+	targetDomain string,
+	opt *GoogleSearchOpts,
+	pages int,
+	wait func(context.Context) error,
+) KeywordRank {
+	if wait == nil {
+		wait = func(context.Context) error { return nil }
+	}
+
+	if err := wait(ctx); err != nil {
+		return KeywordRank{Err: err}
+	}
+
+	resp, err := c.ScrapeGoogleSearchCtx(ctx, keyword, opt)
+	if err != nil {
+		return KeywordRank{Err: err}
+	}
+
+	for page := 1; ; page++ {
+		rank := FindDomainRank(resp, targetDomain)
+		if rank.Found || page >= pages {
+			return KeywordRank{Pages: page, DomainRank: rank}
+		}
+
+		if _, ok := resp.NextPageHint(); !ok {
+			return KeywordRank{Pages: page, DomainRank: rank}
+		}
+
+		if err := wait(ctx); err != nil {
+			return KeywordRank{Pages: page, Err: err}
+		}
+
+		resp, err = resp.NextPage(ctx)
+		if err != nil {
+			return KeywordRank{Pages: page, Err: err}
+		}
+	}
+}
+
+// matchesDomain returns whether the url belongs to the domain or one of its subdomains.
+func matchesDomain(rawUrl string, domain string) bool {
+	parsedUrl, err := url.Parse(rawUrl)
+	if err != nil {
+		return false
+	}
+
+	host := strings.TrimPrefix(strings.ToLower(parsedUrl.Hostname()), "www.")
+	return host == domain || strings.HasSuffix(host, "."+domain)
+}
@@ -0,0 +1,126 @@
+package serp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/bulk"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// Market is a google_search market: domain, geo location and locale.
+type Market struct {
+	Domain oxylabs.Domain
+	Geo    string
+	Locale oxylabs.Locale
+}
+
+// String returns the market as "domain/geo/locale".
+func (m Market) String() string {
+	return fmt.Sprintf("%s/%s/%s", m.Domain, m.Geo, m.Locale)
+}
+
+// RankTable holds the rank of the target domain keyed by keyword and market.
+type RankTable map[string]map[Market]KeywordRank
+
+// Rank returns the rank of the target domain for the keyword in the market.
+func (t RankTable) Rank(keyword string, market Market) (KeywordRank, bool) {
+	rank, ok := t[keyword][market]
+	return rank, ok
+}
+
+// TrackKeywordsOpts contains the options of TrackKeywords.
+// Search is the base of the google_search options of every market,
+// Pages the maximum number of pages searched per keyword, DefaultTrackPages if 0.
+// Concurrency is the number of keywords tracked simultaneously, bulk.DefaultConcurrency if 0,
+// and RequestsPerSecond limits the rate of reqs if greater than 0.
+type TrackKeywordsOpts struct {
+	Search            *GoogleSearchOpts
+	Pages             int
+	Concurrency       int
+	RequestsPerSecond float64
+}
+
+// TrackKeywords tracks the rank of the target domain for every keyword in every market
+// via Oxylabs SERP API with google_search as source, within the concurrency and rate limits.
+func (c *SerpClient) TrackKeywords(
+	ctx context.Context,
+	keywords []string,
+	targetDomain string,
+	markets []Market,
+	opts ...*TrackKeywordsOpts,
+) RankTable {
+	// Prepare options.
+	opt := &TrackKeywordsOpts{}
+	if len(opts) > 0 && opts[len(opts)-1] != nil {
+		opt = opts[len(opts)-1]
+	}
+
+	// Set defaults.
+	pages := opt.Pages
+	if pages <= 0 {
+		pages = DefaultTrackPages
+	}
+
+	// Limit the rate of reqs.
+	var wait func(context.Context) error
+	if opt.RequestsPerSecond > 0 {
+		ticker := time.NewTicker(time.Duration(float64(time.Second) / opt.RequestsPerSecond))
+		defer ticker.Stop()
+
+		wait = func(ctx context.Context) error {
+			select {
+			case <-ticker.C:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+	}
+
+	type cell struct {
+		keyword string
+		market  Market
+	}
+	cells := make([]cell, 0, len(keywords)*len(markets))
+	for _, keyword := range keywords {
+		for _, market := range markets {
+			cells = append(cells, cell{keyword: keyword, market: market})
+		}
+	}
+
+	result := bulk.Execute(ctx, cells, func(ctx context.Context, cell cell) (KeywordRank, error) {
+		searchOpt := &GoogleSearchOpts{}
+		if opt.Search != nil {
+			*searchOpt = *opt.Search
+		}
+		searchOpt.Domain = cell.market.Domain
+		searchOpt.GeoLocation = cell.market.Geo
+		searchOpt.Locale = cell.market.Locale
+		searchOpt.Parse = oxylabs.Bool(true)
+		searchOpt.Pages = 1
+
+		rank := c.trackKeyword(ctx, cell.keyword, targetDomain, searchOpt, pages, wait)
+		rank.Geo = cell.market.Geo
+
+		return rank, rank.Err
+	}, &bulk.Opts{Concurrency: opt.Concurrency})
+
+	// Tabulate.
+	table := make(RankTable, len(keywords))
+	for _, keyword := range keywords {
+		table[keyword] = make(map[Market]KeywordRank, len(markets))
+	}
+	for _, success := range result.Successes {
+		table[success.Input.keyword][success.Input.market] = success.Output
+	}
+	for _, itemErr := range result.Errors {
+		table[itemErr.Input.keyword][itemErr.Input.market] = KeywordRank{
+			Geo: itemErr.Input.market.Geo,
+			Err: itemErr.Err,
+		}
+	}
+
+	return table
+}
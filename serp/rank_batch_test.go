@@ -0,0 +1,40 @@
+package serp
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestTrackKeywords(t *testing.T) {
+	transport, reqs := rankApi(map[string]int{"shoes/US": 1, "boots/DE": 2}, "")
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: transport}))
+
+	us := Market{Domain: oxylabs.DOMAIN_COM, Geo: "US", Locale: oxylabs.LOCALE_EN}
+	de := Market{Domain: oxylabs.DOMAIN_DE, Geo: "DE", Locale: oxylabs.LOCALE_DE}
+	table := c.TrackKeywords(context.Background(), []string{"shoes", "boots"}, "adidas.com", []Market{us, de}, &TrackKeywordsOpts{
+		Pages:             2,
+		Concurrency:       2,
+		RequestsPerSecond: 1000,
+	})
+
+	rank, ok := table.Rank("shoes", us)
+	assert.True(t, ok)
+	assert.Equal(t, KeywordRank{Geo: "US", Pages: 1, DomainRank: DomainRank{Found: true, Page: 1, Pos: 2, PosOverall: 3, Url: "https://shop.adidas.com/shoes"}}, rank)
+
+	rank, _ = table.Rank("boots", de)
+	assert.True(t, rank.Found)
+	assert.Equal(t, 2, rank.Page)
+
+	rank, _ = table.Rank("shoes", de)
+	assert.Equal(t, KeywordRank{Geo: "DE", Pages: 2}, rank)
+
+	_, ok = table.Rank("socks", us)
+	assert.False(t, ok)
+	assert.Equal(t, 1+2+2+2, reqs())
+	assert.Equal(t, "de/DE/de", de.String())
+}
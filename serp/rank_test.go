@@ -0,0 +1,88 @@
+package serp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// rankApi returns a transport serving google_search pages with the domain
+// ranked at pos 2 of the page of ranks per keyword and geo, failing the geo failed,
+// and the number of reqs served.
+func rankApi(ranks map[string]int, failed string) (http.RoundTripper, func() int) {
+	var mu sync.Mutex
+	reqs := 0
+
+	transport := roundTripFunc(func(req *http.Request) *http.Response {
+		payload := struct {
+			Query     string `json:"query"`
+			Geo       string `json:"geo_location"`
+			StartPage int    `json:"start_page"`
+		}{}
+		_ = json.NewDecoder(req.Body).Decode(&payload)
+		mu.Lock()
+		reqs++
+		mu.Unlock()
+
+		if payload.Geo == failed {
+			return &http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(`{}`))}
+		}
+
+		organic := `{"pos": 1, "url": "https://other.com", "pos_overall": 1}`
+		if ranks[payload.Query+"/"+payload.Geo] == payload.StartPage {
+			organic += `, {"pos": 2, "url": "https://shop.adidas.com/shoes", "pos_overall": 3}`
+		}
+		body := fmt.Sprintf(`{"results": [{"page": %d, "content": {"page": %d, "last_visible_page": 5, "results": {"organic": [%s]}}}]}`,
+			payload.StartPage, payload.StartPage, organic)
+
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	})
+
+	return transport, func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return reqs
+	}
+}
+
+func TestFindDomainRank(t *testing.T) {
+	resp := &Resp{Results: []Results{{Page: 2}}}
+	resp.Results[0].ContentParsed.Results.Organic = []Organic{
+		{Pos: 1, PosOverall: 1, Url: "https://notadidas.com"},
+		{Pos: 2, PosOverall: 3, Url: "https://www.shop.adidas.com/shoes"},
+		{Pos: 3, PosOverall: 4, Url: "https://adidas.com"},
+	}
+
+	assert.Equal(t, DomainRank{Found: true, Page: 2, Pos: 2, PosOverall: 3, Url: "https://www.shop.adidas.com/shoes"}, FindDomainRank(resp, "www.Adidas.com"))
+	assert.Equal(t, DomainRank{Found: true, Page: 2, Pos: 1, PosOverall: 1, Url: "https://notadidas.com"}, FindDomainRank(resp, "notadidas.com"))
+	assert.Equal(t, DomainRank{}, FindDomainRank(resp, "nike.com"))
+}
+
+func TestTrackKeyword(t *testing.T) {
+	transport, reqs := rankApi(map[string]int{"shoes/US": 2}, "FR")
+	c := Init("user", "pass", WithHttpClient(&http.Client{Transport: transport}))
+
+	ranks := c.TrackKeyword(context.Background(), "shoes", "adidas.com", []string{"US", "DE", "FR"})
+	if !assert.Len(t, ranks, 3) {
+		return
+	}
+
+	assert.Equal(t, KeywordRank{
+		Geo:        "US",
+		Pages:      2,
+		DomainRank: DomainRank{Found: true, Page: 2, Pos: 2, PosOverall: 3, Url: "https://shop.adidas.com/shoes"},
+	}, ranks[0])
+	assert.Equal(t, KeywordRank{Geo: "DE", Pages: DefaultTrackPages}, ranks[1], "the search stops at the page limit")
+	assert.Equal(t, "FR", ranks[2].Geo)
+	assert.ErrorIs(t, ranks[2].Err, oxylabs.ErrInvalidParameter)
+	assert.Equal(t, 2+DefaultTrackPages+1, reqs())
+}
@@ -0,0 +1,251 @@
+package serp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// marshalRequest returns the json payload of the req.
+func marshalRequest(req *oxylabs.Request) ([]byte, error) {
+	if req == nil {
+		return nil, fmt.Errorf("request cannot be nil")
+	}
+
+	payload, err := req.Payload()
+	if err != nil {
+		return nil, err
+	}
+
+	// Marshal.
+	jsonPayload, err := oxylabs.MarshalPayload(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling payload: %v", err)
+	}
+
+	return jsonPayload, nil
+}
+
+// Do sends the req via Oxylabs SERP API and returns the response.
+// All scrape methods of the client are built on it.
+func (c *SerpClient) Do(
+	ctx context.Context,
+	req *oxylabs.Request,
+) (*Resp, error) {
+	jsonPayload, err := marshalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the results language is consistent with the domain and locale,
+	// and warn about queries that are urls.
+	languageWarnings, err := c.C.CheckLanguage(req)
+	if err != nil {
+		return nil, err
+	}
+	reqWarnings := append(languageWarnings, c.C.CheckQuery(req)...)
+
+	// Direct the req at its base url, if set, and record its retries.
+	ctx = internal.WithRequestBaseUrl(ctx, req)
+	ctx, history := internal.WithRetryHistory(ctx, req)
+
+	// Split reqs guarded by the pages guard into single page async jobs.
+	if c.C.GuardPages(req) {
+		resp, err := c.doPages(ctx, req, history)
+		if err != nil {
+			return nil, err
+		}
+		resp.Warnings = append(reqWarnings, resp.Warnings...)
+		return resp, nil
+	}
+
+	// Req.
+	httpResp, err := c.C.Req(ctx, jsonPayload, "POST")
+	if err != nil {
+		return nil, history.Wrap(err)
+	}
+
+	// Unmarshal the http Response and get the response.
+	resp, err := GetResp(httpResp, req.Parse(), req.CustomParser())
+	if err != nil {
+		return nil, err
+	}
+	resp.Warnings = append(reqWarnings, resp.Warnings...)
+	resp.RetryHistory = history.History()
+	resp.classify(c.C)
+	resp.recordSchemaVersion(c.C)
+	resp.detectConsent()
+	// Normalize the charset before the results are translated.
+	if c.C.NormalizeCharset() {
+		resp.normalizeCharset()
+	}
+	resp.translate(ctx, c.C)
+	resp.bind(req, c.Do)
+
+	return resp, nil
+}
+
+// doPages sends the req as an async job per page and merges
+// the results of the pages into a single response.
+func (c *SerpClient) doPages(
+	ctx context.Context,
+	req *oxylabs.Request,
+	history *internal.RetryHistory,
+) (*Resp, error) {
+	httpResps, err := c.C.SplitPages(ctx, req, marshalRequest)
+	if err != nil {
+		return nil, history.Wrap(err)
+	}
+
+	// Unmarshal the http Responses and merge the responses.
+	var resp *Resp
+	for _, httpResp := range httpResps {
+		pageResp, err := GetResp(httpResp, req.Parse(), req.CustomParser())
+		if err != nil {
+			return nil, err
+		}
+		if resp == nil {
+			resp = pageResp
+			continue
+		}
+		resp.Results = append(resp.Results, pageResp.Results...)
+		resp.Warnings = append(resp.Warnings, pageResp.Warnings...)
+	}
+	// Drop the organic results repeated across pages.
+	resp.Dedup = resp.dedupOrganic(make(map[string]bool))
+	resp.RetryHistory = history.History()
+	resp.classify(c.C)
+	resp.recordSchemaVersion(c.C)
+	resp.detectConsent()
+	// Normalize the charset before the results are translated.
+	if c.C.NormalizeCharset() {
+		resp.normalizeCharset()
+	}
+	resp.translate(ctx, c.C)
+	resp.bind(req, c.Do)
+
+	return resp, nil
+}
+
+// Do sends the req with async polling runtime via Oxylabs SERP API
+// and returns a channel with the response.
+// All scrape methods of the client are built on it.
+func (c *SerpClientAsync) Do(
+	ctx context.Context,
+	req *oxylabs.Request,
+) (chan *Resp, error) {
+	respChan := make(chan *Resp)
+
+	jsonPayload, err := marshalRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check the results language is consistent with the domain and locale,
+	// and warn about queries that are urls.
+	languageWarnings, err := c.C.CheckLanguage(req)
+	if err != nil {
+		return nil, err
+	}
+	reqWarnings := append(languageWarnings, c.C.CheckQuery(req)...)
+
+	// Direct the req at its base url, if set, and record its retries.
+	ctx = internal.WithRequestBaseUrl(ctx, req)
+	ctx, history := internal.WithRetryHistory(ctx, req)
+
+	// Get job ID.
+	jobID, err := c.C.SubmitJob(ctx, req, jsonPayload)
+	if err != nil {
+		return nil, history.Wrap(err)
+	}
+
+	// Return the job without polling, its completion is notified to the callback_url.
+	if c.C.FireAndForget(req) {
+		go func() {
+			respChan <- submittedResp(jobID, req)
+		}()
+		return respChan, nil
+	}
+
+	// Poll job status, resubmitting faulted jobs per the resubmit policy.
+	httpResp, attempts, err := c.C.AwaitJobResubmitting(ctx, req, jobID, marshalRequest)
+	if err != nil {
+		return nil, history.Wrap(err)
+	}
+
+	// Unmarshal the http Response and get the response.
+	resp, err := GetResp(httpResp, req.Parse(), req.CustomParser())
+	if err != nil {
+		return nil, err
+	}
+	resp.Warnings = append(reqWarnings, resp.Warnings...)
+	resp.Attempts = attempts
+	resp.RetryHistory = history.History()
+	resp.classify(c.C)
+	resp.recordSchemaVersion(c.C)
+	resp.detectConsent()
+	// Normalize the charset before the results are translated.
+	if c.C.NormalizeCharset() {
+		resp.normalizeCharset()
+	}
+	resp.translate(ctx, c.C)
+	resp.bind(req, func(ctx context.Context, req *oxylabs.Request) (*Resp, error) {
+		respChan, err := c.Do(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		return <-respChan, nil
+	})
+
+	// Retrieve internal resp and forward it to the
+	// resp channel.
+	go func() {
+		respChan <- resp
+	}()
+
+	return respChan, nil
+}
+
+// ReplayPayload validates and resubmits a previously submitted payload, e.g. the
+// Payload of an audit record, verbatim and returns the response.
+func (c *SerpClient) ReplayPayload(
+	ctx context.Context,
+	raw []byte,
+) (*Resp, error) {
+	req, err := oxylabs.ParsePayload(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req)
+}
+
+// ReplayPayload validates and resubmits a previously submitted payload, e.g. the
+// Payload of an audit record, verbatim and returns a channel with the response.
+func (c *SerpClientAsync) ReplayPayload(
+	ctx context.Context,
+	raw []byte,
+) (chan *Resp, error) {
+	req, err := oxylabs.ParsePayload(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.Do(ctx, req)
+}
+
+// submittedResp returns the resp of a submitted job that is not polled.
+func submittedResp(jobID string, req *oxylabs.Request) *Resp {
+	return &Resp{
+		Parse:             req.Parse(),
+		ParseInstructions: req.CustomParser(),
+		Job: Job{
+			ID:          jobID,
+			CallbackUrl: req.CallbackUrl(),
+			Source:      string(req.Source),
+			Status:      "pending",
+		},
+	}
+}
@@ -0,0 +1,107 @@
+package serp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+func TestNewRequests(t *testing.T) {
+	toaster := oxylabs.UserAgent("toaster")
+	tests := []struct {
+		source  oxylabs.Source
+		input   string
+		newReq  func(input string) (*oxylabs.Request, error)
+		invalid func(input string) (*oxylabs.Request, error)
+	}{
+		{
+			oxylabs.BingSearch, "adidas",
+			func(query string) (*oxylabs.Request, error) { return NewBingSearchRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewBingSearchRequest(query, &BingSearchOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.BingUrl, "https://www.bing.com/search?q=adidas",
+			func(url string) (*oxylabs.Request, error) { return NewBingUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewBingUrlRequest(url, &BingUrlOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleSearch, "adidas",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleSearchRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleSearchRequest(query, &GoogleSearchOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleUrl, "https://www.google.com/search?q=adidas",
+			func(url string) (*oxylabs.Request, error) { return NewGoogleUrlRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewGoogleUrlRequest(url, &GoogleUrlOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleAds, "adidas",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleAdsRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleAdsRequest(query, &GoogleAdsOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleSuggestions, "adidas",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleSuggestionsRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleSuggestionsRequest(query, &GoogleSuggestionsOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleHotels, "hotels in paris",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleHotelsRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleHotelsRequest(query, &GoogleHotelsOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleTravelHotels, "hotels in paris",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleTravelHotelsRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleTravelHotelsRequest(query, &GoogleTravelHotelsOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleImages, "https://www.example.com/shoe.png",
+			func(url string) (*oxylabs.Request, error) { return NewGoogleImagesRequest(url) },
+			func(url string) (*oxylabs.Request, error) {
+				return NewGoogleImagesRequest(url, &GoogleImagesOpts{UserAgent: toaster})
+			},
+		},
+		{
+			oxylabs.GoogleTrendsExplore, "adidas",
+			func(query string) (*oxylabs.Request, error) { return NewGoogleTrendsExploreRequest(query) },
+			func(query string) (*oxylabs.Request, error) {
+				return NewGoogleTrendsExploreRequest(query, &GoogleTrendsExploreOpts{UserAgent: toaster})
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.source), func(t *testing.T) {
+			req, err := tt.newReq(tt.input)
+			if !assert.NoError(t, err) {
+				return
+			}
+			payload, err := req.Payload()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.source, payload["source"])
+			assert.Contains(t, []interface{}{payload["query"], payload["url"]}, tt.input)
+
+			_, err = tt.invalid(tt.input)
+			assert.ErrorIs(t, err, oxylabs.ErrInvalidParameter, "the options are validated by the constructor")
+			assert.ErrorContains(t, err, "invalid user agent parameter: toaster")
+		})
+	}
+}
@@ -1,34 +1,78 @@
 package serp
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
 )
 
 // Resp is the response struct for all serp sources.
 type Resp struct {
-	Parse             bool      `json:"parse"`
-	ParseInstructions bool      `json:"parse_instructions"`
-	Results           []Results `json:"results"`
-	Job               Job       `json:"job"`
-	StatusCode        int       `json:"status_code"`
-	Status            string    `json:"status"`
-	Html              string    `json:"html"`
+	Parse             bool                   `json:"parse"`
+	ParseInstructions bool                   `json:"parse_instructions"`
+	Results           []Results              `json:"results"`
+	Job               Job                    `json:"job"`
+	StatusCode        int                    `json:"status_code"`
+	Status            string                 `json:"status"`
+	Html              string                 `json:"html"`
+	Warnings          []oxylabs.Warning      `json:"-"`
+	Attempts          []oxylabs.Attempt      `json:"-"`
+	RetryHistory      oxylabs.RetryHistory   `json:"-"`
+	Classification    oxylabs.Classification `json:"-"`
+	SchemaVersion     string                 `json:"-"`
+	Dedup             DedupReport            `json:"-"`
+	ConsentWall       bool                   `json:"-"`
+
+	req *oxylabs.Request
+	do  func(context.Context, *oxylabs.Request) (*Resp, error)
+}
+
+// String returns a compact summary of the resp for logging,
+// without the content of the results.
+func (r *Resp) String() string {
+	if r == nil {
+		return "<nil>"
+	}
+
+	fields := []string{fmt.Sprintf("Source: %s", r.Job.Source)}
+	if r.Job.Query != "" {
+		fields = append(fields, fmt.Sprintf("Query: %s", internal.Truncate(r.Job.Query, 64)))
+	}
+	if url, ok := r.Job.Url.(string); ok && url != "" {
+		fields = append(fields, fmt.Sprintf("Url: %s", internal.Truncate(url, 64)))
+	}
+	if r.Job.Pages != 0 {
+		fields = append(fields, fmt.Sprintf("Pages: %d", r.Job.Pages))
+	}
+	if r.Job.GeoLocation != nil {
+		fields = append(fields, fmt.Sprintf("GeoLocation: %v", r.Job.GeoLocation))
+	}
+	fields = append(fields,
+		fmt.Sprintf("Status: %s", r.Status),
+		fmt.Sprintf("Results: %d", len(r.Results)),
+	)
+
+	return fmt.Sprintf("Resp{%s}", strings.Join(fields, ", "))
 }
 
 type Results struct {
 	CustomContentParsed map[string]interface{}
 	ContentParsed       Content
 	Content             string
-	CreatedAt           string `json:"created_at"`
-	UpdatedAt           string `json:"updated_at"`
-	Page                int    `json:"page"`
-	Url                 string `json:"url"`
-	JobID               string `json:"job_id"`
-	StatusCode          int    `json:"status_code"`
-	ParserType          string `json:"parser_type"`
+	ContentRaw          json.RawMessage `json:"-"`
+	CreatedAt           string          `json:"created_at"`
+	UpdatedAt           string          `json:"updated_at"`
+	Page                int             `json:"page"`
+	Url                 string          `json:"url"`
+	JobID               string          `json:"job_id"`
+	StatusCode          int             `json:"status_code"`
+	ParserType          string          `json:"parser_type"`
 }
 
 type Content struct {
@@ -38,6 +82,17 @@ type Content struct {
 	Results         Result      `json:"results"`
 	LastVisiblePage int         `json:"last_visible_page"`
 	ParseStatusCode int         `json:"parse_status_code"`
+	Pagination      Pagination  `json:"-"`
+}
+
+// Pagination is the pagination of a parsed page, from its pagination section
+// or, if it has none, from its page and last visible page.
+// NextPage is 0 and NextPageUrl empty on the last page.
+type Pagination struct {
+	CurrentPage     int
+	LastVisiblePage int
+	NextPage        int
+	NextPageUrl     string
 }
 
 type Result struct {
@@ -456,6 +511,14 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 
 		// Unmarshal each result into the Results slice.
 		for _, resultRawMessage := range resultsRawMessages {
+			// Keep the raw content for decoding into typed models.
+			var rawResult struct {
+				Content json.RawMessage `json:"content"`
+			}
+			if err := json.Unmarshal(resultRawMessage, &rawResult); err != nil {
+				return err
+			}
+
 			if r.Parse && !r.ParseInstructions {
 				var result struct {
 					ContentParsed Content `json:"content"`
@@ -469,7 +532,9 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 				if err := json.Unmarshal(resultRawMessage, &result); err != nil {
 					return err
 				}
+				result.ContentParsed.Pagination = pagination(result.ContentParsed, rawResult.Content)
 				r.Results = append(r.Results, Results{
+					ContentRaw:    rawResult.Content,
 					ContentParsed: result.ContentParsed,
 					CreatedAt:     result.CreatedAt,
 					UpdatedAt:     result.UpdatedAt,
@@ -492,6 +557,7 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 					return err
 				}
 				r.Results = append(r.Results, Results{
+					ContentRaw:          rawResult.Content,
 					CustomContentParsed: result.CustomContentParsed,
 					CreatedAt:           result.CreatedAt,
 					UpdatedAt:           result.UpdatedAt,
@@ -514,6 +580,7 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 					return err
 				}
 				r.Results = append(r.Results, Results{
+					ContentRaw: rawResult.Content,
 					Content:    result.Content,
 					CreatedAt:  result.CreatedAt,
 					UpdatedAt:  result.UpdatedAt,
@@ -538,6 +605,31 @@ func (r *Resp) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// pagination returns the pagination of the parsed content.
+func pagination(content Content, raw json.RawMessage) Pagination {
+	parsed := struct {
+		Pagination struct {
+			CurrentPage int `json:"current_page"`
+			TotalPages  int `json:"total_pages"`
+		} `json:"pagination"`
+	}{}
+	_ = json.Unmarshal(raw, &parsed)
+
+	p := Pagination{CurrentPage: content.Page, LastVisiblePage: content.LastVisiblePage}
+	if parsed.Pagination.CurrentPage != 0 {
+		p.CurrentPage = parsed.Pagination.CurrentPage
+	}
+	if parsed.Pagination.TotalPages != 0 {
+		p.LastVisiblePage = parsed.Pagination.TotalPages
+	}
+	if hint, ok := internal.NextPageHint(raw); ok {
+		p.NextPage = hint.Page
+		p.NextPageUrl = hint.Url
+	}
+
+	return p
+}
+
 // GetResp returns a Resp struct from the http.Response object.
 // It will use the parse and customParserFlag parameters
 // to determine how to parse the response.
@@ -554,7 +646,11 @@ func GetResp(
 
 	// If status code not 200, return error.
 	if httpResp.StatusCode != 200 {
-		return nil, fmt.Errorf("error with status code %s: %s", httpResp.Status, respBody)
+		return nil, &oxylabs.StatusError{
+			StatusCode: httpResp.StatusCode,
+			Status:     httpResp.Status,
+			Body:       respBody,
+		}
 	}
 
 	// Unmarshal the JSON object.
@@ -569,5 +665,10 @@ func GetResp(
 	res.StatusCode = httpResp.StatusCode
 	res.Status = httpResp.Status
 
+	// Set warnings of partially parsed pages.
+	for _, result := range res.Results {
+		res.Warnings = append(res.Warnings, internal.ParseWarnings(result.Page, result.ContentRaw)...)
+	}
+
 	return res, nil
 }
@@ -0,0 +1,46 @@
+package serp
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetRespTypedGoogleResults(t *testing.T) {
+	body := []byte(`{"results": [{"page": 1, "content": {"page": 1, "last_visible_page": 10, "results": {
+		"paid": [{"pos": 1, "url": "https://ad.com", "title": "Ad", "desc": "Buy"}],
+		"organic": [{"pos": 1, "url": "https://a.com", "title": "A", "desc": "First"}],
+		"featured_snippet": [{"url": "https://b.com", "title": "B", "pos_overall": 1}],
+		"related_searches": {"pos_overall": 12, "related_searches": ["adidas shoes"]}
+	}}}], "job": {"id": "1", "source": "google_search"}}`)
+
+	resp, err := GetResp(&http.Response{
+		StatusCode: http.StatusOK,
+		Status:     "200 OK",
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, true, false)
+	assert.NoError(t, err)
+
+	content := resp.Results[0].ContentParsed
+	assert.Equal(t, "https://ad.com", content.Results.Paid[0].Url)
+	assert.Equal(t, "First", content.Results.Organic[0].Desc)
+	assert.Equal(t, "B", content.Results.FeaturedSnippet[0].Title)
+	assert.Equal(t, []string{"adidas shoes"}, content.Results.RelatedSearches.RelatedSearches)
+	assert.Equal(t, Pagination{CurrentPage: 1, LastVisiblePage: 10, NextPage: 2}, content.Pagination)
+}
+
+func TestGetRespPaginationSection(t *testing.T) {
+	body := []byte(`{"results": [{"page": 1, "content": {"page": 1,
+		"pagination": {"current_page": 3, "total_pages": 3, "next_page_url": ""}
+	}}]}`)
+
+	resp, err := GetResp(&http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(bytes.NewReader(body)),
+	}, true, false)
+	assert.NoError(t, err)
+	assert.Equal(t, Pagination{CurrentPage: 3, LastVisiblePage: 3}, resp.Results[0].ContentParsed.Pagination)
+}
@@ -0,0 +1,26 @@
+package serp
+
+import (
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// recordSchemaVersion sets the schema version of the resp to the one of its
+// first parsed page with a version, and records the version of every page.
+func (r *Resp) recordSchemaVersion(c *internal.Client) {
+	for _, result := range r.Results {
+		version := internal.SchemaVersion(result.ContentRaw)
+		if version == "" {
+			continue
+		}
+		if r.SchemaVersion == "" {
+			r.SchemaVersion = version
+		}
+
+		c.RecordSchemaVersion(oxylabs.SchemaVersion{
+			Source:  oxylabs.Source(r.Job.Source),
+			Version: version,
+			JobID:   result.JobID,
+		})
+	}
+}
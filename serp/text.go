@@ -0,0 +1,39 @@
+package serp
+
+import (
+	"strings"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+)
+
+// PlainText returns the readable text of the html content of every result,
+// without scripts, styles, navigation and other boilerplate.
+// The text of each result is separated by an empty line.
+func (r *Resp) PlainText() (string, error) {
+	texts := make([]string, 0, len(r.Results))
+	for _, result := range r.Results {
+		text, err := internal.PlainText(result.Content)
+		if err != nil {
+			return "", err
+		}
+		texts = append(texts, text)
+	}
+
+	return strings.Join(texts, "\n\n"), nil
+}
+
+// ToMarkdown converts the html content of every result to markdown client-side,
+// for sources where the markdown output format of the API isn't available.
+// The markdown of each result is separated by a horizontal rule.
+func (r *Resp) ToMarkdown() (string, error) {
+	mds := make([]string, 0, len(r.Results))
+	for _, result := range r.Results {
+		md, err := internal.Markdown(result.Content)
+		if err != nil {
+			return "", err
+		}
+		mds = append(mds, md)
+	}
+
+	return strings.Join(mds, "\n\n---\n\n"), nil
+}
@@ -0,0 +1,30 @@
+package serp
+
+import (
+	"context"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// translate translates the titles and snippets of the parsed results with the
+// translator of the client. A failed translation is reported as a warning.
+func (r *Resp) translate(ctx context.Context, c *internal.Client) {
+	var texts []*string
+	for i := range r.Results {
+		results := &r.Results[i].ContentParsed.Results
+		for j := range results.Organic {
+			texts = append(texts, &results.Organic[j].Title, &results.Organic[j].Desc)
+		}
+		for j := range results.Paid {
+			texts = append(texts, &results.Paid[j].Title, &results.Paid[j].Desc)
+		}
+		for j := range results.FeaturedSnippet {
+			texts = append(texts, &results.FeaturedSnippet[j].Title, &results.FeaturedSnippet[j].Desc)
+		}
+	}
+
+	if err := c.Translate(ctx, texts); err != nil {
+		r.Warnings = append(r.Warnings, oxylabs.Warning{Message: err.Error()})
+	}
+}
@@ -0,0 +1,117 @@
+package usage
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// JobSummary is a job submitted by the account.
+// ClientNotes carry the idempotency key of the jobs submitted with a job store.
+type JobSummary struct {
+	ID          string         `json:"id"`
+	Status      string         `json:"status"`
+	Source      oxylabs.Source `json:"source"`
+	CreatedAt   time.Time      `json:"created_at"`
+	ClientNotes string         `json:"client_notes"`
+}
+
+// JobEntry is an entry of a job store.
+// JobID is empty for a key reserved without a stored job.
+type JobEntry struct {
+	Key   string
+	JobID string
+}
+
+// Reconciliation is the comparison of the jobs submitted by the account with
+// the entries of a job store. Orphans are the jobs submitted with an
+// idempotency key and not tracked by the store, e.g. submitted by a process
+// that crashed before storing them. Jobs submitted without a key are never orphans.
+// Stale are the entries of the store whose job is not among the submitted
+// jobs, including the keys reserved without a stored job.
+type Reconciliation struct {
+	Orphans []JobSummary
+	Stale   []JobEntry
+}
+
+// Jobs returns the jobs submitted by the account since the time, from the
+// GET /v2/user/jobs endpoint, following its pages until the last one.
+func (c *UsageClient) Jobs(ctx context.Context, since time.Time) ([]JobSummary, error) {
+	var jobs []JobSummary
+	for page := 1; ; {
+		resp := &struct {
+			Jobs     []JobSummary `json:"jobs"`
+			NextPage int          `json:"next_page"`
+		}{}
+		path := fmt.Sprintf(
+			"/jobs?created_after=%s&page=%d",
+			url.QueryEscape(since.UTC().Format(time.RFC3339)),
+			page,
+		)
+		if err := c.get(ctx, path, resp); err != nil {
+			return nil, err
+		}
+		jobs = append(jobs, resp.Jobs...)
+
+		// Stop at the last page, or if the next one doesn't advance.
+		if len(resp.Jobs) == 0 || resp.NextPage <= page {
+			return jobs, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// Reconcile compares the jobs submitted by the account since the time with
+// the entries of the store. Entries of jobs submitted before the time are
+// reported as stale, so since should precede the oldest job of the store.
+func (c *UsageClient) Reconcile(
+	ctx context.Context,
+	store oxylabs.ListableJobStore,
+	since time.Time,
+) (*Reconciliation, error) {
+	jobs, err := c.Jobs(ctx, since)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := store.Entries(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing job store entries: %v", err)
+	}
+
+	return reconcile(jobs, entries), nil
+}
+
+// reconcile compares the jobs with the entries of a job store.
+func reconcile(jobs []JobSummary, entries map[string]string) *Reconciliation {
+	tracked := make(map[string]bool, len(entries))
+	for _, jobID := range entries {
+		if jobID != "" {
+			tracked[jobID] = true
+		}
+	}
+
+	reconciliation := &Reconciliation{}
+	submitted := make(map[string]bool, len(jobs))
+	for _, job := range jobs {
+		submitted[job.ID] = true
+		if _, keyed := internal.IdempotencyKeyOfNotes(job.ClientNotes); keyed && !tracked[job.ID] {
+			reconciliation.Orphans = append(reconciliation.Orphans, job)
+		}
+	}
+	for key, jobID := range entries {
+		if !submitted[jobID] {
+			reconciliation.Stale = append(reconciliation.Stale, JobEntry{Key: key, JobID: jobID})
+		}
+	}
+	sort.Slice(reconciliation.Stale, func(i, j int) bool {
+		return reconciliation.Stale[i].Key < reconciliation.Stale[j].Key
+	})
+
+	return reconciliation
+}
@@ -0,0 +1,92 @@
+package usage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReconcile(t *testing.T) {
+	pages := map[string]string{
+		"1": `{"jobs":[{"id":"job1","status":"done","client_notes":"idempotency_key:tracked"},{"id":"job2","status":"pending","client_notes":"idempotency_key:crashed"}],"next_page":2}`,
+		"2": `{"jobs":[{"id":"job3","status":"done"},{"id":"job4","status":"done","client_notes":"campaign 42"}]}`,
+	}
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/v2/user/jobs", r.URL.Path)
+		assert.Equal(t, "2026-01-01T00:00:00Z", r.URL.Query().Get("created_after"))
+		page := r.URL.Query().Get("page")
+		requested = append(requested, page)
+		_, _ = w.Write([]byte(pages[page]))
+	}))
+	defer server.Close()
+
+	c := Init("user", "pass")
+	c.C.BaseUrl = server.URL + "/v2/user"
+
+	ctx := context.Background()
+	store := oxylabs.NewMemoryJobStore()
+	_ = store.Store(ctx, "tracked", "job1")
+	_ = store.Store(ctx, "expired", "job0")
+	_, _ = store.Reserve(ctx, "crashed")
+
+	reconciliation, err := c.Reconcile(ctx, store, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2"}, requested)
+
+	// Jobs submitted without an idempotency key are not orphans.
+	assert.Equal(t, []JobSummary{{ID: "job2", Status: "pending", ClientNotes: "idempotency_key:crashed"}}, reconciliation.Orphans)
+	assert.Equal(t, []JobEntry{{Key: "crashed"}, {Key: "expired", JobID: "job0"}}, reconciliation.Stale)
+}
+
+func TestJobsPagination(t *testing.T) {
+	tests := []struct {
+		name      string
+		pages     map[string]string
+		wantJobs  int
+		wantPages int
+	}{
+		{
+			name:      "single page",
+			pages:     map[string]string{"1": `{"jobs":[{"id":"job1"}]}`},
+			wantJobs:  1,
+			wantPages: 1,
+		},
+		{
+			name:      "empty next page",
+			pages:     map[string]string{"1": `{"jobs":[{"id":"job1"}],"next_page":2}`, "2": `{"jobs":[],"next_page":3}`},
+			wantJobs:  1,
+			wantPages: 2,
+		},
+		{
+			name:      "next page not advancing",
+			pages:     map[string]string{"1": `{"jobs":[{"id":"job1"}],"next_page":1}`},
+			wantJobs:  1,
+			wantPages: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requested := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requested++
+				_, _ = w.Write([]byte(tt.pages[r.URL.Query().Get("page")]))
+			}))
+			defer server.Close()
+
+			c := Init("user", "pass")
+			c.C.BaseUrl = server.URL + "/v2/user"
+
+			jobs, err := c.Jobs(context.Background(), time.Now())
+			assert.NoError(t, err)
+			assert.Len(t, jobs, tt.wantJobs)
+			assert.Equal(t, tt.wantPages, requested)
+		})
+	}
+}
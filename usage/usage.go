@@ -0,0 +1,62 @@
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/revvim/oxylabs-sdk-go/internal"
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+)
+
+// BaseUrl is the base url of the account endpoints.
+const BaseUrl = "https://data.oxylabs.io/v2/user"
+
+type ClientOption = internal.ClientOption
+
+// UsageClient is the client of the account endpoints.
+type UsageClient struct {
+	C *internal.Client
+}
+
+// Init for the account endpoints.
+func Init(
+	username string,
+	password string,
+	opts ...ClientOption,
+) *UsageClient {
+	return &UsageClient{
+		C: internal.NewClient(BaseUrl, username, password, opts...),
+	}
+}
+
+// get performs a GET req to the path of the account endpoints
+// and unmarshals the resp body into v.
+func (c *UsageClient) get(ctx context.Context, path string, v interface{}) error {
+	req, err := internal.NewRequestWithContext(ctx, "GET", c.C.BaseUrl+path, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.C.DoAuthorized(req)
+	if err != nil {
+		return fmt.Errorf("error performing req: %v", err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading resp body: %v", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return &oxylabs.StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: respBody}
+	}
+
+	if err = json.Unmarshal(respBody, v); err != nil {
+		return fmt.Errorf("error unmarshalling resp body: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package usage
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/revvim/oxylabs-sdk-go/oxylabs"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr string
+	}{
+		{name: "unauthorized", status: http.StatusUnauthorized, body: `{"message":"Unauthorized"}`, wantErr: "401"},
+		{name: "malformed body", status: http.StatusOK, body: `{"jobs":`, wantErr: "error unmarshalling resp body"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := Init("user", "pass")
+			c.C.BaseUrl = server.URL + "/v2/user"
+
+			_, err := c.Jobs(context.Background(), time.Time{})
+			assert.ErrorContains(t, err, tt.wantErr)
+
+			var statusErr *oxylabs.StatusError
+			assert.Equal(t, tt.status != http.StatusOK, errors.As(err, &statusErr))
+		})
+	}
+}